@@ -13,9 +13,9 @@ type GetBNBBurnService struct {
 // Do send request
 func (s *GetBNBBurnService) Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bnbBurn",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bnbBurn",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -57,15 +57,15 @@ func (s *ToggleBNBBurnService) InterestBNBBurn(v bool) *ToggleBNBBurnService {
 // Do send request
 func (s *ToggleBNBBurnService) Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/bnbBurn",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/bnbBurn",
+		SecType:  secTypeSigned,
 	}
 	if s.spotBNBBurn != nil {
-		r.setParam("spotBNBBurn", *s.spotBNBBurn)
+		r.SetParam("spotBNBBurn", *s.spotBNBBurn)
 	}
 	if s.interestBNBBurn != nil {
-		r.setParam("interestBNBBurn", *s.interestBNBBurn)
+		r.SetParam("interestBNBBurn", *s.interestBNBBurn)
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
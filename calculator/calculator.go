@@ -0,0 +1,295 @@
+// Package calculator computes the margin, margin ratio and liquidation price
+// of USDⓈ-M and COIN-M futures positions from the leverage brackets, e.g. to
+// check the risk of an order before placing it.
+//
+// The maintenance margin of a leg of notional N is N * maintMarginRatio - cum
+// of the bracket N falls in. The liquidation price is the mark price where
+// the margin balance equals the maintenance margin; the bracket is taken at
+// the notional of the liquidation price, the same way as Binance.
+package calculator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// PositionSide define the position side of a leg
+type PositionSide string
+
+const (
+	PositionSideBoth  PositionSide = "BOTH"
+	PositionSideLong  PositionSide = "LONG"
+	PositionSideShort PositionSide = "SHORT"
+)
+
+// Leg define a side of a position, in the base asset for USDⓈ-M and in
+// contracts for COIN-M
+type Leg struct {
+	Side       PositionSide
+	Amount     float64 // signed, positive for long
+	EntryPrice float64
+}
+
+// Position define the position of a symbol and the margin backing it. A
+// one-way position has a BOTH leg, a hedge mode position a LONG and a SHORT
+// leg. Each side of an isolated hedge mode position has its own wallet and
+// is a Position of its own.
+type Position struct {
+	Legs     []Leg
+	Leverage int // leverage of the symbol, the max leverage of the bracket when zero
+	Isolated bool
+	// WalletBalance is the isolated wallet of an isolated position, the
+	// cross wallet balance of the margin asset otherwise
+	WalletBalance      float64
+	OtherMaintMargin   float64 // maintenance margin of the other cross positions
+	OtherUnrealizedPnL float64 // unrealized PnL of the other cross positions
+}
+
+func (p Position) clone() Position {
+	p.Legs = append([]Leg(nil), p.Legs...)
+	return p
+}
+
+// Order define a hypothetical order
+type Order struct {
+	Side     PositionSide // leg traded, PositionSideBoth in one-way mode
+	Quantity float64      // signed, positive to buy
+	Price    float64      // expected execution price
+	FeeRate  float64      // commission rate, e.g. from CommissionRateService
+}
+
+// Risk define the margin of a position at a mark price, in the margin asset
+type Risk struct {
+	Notional         float64
+	UnrealizedPnL    float64
+	InitialMargin    float64
+	MaintMargin      float64 // including OtherMaintMargin for cross positions
+	MarginBalance    float64 // including OtherUnrealizedPnL for cross positions
+	MarginRatio      float64 // MaintMargin / MarginBalance, the position is liquidated at 1
+	LiquidationPrice float64 // 0 when the position cannot be liquidated
+}
+
+// Calculator compute the margin of the positions of a symbol
+type Calculator struct {
+	c        contract
+	brackets []bracket
+}
+
+// NewUSDM init a calculator for a USDⓈ-M symbol with its brackets from
+// GetLeverageBracketService
+func NewUSDM(brackets []futures.Bracket) *Calculator {
+	return &Calculator{c: linear{}, brackets: futuresBrackets(brackets)}
+}
+
+// NewCOINM init a calculator for a COIN-M symbol with its brackets from
+// GetLeverageBracketService and the USD value of a contract
+func NewCOINM(brackets []delivery.Bracket, contractSize float64) *Calculator {
+	return &Calculator{c: inverse{size: contractSize}, brackets: deliveryBrackets(brackets)}
+}
+
+// bracket return the bracket of a notional, the last one above the caps
+func (c *Calculator) bracket(notional float64) bracket {
+	for _, b := range c.brackets {
+		if notional >= b.floor && notional < b.cap {
+			return b
+		}
+	}
+	if len(c.brackets) == 0 {
+		return bracket{}
+	}
+	if notional < c.brackets[0].floor {
+		return c.brackets[0]
+	}
+	return c.brackets[len(c.brackets)-1]
+}
+
+// MaxLeverage return the max initial leverage of a notional
+func (c *Calculator) MaxLeverage(notional float64) int {
+	return c.bracket(notional).leverage
+}
+
+// MaintMargin return the maintenance margin of a notional
+func (c *Calculator) MaintMargin(notional float64) float64 {
+	b := c.bracket(notional)
+	return notional*b.mmr - b.cum
+}
+
+// Risk compute the margin of a position at a mark price
+func (c *Calculator) Risk(p Position, markPrice float64) Risk {
+	var res Risk
+	for _, l := range p.Legs {
+		if l.Amount == 0 {
+			continue
+		}
+		notional := c.c.notional(l.Amount, markPrice)
+		leverage := p.Leverage
+		if leverage <= 0 {
+			leverage = c.MaxLeverage(notional)
+		}
+		res.Notional += notional
+		res.UnrealizedPnL += c.c.pnl(l.Amount, l.EntryPrice, markPrice)
+		if leverage > 0 {
+			res.InitialMargin += notional / float64(leverage)
+		}
+		res.MaintMargin += c.MaintMargin(notional)
+	}
+	res.MarginBalance = p.WalletBalance + res.UnrealizedPnL
+	if !p.Isolated {
+		res.MaintMargin += p.OtherMaintMargin
+		res.MarginBalance += p.OtherUnrealizedPnL
+	}
+	switch {
+	case res.MaintMargin == 0:
+	case res.MarginBalance <= 0:
+		res.MarginRatio = math.Inf(1)
+	default:
+		res.MarginRatio = res.MaintMargin / res.MarginBalance
+	}
+	res.LiquidationPrice = c.LiquidationPrice(p)
+	return res
+}
+
+// LiquidationPrice return the mark price where the position is liquidated,
+// 0 when it cannot be liquidated. The margin and PnL of the other cross
+// positions are taken as constant.
+func (c *Calculator) LiquidationPrice(p Position) float64 {
+	var legs []Leg
+	for _, l := range p.Legs {
+		if l.Amount != 0 {
+			legs = append(legs, l)
+		}
+	}
+	if len(legs) == 0 {
+		return 0
+	}
+	balance := p.WalletBalance
+	if !p.Isolated {
+		balance += p.OtherUnrealizedPnL - p.OtherMaintMargin
+	}
+	tiers := make([]bracket, len(legs))
+	for i, l := range legs {
+		tiers[i] = c.bracket(c.c.notional(l.Amount, l.EntryPrice))
+	}
+	// the brackets depend on the price solved, retry until they settle
+	var price float64
+	for n := 0; n <= len(c.brackets); n++ {
+		price = c.c.liquidationPrice(legs, tiers, balance)
+		if price <= 0 || math.IsInf(price, 0) || math.IsNaN(price) {
+			return 0
+		}
+		settled := true
+		for i, l := range legs {
+			if b := c.bracket(c.c.notional(l.Amount, price)); b != tiers[i] {
+				tiers[i] = b
+				settled = false
+			}
+		}
+		if settled {
+			break
+		}
+	}
+	return price
+}
+
+// Apply return the position after an order is filled and the PnL it
+// realizes, the realized PnL and the commission are settled in the wallet
+func (c *Calculator) Apply(p Position, o Order) (res Position, realizedPnL float64) {
+	res = p.clone()
+	side := o.Side
+	if side == "" {
+		side = PositionSideBoth
+	}
+	i := -1
+	for j, l := range res.Legs {
+		if l.Side == side {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		res.Legs = append(res.Legs, Leg{Side: side})
+		i = len(res.Legs) - 1
+	}
+	l := &res.Legs[i]
+	switch {
+	case l.Amount == 0 || (l.Amount > 0) == (o.Quantity > 0):
+		if l.Amount == 0 {
+			l.EntryPrice = o.Price
+		} else {
+			l.EntryPrice = c.c.entry(l.Amount, l.EntryPrice, o.Quantity, o.Price)
+		}
+		l.Amount += o.Quantity
+	default:
+		closed := math.Min(math.Abs(o.Quantity), math.Abs(l.Amount))
+		realizedPnL = c.c.pnl(math.Copysign(closed, l.Amount), l.EntryPrice, o.Price)
+		if math.Abs(o.Quantity) > math.Abs(l.Amount) {
+			l.EntryPrice = o.Price
+		}
+		l.Amount += o.Quantity
+		if l.Amount == 0 {
+			l.EntryPrice = 0
+		}
+	}
+	res.WalletBalance += realizedPnL - c.c.notional(o.Quantity, o.Price)*o.FeeRate
+	return res, realizedPnL
+}
+
+// OrderImpact compute the margin of a position at a mark price before and
+// after an order is filled
+func (c *Calculator) OrderImpact(p Position, o Order, markPrice float64) (before, after Risk) {
+	next, _ := c.Apply(p, o)
+	return c.Risk(p, markPrice), c.Risk(next, markPrice)
+}
+
+// NewFuturesPosition build a position from the position risk of a USDⓈ-M
+// symbol, crossWalletBalance is used for a cross margin position
+func NewFuturesPosition(risks []*futures.PositionRisk, crossWalletBalance float64) Position {
+	p := Position{WalletBalance: crossWalletBalance}
+	for i, r := range risks {
+		if i == 0 {
+			p.Leverage = r.Leverage
+			p.Isolated = strings.EqualFold(string(r.MarginType), string(futures.MarginTypeIsolated))
+			if p.Isolated {
+				p.WalletBalance = 0
+			}
+		}
+		if p.Isolated {
+			p.WalletBalance += r.IsolatedWallet
+		}
+		if r.PositionAmt != 0 {
+			p.Legs = append(p.Legs, Leg{Side: PositionSide(r.PositionSide), Amount: r.PositionAmt, EntryPrice: r.EntryPrice})
+		}
+	}
+	return p
+}
+
+// NewDeliveryPosition build a position from the position risk of a COIN-M
+// symbol, crossWalletBalance is used for a cross margin position
+func NewDeliveryPosition(risks []*delivery.PositionRisk, crossWalletBalance float64) Position {
+	p := Position{WalletBalance: crossWalletBalance}
+	for i, r := range risks {
+		if i == 0 {
+			p.Leverage, _ = strconv.Atoi(r.Leverage)
+			p.Isolated = strings.EqualFold(r.MarginType, string(delivery.MarginTypeIsolated))
+			if p.Isolated {
+				p.WalletBalance = 0
+			}
+		}
+		if p.Isolated {
+			p.WalletBalance += parseFloat(r.IsolatedMargin)
+		}
+		if amount := parseFloat(r.PositionAmt); amount != 0 {
+			p.Legs = append(p.Legs, Leg{Side: PositionSide(r.PositionSide), Amount: amount, EntryPrice: parseFloat(r.EntryPrice)})
+		}
+	}
+	return p
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,123 @@
+package calculator_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/calculator"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+var usdmBrackets = []futures.Bracket{
+	{Bracket: 1, InitialLeverage: 125, NotionalFloor: 0, NotionalCap: 5000, MaintMarginRatio: 0.004, Cum: 0},
+	{Bracket: 2, InitialLeverage: 100, NotionalFloor: 5000, NotionalCap: 25000, MaintMarginRatio: 0.005, Cum: 5},
+	{Bracket: 3, InitialLeverage: 50, NotionalFloor: 25000, NotionalCap: 100000, MaintMarginRatio: 0.01, Cum: 130},
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6*math.Max(1, math.Abs(b))
+}
+
+func TestUSDMIsolatedLiquidationPrice(t *testing.T) {
+	c := calculator.NewUSDM(usdmBrackets)
+	long := calculator.Position{
+		Legs:          []calculator.Leg{{Side: calculator.PositionSideBoth, Amount: 1, EntryPrice: 10000}},
+		Leverage:      10,
+		Isolated:      true,
+		WalletBalance: 1000,
+	}
+	if p := c.LiquidationPrice(long); !near(p, 8995/0.995) {
+		t.Errorf("unexpected long liquidation price %v", p)
+	}
+
+	// the short is opened in the first bracket and liquidated in the second
+	short := calculator.Position{
+		Legs:          []calculator.Leg{{Side: calculator.PositionSideBoth, Amount: -1, EntryPrice: 4900}},
+		Isolated:      true,
+		WalletBalance: 490,
+	}
+	p := c.LiquidationPrice(short)
+	if !near(p, 5395/1.005) {
+		t.Errorf("unexpected short liquidation price %v", p)
+	}
+	if r := c.Risk(short, p); !near(r.MarginRatio, 1) {
+		t.Errorf("expected a margin ratio of 1 at the liquidation price, got %+v", r)
+	}
+}
+
+func TestUSDMCrossHedgeOrderImpact(t *testing.T) {
+	c := calculator.NewUSDM(usdmBrackets)
+	p := calculator.Position{
+		Legs: []calculator.Leg{
+			{Side: calculator.PositionSideLong, Amount: 2, EntryPrice: 10000},
+			{Side: calculator.PositionSideShort, Amount: -1, EntryPrice: 10000},
+		},
+		Leverage:           20,
+		WalletBalance:      3000,
+		OtherMaintMargin:   100,
+		OtherUnrealizedPnL: -50,
+	}
+	r := c.Risk(p, 11000)
+	if !near(r.UnrealizedPnL, 1000) || !near(r.InitialMargin, 33000.0/20) || !near(r.MaintMargin, 22000*0.005-5+11000*0.005-5+100) {
+		t.Fatalf("unexpected risk %+v", r)
+	}
+	if !near(r.MarginBalance, 3950) || !near(r.MarginRatio, r.MaintMargin/3950) {
+		t.Fatalf("unexpected margin balance %+v", r)
+	}
+	if r.LiquidationPrice == 0 || r.LiquidationPrice >= 10000 {
+		t.Fatalf("unexpected liquidation price %v", r.LiquidationPrice)
+	}
+
+	before, after := c.OrderImpact(p, calculator.Order{Side: calculator.PositionSideShort, Quantity: 1, Price: 11000, FeeRate: 0.0005}, 11000)
+	if before.MarginRatio != r.MarginRatio || after.Notional != 22000 {
+		t.Fatalf("unexpected impact %+v %+v", before, after)
+	}
+	next, pnl := c.Apply(p, calculator.Order{Side: calculator.PositionSideShort, Quantity: 1, Price: 11000, FeeRate: 0.0005})
+	if pnl != -1000 || !near(next.WalletBalance, 3000-1000-5.5) || next.Legs[1].Amount != 0 {
+		t.Fatalf("unexpected position %+v pnl %v", next, pnl)
+	}
+	if len(p.Legs) != 2 || p.Legs[1].Amount != -1 {
+		t.Fatalf("apply changed the position %+v", p)
+	}
+}
+
+func TestUSDMApplyFlip(t *testing.T) {
+	c := calculator.NewUSDM(usdmBrackets)
+	p := calculator.Position{Legs: []calculator.Leg{{Side: calculator.PositionSideBoth, Amount: 1, EntryPrice: 100}}}
+	p, _ = c.Apply(p, calculator.Order{Quantity: 1, Price: 200})
+	if p.Legs[0].Amount != 2 || p.Legs[0].EntryPrice != 150 {
+		t.Fatalf("unexpected leg %+v", p.Legs[0])
+	}
+	p, pnl := c.Apply(p, calculator.Order{Quantity: -3, Price: 160})
+	if pnl != 20 || p.Legs[0].Amount != -1 || p.Legs[0].EntryPrice != 160 {
+		t.Fatalf("unexpected leg %+v pnl %v", p.Legs[0], pnl)
+	}
+}
+
+func TestCOINMLiquidationPrice(t *testing.T) {
+	c := calculator.NewCOINM([]delivery.Bracket{
+		{Bracket: 1, InitialLeverage: 125, QtyFloor: 0, QtyCap: 5, MaintMarginRatio: 0.004, Cum: 0},
+		{Bracket: 2, InitialLeverage: 100, QtyFloor: 5, QtyCap: 10, MaintMarginRatio: 0.005, Cum: 0.005},
+	}, 100)
+	p := calculator.Position{
+		Legs:          []calculator.Leg{{Side: calculator.PositionSideBoth, Amount: 100, EntryPrice: 50000}},
+		Isolated:      true,
+		WalletBalance: 0.02,
+	}
+	liq := c.LiquidationPrice(p)
+	if !near(liq, 10040/0.22) {
+		t.Fatalf("unexpected liquidation price %v", liq)
+	}
+	r := c.Risk(p, 50000)
+	if !near(r.Notional, 0.2) || !near(r.InitialMargin, 0.2/125) || !near(r.MaintMargin, 0.0008) {
+		t.Fatalf("unexpected risk %+v", r)
+	}
+	next, pnl := c.Apply(p, calculator.Order{Quantity: -100, Price: 40000})
+	if !near(pnl, 10000*(1.0/50000-1.0/40000)) || next.Legs[0].Amount != 0 {
+		t.Fatalf("unexpected position %+v pnl %v", next, pnl)
+	}
+	if c.LiquidationPrice(next) != 0 {
+		t.Fatal("expected no liquidation price for a closed position")
+	}
+}
@@ -0,0 +1,127 @@
+package calculator
+
+import (
+	"math"
+
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// bracket define a maintenance margin tier, the bounds are in notional of
+// the margin asset
+type bracket struct {
+	floor    float64
+	cap      float64
+	mmr      float64
+	cum      float64
+	leverage int
+}
+
+// contract define the margin math of a contract kind
+type contract interface {
+	// notional return the value of a signed amount at price, in the margin asset
+	notional(amount, price float64) float64
+	// pnl return the PnL of a signed amount opened at entry and closed at price
+	pnl(amount, entry, price float64) float64
+	// entry return the average entry price of two amounts of the same side
+	entry(amount1, entry1, amount2, entry2 float64) float64
+	// liquidationPrice solve the price where the margin balance equals the
+	// maintenance margin of the legs, given their tiers
+	liquidationPrice(legs []Leg, tiers []bracket, balance float64) float64
+}
+
+// linear define USDⓈ-M contracts, amounts are in the base asset and the
+// margin in the quote asset
+type linear struct{}
+
+func (linear) notional(amount, price float64) float64 {
+	return math.Abs(amount) * price
+}
+
+func (linear) pnl(amount, entry, price float64) float64 {
+	return amount * (price - entry)
+}
+
+func (linear) entry(amount1, entry1, amount2, entry2 float64) float64 {
+	return (math.Abs(amount1)*entry1 + math.Abs(amount2)*entry2) / math.Abs(amount1+amount2)
+}
+
+// liquidationPrice solve
+// balance + Σ a(P - E) = Σ (|a| P mmr - cum)
+func (linear) liquidationPrice(legs []Leg, tiers []bracket, balance float64) float64 {
+	num, den := balance, 0.0
+	for i, l := range legs {
+		num += tiers[i].cum - l.Amount*l.EntryPrice
+		den += math.Abs(l.Amount)*tiers[i].mmr - l.Amount
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+// inverse define COIN-M contracts, amounts are in contracts of ContractSize
+// USD and the margin in the base asset
+type inverse struct {
+	size float64
+}
+
+func (c inverse) notional(amount, price float64) float64 {
+	if price == 0 {
+		return 0
+	}
+	return math.Abs(amount) * c.size / price
+}
+
+func (c inverse) pnl(amount, entry, price float64) float64 {
+	if entry == 0 || price == 0 {
+		return 0
+	}
+	return amount * c.size * (1/entry - 1/price)
+}
+
+func (inverse) entry(amount1, entry1, amount2, entry2 float64) float64 {
+	return math.Abs(amount1+amount2) / (math.Abs(amount1)/entry1 + math.Abs(amount2)/entry2)
+}
+
+// liquidationPrice solve
+// balance + Σ a S (1/E - 1/P) = Σ (|a| S mmr / P - cum)
+func (c inverse) liquidationPrice(legs []Leg, tiers []bracket, balance float64) float64 {
+	num, den := 0.0, balance
+	for i, l := range legs {
+		num += (math.Abs(l.Amount)*tiers[i].mmr + l.Amount) * c.size
+		den += tiers[i].cum + l.Amount*c.size/l.EntryPrice
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+func futuresBrackets(brackets []futures.Bracket) []bracket {
+	res := make([]bracket, 0, len(brackets))
+	for _, b := range brackets {
+		res = append(res, bracket{
+			floor:    b.NotionalFloor,
+			cap:      b.NotionalCap,
+			mmr:      b.MaintMarginRatio,
+			cum:      b.Cum,
+			leverage: b.InitialLeverage,
+		})
+	}
+	return res
+}
+
+func deliveryBrackets(brackets []delivery.Bracket) []bracket {
+	res := make([]bracket, 0, len(brackets))
+	for _, b := range brackets {
+		res = append(res, bracket{
+			floor:    b.QtyFloor,
+			cap:      b.QtyCap,
+			mmr:      b.MaintMarginRatio,
+			cum:      b.Cum,
+			leverage: b.InitialLeverage,
+		})
+	}
+	return res
+}
@@ -1,21 +1,16 @@
 package binance
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"crypto/tls"
-	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"time"
 
-	"github.com/uncle-gua/gobinance/common"
 	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/internal/transport"
 
 	"github.com/uncle-gua/gobinance/futures"
 
@@ -59,6 +54,15 @@ type MarginLoanStatusType string
 // MarginRepayStatusType define margin repay status type
 type MarginRepayStatusType string
 
+// MarginBorrowRepayType define whether a margin borrow-repay is a borrow or a repay
+type MarginBorrowRepayType string
+
+// IsolatedMarginAccountType define the account of an isolated margin transfer
+type IsolatedMarginAccountType string
+
+// MarginCapitalFlowType define the type of a margin capital flow
+type MarginCapitalFlowType string
+
 // FuturesTransferStatusType define futures transfer status type
 type FuturesTransferStatusType string
 
@@ -80,6 +84,33 @@ type StakingProduct string
 // StakingTransactionType define the staking transaction type (subscription, redemption, interest)
 type StakingTransactionType string
 
+// SimpleEarnAccountType define the account funding or receiving a Simple Earn subscription or redemption
+type SimpleEarnAccountType string
+
+// SimpleEarnRewardType define the type of Simple Earn flexible rewards
+type SimpleEarnRewardType string
+
+// ConvertWalletType define the wallet a convert is funded from
+type ConvertWalletType string
+
+// ConvertValidTimeType define how long a convert quote stays valid
+type ConvertValidTimeType string
+
+// ConvertExpiredType define how long a limit convert order stays open
+type ConvertExpiredType string
+
+// ConvertOrderStatusType define convert order status
+type ConvertOrderStatusType string
+
+// SubAccountFuturesType define the futures account of a sub-account
+type SubAccountFuturesType int
+
+// SubAccountFuturesTransferType define the direction of a sub-account futures transfer
+type SubAccountFuturesTransferType int
+
+// SubAccountApiIpRestrictionStatus define whether a sub-account API key is restricted to its IP list
+type SubAccountApiIpRestrictionStatus string
+
 // LiquidityOperationType define the type of adding/removing liquidity to a liquidity pool(COMBINATION, SINGLE)
 type LiquidityOperationType string
 
@@ -92,6 +123,12 @@ type LiquidityRewardType int
 // RewardClaimStatus define the status of claiming a reward
 type RewardClaimStatus int
 
+// CancelReplaceModeType define the behavior of cancelReplace when the cancel fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the result of each step of cancelReplace
+type CancelReplaceResultType string
+
 // RateLimitType define the rate limitation types
 // see https://github.com/binance/binance-spot-api-docs/blob/master/rest-api.md#enum-definitions
 type RateLimitType string
@@ -140,6 +177,13 @@ const (
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
 
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
 	SymbolTypeSpot SymbolType = "SPOT"
 
 	SymbolStatusTypePreTrading   SymbolStatusType = "PRE_TRADING"
@@ -177,6 +221,29 @@ const (
 	MarginRepayStatusTypeConfirmed MarginRepayStatusType = "CONFIRMED"
 	MarginRepayStatusTypeFailed    MarginRepayStatusType = "FAILED"
 
+	MarginBorrowRepayTypeBorrow MarginBorrowRepayType = "BORROW"
+	MarginBorrowRepayTypeRepay  MarginBorrowRepayType = "REPAY"
+
+	IsolatedMarginAccountTypeSpot           IsolatedMarginAccountType = "SPOT"
+	IsolatedMarginAccountTypeIsolatedMargin IsolatedMarginAccountType = "ISOLATED_MARGIN"
+
+	MarginCapitalFlowTypeTransfer            MarginCapitalFlowType = "TRANSFER"
+	MarginCapitalFlowTypeBorrow              MarginCapitalFlowType = "BORROW"
+	MarginCapitalFlowTypeRepay               MarginCapitalFlowType = "REPAY"
+	MarginCapitalFlowTypeBuyIncome           MarginCapitalFlowType = "BUY_INCOME"
+	MarginCapitalFlowTypeBuyExpense          MarginCapitalFlowType = "BUY_EXPENSE"
+	MarginCapitalFlowTypeSellIncome          MarginCapitalFlowType = "SELL_INCOME"
+	MarginCapitalFlowTypeSellExpense         MarginCapitalFlowType = "SELL_EXPENSE"
+	MarginCapitalFlowTypeTradingCommission   MarginCapitalFlowType = "TRADING_COMMISSION"
+	MarginCapitalFlowTypeBuyLiquidation      MarginCapitalFlowType = "BUY_LIQUIDATION"
+	MarginCapitalFlowTypeSellLiquidation     MarginCapitalFlowType = "SELL_LIQUIDATION"
+	MarginCapitalFlowTypeRepayLiquidation    MarginCapitalFlowType = "REPAY_LIQUIDATION"
+	MarginCapitalFlowTypeOtherLiquidation    MarginCapitalFlowType = "OTHER_LIQUIDATION"
+	MarginCapitalFlowTypeLiquidationFee      MarginCapitalFlowType = "LIQUIDATION_FEE"
+	MarginCapitalFlowTypeSmallBalanceConvert MarginCapitalFlowType = "SMALL_BALANCE_CONVERT"
+	MarginCapitalFlowTypeCommissionReturn    MarginCapitalFlowType = "COMMISSION_RETURN"
+	MarginCapitalFlowTypeSmallConvert        MarginCapitalFlowType = "SMALL_CONVERT"
+
 	FuturesTransferStatusTypePending   FuturesTransferStatusType = "PENDING"
 	FuturesTransferStatusTypeConfirmed FuturesTransferStatusType = "CONFIRMED"
 	FuturesTransferStatusTypeFailed    FuturesTransferStatusType = "FAILED"
@@ -197,10 +264,6 @@ const (
 	LiquidityOperationTypeCombination LiquidityOperationType = "COMBINATION"
 	LiquidityOperationTypeSingle      LiquidityOperationType = "SINGLE"
 
-	timestampKey  = "timestamp"
-	signatureKey  = "signature"
-	recvWindowKey = "recvWindow"
-
 	StakingProductLockedStaking       = "STAKING"
 	StakingProductFlexibleDeFiStaking = "F_DEFI"
 	StakingProductLockedDeFiStaking   = "L_DEFI"
@@ -209,6 +272,46 @@ const (
 	StakingTransactionTypeRedemption   = "REDEMPTION"
 	StakingTransactionTypeInterest     = "INTEREST"
 
+	SimpleEarnAccountTypeSpot SimpleEarnAccountType = "SPOT"
+	SimpleEarnAccountTypeFund SimpleEarnAccountType = "FUND"
+	SimpleEarnAccountTypeAll  SimpleEarnAccountType = "ALL"
+
+	SimpleEarnRewardTypeBonus    SimpleEarnRewardType = "BONUS"
+	SimpleEarnRewardTypeRealTime SimpleEarnRewardType = "REALTIME"
+	SimpleEarnRewardTypeRewards  SimpleEarnRewardType = "REWARDS"
+
+	ConvertWalletTypeSpot        ConvertWalletType = "SPOT"
+	ConvertWalletTypeFunding     ConvertWalletType = "FUNDING"
+	ConvertWalletTypeSpotFunding ConvertWalletType = "SPOT_FUNDING"
+
+	ConvertValidTimeType10s ConvertValidTimeType = "10s"
+	ConvertValidTimeType30s ConvertValidTimeType = "30s"
+	ConvertValidTimeType1m  ConvertValidTimeType = "1m"
+	ConvertValidTimeType2m  ConvertValidTimeType = "2m"
+
+	ConvertExpiredType1D  ConvertExpiredType = "1_D"
+	ConvertExpiredType3D  ConvertExpiredType = "3_D"
+	ConvertExpiredType7D  ConvertExpiredType = "7_D"
+	ConvertExpiredType30D ConvertExpiredType = "30_D"
+
+	ConvertOrderStatusTypeProcess       ConvertOrderStatusType = "PROCESS"
+	ConvertOrderStatusTypeAcceptSuccess ConvertOrderStatusType = "ACCEPT_SUCCESS"
+	ConvertOrderStatusTypeSuccess       ConvertOrderStatusType = "SUCCESS"
+	ConvertOrderStatusTypeFail          ConvertOrderStatusType = "FAIL"
+	ConvertOrderStatusTypeCanceled      ConvertOrderStatusType = "CANCELED"
+	ConvertOrderStatusTypeExpired       ConvertOrderStatusType = "EXPIRED"
+
+	SubAccountFuturesTypeUSDT SubAccountFuturesType = 1
+	SubAccountFuturesTypeCoin SubAccountFuturesType = 2
+
+	SubAccountFuturesTransferTypeSpotToUSDTFutures SubAccountFuturesTransferType = 1
+	SubAccountFuturesTransferTypeUSDTFuturesToSpot SubAccountFuturesTransferType = 2
+	SubAccountFuturesTransferTypeSpotToCoinFutures SubAccountFuturesTransferType = 3
+	SubAccountFuturesTransferTypeCoinFuturesToSpot SubAccountFuturesTransferType = 4
+
+	SubAccountApiIpRestrictionStatusUnrestricted SubAccountApiIpRestrictionStatus = "1"
+	SubAccountApiIpRestrictionStatusRestricted   SubAccountApiIpRestrictionStatus = "2"
+
 	SwappingStatusPending SwappingStatus = 0
 	SwappingStatusDone    SwappingStatus = 1
 	SwappingStatusFailed  SwappingStatus = 2
@@ -228,10 +331,6 @@ const (
 	RateLimitIntervalDay    RateLimitInterval = "DAY"
 )
 
-func currentTimestamp() int64 {
-	return FormatTimestamp(time.Now())
-}
-
 // FormatTimestamp formats a time into Unix timestamp in milliseconds, as requested by Binance.
 func FormatTimestamp(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
@@ -299,7 +398,7 @@ func NewDeliveryClient(apiKey, secretKey string) *delivery.Client {
 	return delivery.NewClient(apiKey, secretKey)
 }
 
-type doFunc func(req *http.Request) (*http.Response, error)
+type doFunc = transport.DoFunc
 
 // Client define API client
 type Client struct {
@@ -314,115 +413,22 @@ type Client struct {
 	do         doFunc
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
+func (c *Client) transport() *transport.Client {
+	return &transport.Client{
+		APIKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		BaseURL:    c.BaseURL,
+		HTTPClient: c.HTTPClient,
+		Debug:      c.Debug,
+		Logger:     c.Logger,
+		TimeOffset: c.TimeOffset,
+		Do:         c.do,
 	}
 }
 
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	// set request options from user
-	for _, opt := range opts {
-		opt(r)
-	}
-	err = r.validate()
-	if err != nil {
-		return err
-	}
-
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow)
-	}
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
-		if err != nil {
-			return err
-		}
-		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
-	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
-	return nil
-}
-
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
-	}
-	res, err := f(req)
-	if err != nil {
-		return []byte{}, err
-	}
-	data, err = io.ReadAll(res.Body)
-	if err != nil {
-		return []byte{}, err
-	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the retured error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, apiErr
-	}
-	return data, nil
+	data, _, err = c.transport().CallAPI(ctx, r, opts...)
+	return data, err
 }
 
 // NewPingService init ping service
@@ -480,6 +486,11 @@ func (c *Client) NewListSymbolTickerService() *ListSymbolTickerService {
 	return &ListSymbolTickerService{c: c}
 }
 
+// NewListTradingDayTickerService init listing trading day tickers
+func (c *Client) NewListTradingDayTickerService() *ListTradingDayTickerService {
+	return &ListTradingDayTickerService{c: c}
+}
+
 // NewCreateOrderService init creating order service
 func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
@@ -490,6 +501,31 @@ func (c *Client) NewCreateOCOService() *CreateOCOService {
 	return &CreateOCOService{c: c}
 }
 
+// NewCreateOrderListOCOService init creating order list OCO service
+func (c *Client) NewCreateOrderListOCOService() *CreateOrderListOCOService {
+	return &CreateOrderListOCOService{c: c}
+}
+
+// NewCreateOrderListOTOService init creating order list OTO service
+func (c *Client) NewCreateOrderListOTOService() *CreateOrderListOTOService {
+	return &CreateOrderListOTOService{c: c}
+}
+
+// NewCreateOrderListOTOCOService init creating order list OTOCO service
+func (c *Client) NewCreateOrderListOTOCOService() *CreateOrderListOTOCOService {
+	return &CreateOrderListOTOCOService{c: c}
+}
+
+// NewGetOrderListService init get order list service
+func (c *Client) NewGetOrderListService() *GetOrderListService {
+	return &GetOrderListService{c: c}
+}
+
+// NewListOrderListsService init list order lists service
+func (c *Client) NewListOrderListsService() *ListOrderListsService {
+	return &ListOrderListsService{c: c}
+}
+
 // NewCancelOCOService init cancel OCO service
 func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
@@ -500,6 +536,16 @@ func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
 }
 
+// NewCancelReplaceService init cancel replace service
+func (c *Client) NewCancelReplaceService() *CancelReplaceService {
+	return &CancelReplaceService{c: c, cancelReplaceMode: CancelReplaceModeTypeStopOnFailure}
+}
+
+// NewAmendKeepPriorityService init amend keep priority service
+func (c *Client) NewAmendKeepPriorityService() *AmendKeepPriorityService {
+	return &AmendKeepPriorityService{c: c}
+}
+
 // NewCancelOrderService init cancel order service
 func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
@@ -536,35 +582,147 @@ func (c *Client) NewGetAPIKeyPermission() *GetAPIKeyPermission {
 }
 
 // NewSavingFlexibleProductPositionsService get flexible products positions (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewGetSimpleEarnFlexiblePositionService
 func (c *Client) NewSavingFlexibleProductPositionsService() *SavingFlexibleProductPositionsService {
 	return &SavingFlexibleProductPositionsService{c: c}
 }
 
 // NewSavingFixedProjectPositionsService get fixed project positions (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewGetSimpleEarnLockedPositionService
 func (c *Client) NewSavingFixedProjectPositionsService() *SavingFixedProjectPositionsService {
 	return &SavingFixedProjectPositionsService{c: c}
 }
 
 // NewListSavingsFlexibleProductsService get flexible products list (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewListSimpleEarnFlexibleProductsService
 func (c *Client) NewListSavingsFlexibleProductsService() *ListSavingsFlexibleProductsService {
 	return &ListSavingsFlexibleProductsService{c: c}
 }
 
 // NewPurchaseSavingsFlexibleProductService purchase a flexible product (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewSubscribeSimpleEarnFlexibleProductService
 func (c *Client) NewPurchaseSavingsFlexibleProductService() *PurchaseSavingsFlexibleProductService {
 	return &PurchaseSavingsFlexibleProductService{c: c}
 }
 
 // NewRedeemSavingsFlexibleProductService redeem a flexible product (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewRedeemSimpleEarnFlexibleProductService
 func (c *Client) NewRedeemSavingsFlexibleProductService() *RedeemSavingsFlexibleProductService {
 	return &RedeemSavingsFlexibleProductService{c: c}
 }
 
 // NewListSavingsFixedAndActivityProductsService get fixed and activity product list (Savings)
+//
+// Deprecated: the savings endpoints are retired, use NewListSimpleEarnLockedProductsService
 func (c *Client) NewListSavingsFixedAndActivityProductsService() *ListSavingsFixedAndActivityProductsService {
 	return &ListSavingsFixedAndActivityProductsService{c: c}
 }
 
+// NewListSimpleEarnFlexibleProductsService init list Simple Earn flexible products service
+func (c *Client) NewListSimpleEarnFlexibleProductsService() *ListSimpleEarnFlexibleProductsService {
+	return &ListSimpleEarnFlexibleProductsService{c: c}
+}
+
+// NewListSimpleEarnLockedProductsService init list Simple Earn locked products service
+func (c *Client) NewListSimpleEarnLockedProductsService() *ListSimpleEarnLockedProductsService {
+	return &ListSimpleEarnLockedProductsService{c: c}
+}
+
+// NewSubscribeSimpleEarnFlexibleProductService init subscribe Simple Earn flexible product service
+func (c *Client) NewSubscribeSimpleEarnFlexibleProductService() *SubscribeSimpleEarnFlexibleProductService {
+	return &SubscribeSimpleEarnFlexibleProductService{c: c}
+}
+
+// NewSubscribeSimpleEarnLockedProductService init subscribe Simple Earn locked product service
+func (c *Client) NewSubscribeSimpleEarnLockedProductService() *SubscribeSimpleEarnLockedProductService {
+	return &SubscribeSimpleEarnLockedProductService{c: c}
+}
+
+// NewRedeemSimpleEarnFlexibleProductService init redeem Simple Earn flexible product service
+func (c *Client) NewRedeemSimpleEarnFlexibleProductService() *RedeemSimpleEarnFlexibleProductService {
+	return &RedeemSimpleEarnFlexibleProductService{c: c}
+}
+
+// NewRedeemSimpleEarnLockedProductService init redeem Simple Earn locked product service
+func (c *Client) NewRedeemSimpleEarnLockedProductService() *RedeemSimpleEarnLockedProductService {
+	return &RedeemSimpleEarnLockedProductService{c: c}
+}
+
+// NewGetSimpleEarnFlexiblePositionService init get Simple Earn flexible position service
+func (c *Client) NewGetSimpleEarnFlexiblePositionService() *GetSimpleEarnFlexiblePositionService {
+	return &GetSimpleEarnFlexiblePositionService{c: c}
+}
+
+// NewGetSimpleEarnLockedPositionService init get Simple Earn locked position service
+func (c *Client) NewGetSimpleEarnLockedPositionService() *GetSimpleEarnLockedPositionService {
+	return &GetSimpleEarnLockedPositionService{c: c}
+}
+
+// NewGetSimpleEarnAccountService init get Simple Earn account service
+func (c *Client) NewGetSimpleEarnAccountService() *GetSimpleEarnAccountService {
+	return &GetSimpleEarnAccountService{c: c}
+}
+
+// NewGetSimpleEarnFlexiblePersonalLeftQuotaService init get Simple Earn flexible personal left quota service
+func (c *Client) NewGetSimpleEarnFlexiblePersonalLeftQuotaService() *GetSimpleEarnFlexiblePersonalLeftQuotaService {
+	return &GetSimpleEarnFlexiblePersonalLeftQuotaService{c: c}
+}
+
+// NewGetSimpleEarnLockedPersonalLeftQuotaService init get Simple Earn locked personal left quota service
+func (c *Client) NewGetSimpleEarnLockedPersonalLeftQuotaService() *GetSimpleEarnLockedPersonalLeftQuotaService {
+	return &GetSimpleEarnLockedPersonalLeftQuotaService{c: c}
+}
+
+// NewSetSimpleEarnFlexibleAutoSubscribeService init set Simple Earn flexible auto subscribe service
+func (c *Client) NewSetSimpleEarnFlexibleAutoSubscribeService() *SetSimpleEarnFlexibleAutoSubscribeService {
+	return &SetSimpleEarnFlexibleAutoSubscribeService{c: c}
+}
+
+// NewSetSimpleEarnLockedAutoSubscribeService init set Simple Earn locked auto subscribe service
+func (c *Client) NewSetSimpleEarnLockedAutoSubscribeService() *SetSimpleEarnLockedAutoSubscribeService {
+	return &SetSimpleEarnLockedAutoSubscribeService{c: c}
+}
+
+// NewListSimpleEarnFlexibleSubscriptionRecordService init list Simple Earn flexible subscription record service
+func (c *Client) NewListSimpleEarnFlexibleSubscriptionRecordService() *ListSimpleEarnFlexibleSubscriptionRecordService {
+	return &ListSimpleEarnFlexibleSubscriptionRecordService{c: c}
+}
+
+// NewListSimpleEarnLockedSubscriptionRecordService init list Simple Earn locked subscription record service
+func (c *Client) NewListSimpleEarnLockedSubscriptionRecordService() *ListSimpleEarnLockedSubscriptionRecordService {
+	return &ListSimpleEarnLockedSubscriptionRecordService{c: c}
+}
+
+// NewListSimpleEarnFlexibleRedemptionRecordService init list Simple Earn flexible redemption record service
+func (c *Client) NewListSimpleEarnFlexibleRedemptionRecordService() *ListSimpleEarnFlexibleRedemptionRecordService {
+	return &ListSimpleEarnFlexibleRedemptionRecordService{c: c}
+}
+
+// NewListSimpleEarnLockedRedemptionRecordService init list Simple Earn locked redemption record service
+func (c *Client) NewListSimpleEarnLockedRedemptionRecordService() *ListSimpleEarnLockedRedemptionRecordService {
+	return &ListSimpleEarnLockedRedemptionRecordService{c: c}
+}
+
+// NewListSimpleEarnFlexibleRewardsRecordService init list Simple Earn flexible rewards record service
+func (c *Client) NewListSimpleEarnFlexibleRewardsRecordService() *ListSimpleEarnFlexibleRewardsRecordService {
+	return &ListSimpleEarnFlexibleRewardsRecordService{c: c}
+}
+
+// NewListSimpleEarnLockedRewardsRecordService init list Simple Earn locked rewards record service
+func (c *Client) NewListSimpleEarnLockedRewardsRecordService() *ListSimpleEarnLockedRewardsRecordService {
+	return &ListSimpleEarnLockedRewardsRecordService{c: c}
+}
+
+// NewListSimpleEarnFlexibleCollateralRecordService init list Simple Earn flexible collateral record service
+func (c *Client) NewListSimpleEarnFlexibleCollateralRecordService() *ListSimpleEarnFlexibleCollateralRecordService {
+	return &ListSimpleEarnFlexibleCollateralRecordService{c: c}
+}
+
 // NewGetAccountSnapshotService init getting account snapshot service
 func (c *Client) NewGetAccountSnapshotService() *GetAccountSnapshotService {
 	return &GetAccountSnapshotService{c: c}
@@ -641,15 +799,79 @@ func (c *Client) NewMarginTransferService() *MarginTransferService {
 }
 
 // NewMarginLoanService init margin account loan service
+//
+// Deprecated: use NewMarginBorrowRepayService
 func (c *Client) NewMarginLoanService() *MarginLoanService {
 	return &MarginLoanService{c: c}
 }
 
 // NewMarginRepayService init margin account repay service
+//
+// Deprecated: use NewMarginBorrowRepayService
 func (c *Client) NewMarginRepayService() *MarginRepayService {
 	return &MarginRepayService{c: c}
 }
 
+// NewMarginBorrowRepayService init margin borrow-repay service
+func (c *Client) NewMarginBorrowRepayService() *MarginBorrowRepayService {
+	return &MarginBorrowRepayService{c: c}
+}
+
+// NewListMarginBorrowRepayService init list margin borrow-repay service
+func (c *Client) NewListMarginBorrowRepayService() *ListMarginBorrowRepayService {
+	return &ListMarginBorrowRepayService{c: c}
+}
+
+// NewIsolatedMarginTransferService init isolated margin transfer service
+func (c *Client) NewIsolatedMarginTransferService() *IsolatedMarginTransferService {
+	return &IsolatedMarginTransferService{c: c}
+}
+
+// NewEnableIsolatedMarginAccountService init enable isolated margin account service
+func (c *Client) NewEnableIsolatedMarginAccountService() *EnableIsolatedMarginAccountService {
+	return &EnableIsolatedMarginAccountService{c: c}
+}
+
+// NewDisableIsolatedMarginAccountService init disable isolated margin account service
+func (c *Client) NewDisableIsolatedMarginAccountService() *DisableIsolatedMarginAccountService {
+	return &DisableIsolatedMarginAccountService{c: c}
+}
+
+// NewGetIsolatedMarginAccountLimitService init get isolated margin account limit service
+func (c *Client) NewGetIsolatedMarginAccountLimitService() *GetIsolatedMarginAccountLimitService {
+	return &GetIsolatedMarginAccountLimitService{c: c}
+}
+
+// NewListMarginInterestRateHistoryService init list margin interest rate history service
+func (c *Client) NewListMarginInterestRateHistoryService() *ListMarginInterestRateHistoryService {
+	return &ListMarginInterestRateHistoryService{c: c}
+}
+
+// NewListMarginForceLiquidationService init list margin force liquidation service
+func (c *Client) NewListMarginForceLiquidationService() *ListMarginForceLiquidationService {
+	return &ListMarginForceLiquidationService{c: c}
+}
+
+// NewGetCrossMarginCollateralRatioService init get cross margin collateral ratio service
+func (c *Client) NewGetCrossMarginCollateralRatioService() *GetCrossMarginCollateralRatioService {
+	return &GetCrossMarginCollateralRatioService{c: c}
+}
+
+// NewGetMarginTradeCoeffService init get margin trade coeff service
+func (c *Client) NewGetMarginTradeCoeffService() *GetMarginTradeCoeffService {
+	return &GetMarginTradeCoeffService{c: c}
+}
+
+// NewSetMarginMaxLeverageService init set margin max leverage service
+func (c *Client) NewSetMarginMaxLeverageService() *SetMarginMaxLeverageService {
+	return &SetMarginMaxLeverageService{c: c}
+}
+
+// NewListMarginCapitalFlowService init list margin capital flow service
+func (c *Client) NewListMarginCapitalFlowService() *ListMarginCapitalFlowService {
+	return &ListMarginCapitalFlowService{c: c}
+}
+
 // NewCreateMarginOrderService init creating margin order service
 func (c *Client) NewCreateMarginOrderService() *CreateMarginOrderService {
 	return &CreateMarginOrderService{c: c}
@@ -665,6 +887,31 @@ func (c *Client) NewCreateMarginOCOService() *CreateMarginOCOService {
 	return &CreateMarginOCOService{c: c}
 }
 
+// NewCreateMarginOTOService init creating margin OTO service
+func (c *Client) NewCreateMarginOTOService() *CreateMarginOTOService {
+	return &CreateMarginOTOService{c: c}
+}
+
+// NewCreateMarginOTOCOService init creating margin OTOCO service
+func (c *Client) NewCreateMarginOTOCOService() *CreateMarginOTOCOService {
+	return &CreateMarginOTOCOService{c: c}
+}
+
+// NewGetMarginOrderListService init get margin order list service
+func (c *Client) NewGetMarginOrderListService() *GetMarginOrderListService {
+	return &GetMarginOrderListService{c: c}
+}
+
+// NewListMarginOrderListsService init list margin order lists service
+func (c *Client) NewListMarginOrderListsService() *ListMarginOrderListsService {
+	return &ListMarginOrderListsService{c: c}
+}
+
+// NewListMarginOpenOrderListsService init list margin open order lists service
+func (c *Client) NewListMarginOpenOrderListsService() *ListMarginOpenOrderListsService {
+	return &ListMarginOpenOrderListsService{c: c}
+}
+
 // NewCancelMarginOCOService init cancel order service
 func (c *Client) NewCancelMarginOCOService() *CancelMarginOCOService {
 	return &CancelMarginOCOService{c: c}
@@ -676,11 +923,15 @@ func (c *Client) NewGetMarginOrderService() *GetMarginOrderService {
 }
 
 // NewListMarginLoansService init list margin loan service
+//
+// Deprecated: use NewListMarginBorrowRepayService
 func (c *Client) NewListMarginLoansService() *ListMarginLoansService {
 	return &ListMarginLoansService{c: c}
 }
 
 // NewListMarginRepaysService init list margin repay service
+//
+// Deprecated: use NewListMarginBorrowRepayService
 func (c *Client) NewListMarginRepaysService() *ListMarginRepaysService {
 	return &ListMarginRepaysService{c: c}
 }
@@ -715,6 +966,40 @@ func (c *Client) NewGetMarginPriceIndexService() *GetMarginPriceIndexService {
 	return &GetMarginPriceIndexService{c: c}
 }
 
+// NewWithdrawGuard init withdraw guard, add the allowed addresses and the
+// limits of the coins and set the audit log before use
+func (c *Client) NewWithdrawGuard() *WithdrawGuard {
+	return &WithdrawGuard{
+		c:      c,
+		limits: make(map[string]WithdrawLimit),
+		now:    time.Now,
+	}
+}
+
+// NewTransferWatcher init transfer watcher of the deposits and the withdrawals
+func (c *Client) NewTransferWatcher() *TransferWatcher {
+	return &TransferWatcher{
+		c:           c,
+		subscribers: make(map[int]func(event *TransferEvent)),
+		trigger:     make(chan struct{}, 1),
+		now:         time.Now,
+	}
+}
+
+// NewMarginRiskMonitor init margin risk monitor of the cross margin account
+// and of the isolated symbols
+func (c *Client) NewMarginRiskMonitor(isolatedSymbols ...string) *MarginRiskMonitor {
+	return &MarginRiskMonitor{
+		c:               c,
+		isolatedSymbols: isolatedSymbols,
+		cross:           make(map[string]*MarginBalance),
+		prices:          make(map[string]float64),
+		isolated:        make(map[string]*isolatedMarginPair),
+		risks:           make(map[string]*MarginRisk),
+		breached:        make(map[MarginAlertRule]bool),
+	}
+}
+
 // NewListMarginOpenOrdersService init list margin open orders service
 func (c *Client) NewListMarginOpenOrdersService() *ListMarginOpenOrdersService {
 	return &ListMarginOpenOrdersService{c: c}
@@ -855,12 +1140,56 @@ func (c *Client) NewConvertTradeHistoryService() *ConvertTradeHistoryService {
 	return &ConvertTradeHistoryService{c: c}
 }
 
+// NewConvertExchangeInfoService init the convert exchange info service
+func (c *Client) NewConvertExchangeInfoService() *ConvertExchangeInfoService {
+	return &ConvertExchangeInfoService{c: c}
+}
+
+// NewConvertAssetInfoService init the convert asset info service
+func (c *Client) NewConvertAssetInfoService() *ConvertAssetInfoService {
+	return &ConvertAssetInfoService{c: c}
+}
+
+// NewConvertGetQuoteService init the convert get quote service
+func (c *Client) NewConvertGetQuoteService() *ConvertGetQuoteService {
+	return &ConvertGetQuoteService{c: c}
+}
+
+// NewConvertAcceptQuoteService init the convert accept quote service
+func (c *Client) NewConvertAcceptQuoteService() *ConvertAcceptQuoteService {
+	return &ConvertAcceptQuoteService{c: c}
+}
+
+// NewConvertOrderStatusService init the convert order status service
+func (c *Client) NewConvertOrderStatusService() *ConvertOrderStatusService {
+	return &ConvertOrderStatusService{c: c}
+}
+
+// NewConvertPlaceLimitOrderService init the convert place limit order service
+func (c *Client) NewConvertPlaceLimitOrderService() *ConvertPlaceLimitOrderService {
+	return &ConvertPlaceLimitOrderService{c: c}
+}
+
+// NewConvertCancelLimitOrderService init the convert cancel limit order service
+func (c *Client) NewConvertCancelLimitOrderService() *ConvertCancelLimitOrderService {
+	return &ConvertCancelLimitOrderService{c: c}
+}
+
+// NewConvertListOpenLimitOrdersService init the convert list open limit orders service
+func (c *Client) NewConvertListOpenLimitOrdersService() *ConvertListOpenLimitOrdersService {
+	return &ConvertListOpenLimitOrdersService{c: c}
+}
+
 // NewGetIsolatedMarginAllPairsService init get isolated margin all pairs service
 func (c *Client) NewGetIsolatedMarginAllPairsService() *GetIsolatedMarginAllPairsService {
 	return &GetIsolatedMarginAllPairsService{c: c}
 }
 
 // NewInterestHistoryService init the interest history service
+//
+// Deprecated: the lending endpoints are retired, use
+// NewListSimpleEarnFlexibleRewardsRecordService and
+// NewListSimpleEarnLockedRewardsRecordService
 func (c *Client) NewInterestHistoryService() *InterestHistoryService {
 	return &InterestHistoryService{c: c}
 }
@@ -960,6 +1289,76 @@ func (c *Client) NewSubAccountListService() *SubAccountListService {
 	return &SubAccountListService{c: c}
 }
 
+// NewCreateVirtualSubAccountService init create virtual sub-account service
+func (c *Client) NewCreateVirtualSubAccountService() *CreateVirtualSubAccountService {
+	return &CreateVirtualSubAccountService{c: c}
+}
+
+// NewEnableSubAccountFuturesService init enable sub-account futures service
+func (c *Client) NewEnableSubAccountFuturesService() *EnableSubAccountFuturesService {
+	return &EnableSubAccountFuturesService{c: c}
+}
+
+// NewEnableSubAccountMarginService init enable sub-account margin service
+func (c *Client) NewEnableSubAccountMarginService() *EnableSubAccountMarginService {
+	return &EnableSubAccountMarginService{c: c}
+}
+
+// NewGetSubAccountApiIpRestrictionService init get sub-account API key IP restriction service
+func (c *Client) NewGetSubAccountApiIpRestrictionService() *GetSubAccountApiIpRestrictionService {
+	return &GetSubAccountApiIpRestrictionService{c: c}
+}
+
+// NewAddSubAccountApiIpRestrictionService init add sub-account API key IP restriction service
+func (c *Client) NewAddSubAccountApiIpRestrictionService() *AddSubAccountApiIpRestrictionService {
+	return &AddSubAccountApiIpRestrictionService{c: c}
+}
+
+// NewDeleteSubAccountApiIpRestrictionService init delete sub-account API key IP restriction service
+func (c *Client) NewDeleteSubAccountApiIpRestrictionService() *DeleteSubAccountApiIpRestrictionService {
+	return &DeleteSubAccountApiIpRestrictionService{c: c}
+}
+
+// NewSubAccountFuturesInternalTransferService init sub-account futures internal transfer service
+func (c *Client) NewSubAccountFuturesInternalTransferService() *SubAccountFuturesInternalTransferService {
+	return &SubAccountFuturesInternalTransferService{c: c}
+}
+
+// NewSubAccountFuturesTransferService init sub-account futures transfer service
+func (c *Client) NewSubAccountFuturesTransferService() *SubAccountFuturesTransferService {
+	return &SubAccountFuturesTransferService{c: c}
+}
+
+// NewSubAccountFuturesAccountSummaryService init sub-account futures account summary service
+func (c *Client) NewSubAccountFuturesAccountSummaryService() *SubAccountFuturesAccountSummaryService {
+	return &SubAccountFuturesAccountSummaryService{c: c}
+}
+
+// NewSubAccountFuturesPositionRiskService init sub-account futures position risk service
+func (c *Client) NewSubAccountFuturesPositionRiskService() *SubAccountFuturesPositionRiskService {
+	return &SubAccountFuturesPositionRiskService{c: c}
+}
+
+// NewSubAccountMarginAccountSummaryService init sub-account margin account summary service
+func (c *Client) NewSubAccountMarginAccountSummaryService() *SubAccountMarginAccountSummaryService {
+	return &SubAccountMarginAccountSummaryService{c: c}
+}
+
+// NewSubAccountMarginAccountService init sub-account margin account service
+func (c *Client) NewSubAccountMarginAccountService() *SubAccountMarginAccountService {
+	return &SubAccountMarginAccountService{c: c}
+}
+
+// NewSubAccountDepositHistoryService init sub-account deposit history service
+func (c *Client) NewSubAccountDepositHistoryService() *SubAccountDepositHistoryService {
+	return &SubAccountDepositHistoryService{c: c}
+}
+
+// NewSubAccountConsolidatedBalanceService init sub-account consolidated balance service
+func (c *Client) NewSubAccountConsolidatedBalanceService() *SubAccountConsolidatedBalanceService {
+	return &SubAccountConsolidatedBalanceService{c: c}
+}
+
 // NewGetUserAsset Get user assets, just for positive data
 func (c *Client) NewGetUserAsset() *GetUserAssetService {
 	return &GetUserAssetService{c: c}
@@ -1,13 +1,19 @@
 package common
 
 import (
+	stdjson "encoding/json"
 	"fmt"
+	"time"
 )
 
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
-	Code    int64  `json:"code"`
-	Message string `json:"msg"`
+	Code    int64              `json:"code"`
+	Message string             `json:"msg"`
+	Data    stdjson.RawMessage `json:"data,omitempty"` // partial results of some endpoints, e.g. cancelReplace
+	// RetryAfter is the Retry-After header of a rate limited (429) or
+	// banned (418) request, zero otherwise
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error return error code and message
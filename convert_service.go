@@ -0,0 +1,477 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrConvertQuoteExpired is returned when accepting a quote past its valid time
+var ErrConvertQuoteExpired = errors.New("convert quote expired")
+
+// ConvertExchangeInfoService list the convertible pairs and their limits
+type ConvertExchangeInfoService struct {
+	c         *Client
+	fromAsset *string
+	toAsset   *string
+}
+
+// FromAsset set fromAsset
+func (s *ConvertExchangeInfoService) FromAsset(fromAsset string) *ConvertExchangeInfoService {
+	s.fromAsset = &fromAsset
+	return s
+}
+
+// ToAsset set toAsset
+func (s *ConvertExchangeInfoService) ToAsset(toAsset string) *ConvertExchangeInfoService {
+	s.toAsset = &toAsset
+	return s
+}
+
+// Do send request
+func (s *ConvertExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertExchangeInfo, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/convert/exchangeInfo",
+		SecType:  secTypeNone,
+	}
+	if s.fromAsset != nil {
+		r.SetParam("fromAsset", *s.fromAsset)
+	}
+	if s.toAsset != nil {
+		r.SetParam("toAsset", *s.toAsset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertExchangeInfo{}, err
+	}
+	res = make([]*ConvertExchangeInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConvertExchangeInfo{}, err
+	}
+	return res, nil
+}
+
+// ConvertExchangeInfo define a convertible pair
+type ConvertExchangeInfo struct {
+	FromAsset          string `json:"fromAsset"`
+	ToAsset            string `json:"toAsset"`
+	FromAssetMinAmount string `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount string `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   string `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   string `json:"toAssetMaxAmount"`
+}
+
+// ConvertAssetInfoService list the precision of the convertible assets
+type ConvertAssetInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ConvertAssetInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertAssetInfo, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/convert/assetInfo",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertAssetInfo{}, err
+	}
+	res = make([]*ConvertAssetInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConvertAssetInfo{}, err
+	}
+	return res, nil
+}
+
+// ConvertAssetInfo define the precision of an asset, Fraction is the number
+// of decimals accepted in amounts
+type ConvertAssetInfo struct {
+	Asset    string `json:"asset"`
+	Fraction int    `json:"fraction"`
+}
+
+// ConvertGetQuoteService request a quote, either fromAmount or toAmount is required
+type ConvertGetQuoteService struct {
+	c          *Client
+	fromAsset  string
+	toAsset    string
+	fromAmount *string
+	toAmount   *string
+	walletType *ConvertWalletType
+	validTime  *ConvertValidTimeType
+}
+
+// FromAsset set fromAsset
+func (s *ConvertGetQuoteService) FromAsset(fromAsset string) *ConvertGetQuoteService {
+	s.fromAsset = fromAsset
+	return s
+}
+
+// ToAsset set toAsset
+func (s *ConvertGetQuoteService) ToAsset(toAsset string) *ConvertGetQuoteService {
+	s.toAsset = toAsset
+	return s
+}
+
+// FromAmount set fromAmount, the amount deducted after the trade
+func (s *ConvertGetQuoteService) FromAmount(fromAmount string) *ConvertGetQuoteService {
+	s.fromAmount = &fromAmount
+	return s
+}
+
+// ToAmount set toAmount, the amount credited after the trade
+func (s *ConvertGetQuoteService) ToAmount(toAmount string) *ConvertGetQuoteService {
+	s.toAmount = &toAmount
+	return s
+}
+
+// WalletType set walletType, default SPOT
+func (s *ConvertGetQuoteService) WalletType(walletType ConvertWalletType) *ConvertGetQuoteService {
+	s.walletType = &walletType
+	return s
+}
+
+// ValidTime set validTime, default 10s
+func (s *ConvertGetQuoteService) ValidTime(validTime ConvertValidTimeType) *ConvertGetQuoteService {
+	s.validTime = &validTime
+	return s
+}
+
+// Do send request
+func (s *ConvertGetQuoteService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertQuote, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/convert/getQuote",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("fromAsset", s.fromAsset)
+	r.SetFormParam("toAsset", s.toAsset)
+	if s.fromAmount != nil {
+		r.SetFormParam("fromAmount", *s.fromAmount)
+	}
+	if s.toAmount != nil {
+		r.SetFormParam("toAmount", *s.toAmount)
+	}
+	if s.walletType != nil {
+		r.SetFormParam("walletType", *s.walletType)
+	}
+	if s.validTime != nil {
+		r.SetFormParam("validTime", *s.validTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertQuote)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertQuote define a quote, it can only be accepted before ValidTimestamp
+type ConvertQuote struct {
+	QuoteId        string `json:"quoteId"`
+	Ratio          string `json:"ratio"`
+	InverseRatio   string `json:"inverseRatio"`
+	ValidTimestamp int64  `json:"validTimestamp"`
+	ToAmount       string `json:"toAmount"`
+	FromAmount     string `json:"fromAmount"`
+}
+
+// ExpiresAt return the time the quote expires at
+func (q *ConvertQuote) ExpiresAt() time.Time {
+	return time.UnixMilli(q.ValidTimestamp)
+}
+
+// TimeLeft return the time left at now before the quote expires, negative
+// once expired
+func (q *ConvertQuote) TimeLeft(now time.Time) time.Duration {
+	return q.ExpiresAt().Sub(now)
+}
+
+// Expired return whether the quote is expired at now
+func (q *ConvertQuote) Expired(now time.Time) bool {
+	return q.TimeLeft(now) <= 0
+}
+
+// ConvertAcceptQuoteService accept a quote
+type ConvertAcceptQuoteService struct {
+	c       *Client
+	quoteId string
+	quote   *ConvertQuote
+}
+
+// QuoteId set quoteId
+func (s *ConvertAcceptQuoteService) QuoteId(quoteId string) *ConvertAcceptQuoteService {
+	s.quoteId = quoteId
+	s.quote = nil
+	return s
+}
+
+// Quote set the quote to accept, Do then fails with ErrConvertQuoteExpired
+// without sending the request once the quote is expired
+func (s *ConvertAcceptQuoteService) Quote(quote *ConvertQuote) *ConvertAcceptQuoteService {
+	s.quoteId = quote.QuoteId
+	s.quote = quote
+	return s
+}
+
+// Do send request
+func (s *ConvertAcceptQuoteService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertAcceptQuoteResponse, err error) {
+	if s.quote != nil && s.quote.Expired(time.Now()) {
+		return nil, ErrConvertQuoteExpired
+	}
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/convert/acceptQuote",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("quoteId", s.quoteId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertAcceptQuoteResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertAcceptQuoteResponse define accept quote response
+type ConvertAcceptQuoteResponse struct {
+	OrderId     string                 `json:"orderId"`
+	CreateTime  int64                  `json:"createTime"`
+	OrderStatus ConvertOrderStatusType `json:"orderStatus"`
+}
+
+// ConvertOrderStatusService get the status of a convert order, either
+// orderId or quoteId is required
+type ConvertOrderStatusService struct {
+	c       *Client
+	orderId *string
+	quoteId *string
+}
+
+// OrderId set orderId
+func (s *ConvertOrderStatusService) OrderId(orderId string) *ConvertOrderStatusService {
+	s.orderId = &orderId
+	return s
+}
+
+// QuoteId set quoteId
+func (s *ConvertOrderStatusService) QuoteId(quoteId string) *ConvertOrderStatusService {
+	s.quoteId = &quoteId
+	return s
+}
+
+// Do send request
+func (s *ConvertOrderStatusService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertOrder, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/convert/orderStatus",
+		SecType:  secTypeSigned,
+	}
+	if s.orderId != nil {
+		r.SetParam("orderId", *s.orderId)
+	}
+	if s.quoteId != nil {
+		r.SetParam("quoteId", *s.quoteId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertOrder define a convert order, ExpiredTimestamp is only set for
+// limit orders
+type ConvertOrder struct {
+	QuoteId          string                 `json:"quoteId"`
+	OrderId          int64                  `json:"orderId"`
+	OrderStatus      ConvertOrderStatusType `json:"orderStatus"`
+	FromAsset        string                 `json:"fromAsset"`
+	FromAmount       string                 `json:"fromAmount"`
+	ToAsset          string                 `json:"toAsset"`
+	ToAmount         string                 `json:"toAmount"`
+	Ratio            string                 `json:"ratio"`
+	InverseRatio     string                 `json:"inverseRatio"`
+	CreateTime       int64                  `json:"createTime"`
+	ExpiredTimestamp int64                  `json:"expiredTimestamp"`
+}
+
+// ConvertPlaceLimitOrderService place a limit convert order, either
+// baseAmount or quoteAmount is required
+type ConvertPlaceLimitOrderService struct {
+	c           *Client
+	baseAsset   string
+	quoteAsset  string
+	limitPrice  string
+	baseAmount  *string
+	quoteAmount *string
+	side        SideType
+	walletType  *ConvertWalletType
+	expiredType ConvertExpiredType
+}
+
+// BaseAsset set baseAsset
+func (s *ConvertPlaceLimitOrderService) BaseAsset(baseAsset string) *ConvertPlaceLimitOrderService {
+	s.baseAsset = baseAsset
+	return s
+}
+
+// QuoteAsset set quoteAsset
+func (s *ConvertPlaceLimitOrderService) QuoteAsset(quoteAsset string) *ConvertPlaceLimitOrderService {
+	s.quoteAsset = quoteAsset
+	return s
+}
+
+// LimitPrice set limitPrice, expressed in quoteAsset
+func (s *ConvertPlaceLimitOrderService) LimitPrice(limitPrice string) *ConvertPlaceLimitOrderService {
+	s.limitPrice = limitPrice
+	return s
+}
+
+// BaseAmount set baseAmount
+func (s *ConvertPlaceLimitOrderService) BaseAmount(baseAmount string) *ConvertPlaceLimitOrderService {
+	s.baseAmount = &baseAmount
+	return s
+}
+
+// QuoteAmount set quoteAmount
+func (s *ConvertPlaceLimitOrderService) QuoteAmount(quoteAmount string) *ConvertPlaceLimitOrderService {
+	s.quoteAmount = &quoteAmount
+	return s
+}
+
+// Side set side
+func (s *ConvertPlaceLimitOrderService) Side(side SideType) *ConvertPlaceLimitOrderService {
+	s.side = side
+	return s
+}
+
+// WalletType set walletType, default SPOT
+func (s *ConvertPlaceLimitOrderService) WalletType(walletType ConvertWalletType) *ConvertPlaceLimitOrderService {
+	s.walletType = &walletType
+	return s
+}
+
+// ExpiredType set expiredType
+func (s *ConvertPlaceLimitOrderService) ExpiredType(expiredType ConvertExpiredType) *ConvertPlaceLimitOrderService {
+	s.expiredType = expiredType
+	return s
+}
+
+// Do send request
+func (s *ConvertPlaceLimitOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertLimitOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/convert/limit/placeOrder",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"baseAsset":   s.baseAsset,
+		"quoteAsset":  s.quoteAsset,
+		"limitPrice":  s.limitPrice,
+		"side":        s.side,
+		"expiredType": s.expiredType,
+	})
+	if s.baseAmount != nil {
+		r.SetFormParam("baseAmount", *s.baseAmount)
+	}
+	if s.quoteAmount != nil {
+		r.SetFormParam("quoteAmount", *s.quoteAmount)
+	}
+	if s.walletType != nil {
+		r.SetFormParam("walletType", *s.walletType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertLimitOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertLimitOrderResponse define place or cancel limit order response
+type ConvertLimitOrderResponse struct {
+	QuoteId string                 `json:"quoteId"`
+	OrderId int64                  `json:"orderId"`
+	Status  ConvertOrderStatusType `json:"status"`
+}
+
+// ConvertCancelLimitOrderService cancel a limit convert order
+type ConvertCancelLimitOrderService struct {
+	c       *Client
+	orderId int64
+}
+
+// OrderId set orderId
+func (s *ConvertCancelLimitOrderService) OrderId(orderId int64) *ConvertCancelLimitOrderService {
+	s.orderId = orderId
+	return s
+}
+
+// Do send request
+func (s *ConvertCancelLimitOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertLimitOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/convert/limit/cancelOrder",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("orderId", s.orderId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertLimitOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertListOpenLimitOrdersService list the open limit convert orders
+type ConvertListOpenLimitOrdersService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ConvertListOpenLimitOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertOrder, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/convert/limit/queryOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertOrder{}, err
+	}
+	list := struct {
+		List []*ConvertOrder `json:"list"`
+	}{}
+	err = json.Unmarshal(data, &list)
+	if err != nil {
+		return []*ConvertOrder{}, err
+	}
+	return list.List, nil
+}
@@ -33,14 +33,14 @@ func (s *ConvertTradeHistoryService) Limit(limit int32) *ConvertTradeHistoryServ
 // Do send request
 func (s *ConvertTradeHistoryService) Do(ctx context.Context, opts ...RequestOption) (*ConvertTradeHistory, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/convert/tradeFlow",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/convert/tradeFlow",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("startTime", s.startTime)
-	r.setParam("endTime", s.endTime)
+	r.SetParam("startTime", s.startTime)
+	r.SetParam("endTime", s.endTime)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -13,9 +13,9 @@ type GetBalanceService struct {
 // Do send request
 func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/balance",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/balance",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -49,9 +49,9 @@ type GetAccountService struct {
 // Do send request
 func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/account",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/account",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
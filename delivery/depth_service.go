@@ -1,9 +1,66 @@
 package delivery
 
-import "github.com/uncle-gua/gobinance/common"
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+// DepthService show depth info
+type DepthService struct {
+	c      *Client
+	symbol string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *DepthService) Symbol(symbol string) *DepthService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *DepthService) Limit(limit int) *DepthService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *DepthResponse, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/depth",
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DepthResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
 // Ask is a type alias for PriceLevel.
 type Ask = common.PriceLevel
 
 // Bid is a type alias for PriceLevel.
 type Bid = common.PriceLevel
+
+// DepthResponse define depth info with bids and asks
+type DepthResponse struct {
+	LastUpdateID int64  `json:"lastUpdateId"`
+	Symbol       string `json:"symbol"`
+	Pair         string `json:"pair"`
+	Time         int64  `json:"E"`
+	TradeTime    int64  `json:"T"`
+	Bids         []Bid  `json:"bids"`
+	Asks         []Ask  `json:"asks"`
+}
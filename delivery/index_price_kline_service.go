@@ -0,0 +1,71 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+)
+
+// IndexPriceKlinesService list klines
+type IndexPriceKlinesService struct {
+	c         *Client
+	pair      string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair sets pair
+func (ipks *IndexPriceKlinesService) Pair(pair string) *IndexPriceKlinesService {
+	ipks.pair = pair
+	return ipks
+}
+
+// Interval set interval
+func (ipks *IndexPriceKlinesService) Interval(interval string) *IndexPriceKlinesService {
+	ipks.interval = interval
+	return ipks
+}
+
+// Limit set limit
+func (ipks *IndexPriceKlinesService) Limit(limit int) *IndexPriceKlinesService {
+	ipks.limit = &limit
+	return ipks
+}
+
+// StartTime set startTime
+func (ipks *IndexPriceKlinesService) StartTime(startTime int64) *IndexPriceKlinesService {
+	ipks.startTime = &startTime
+	return ipks
+}
+
+// EndTime set endTime
+func (ipks *IndexPriceKlinesService) EndTime(endTime int64) *IndexPriceKlinesService {
+	ipks.endTime = &endTime
+	return ipks
+}
+
+// Do send request
+func (ipks *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/indexPriceKlines",
+	}
+	r.SetParam("pair", ipks.pair)
+	r.SetParam("interval", ipks.interval)
+	if ipks.limit != nil {
+		r.SetParam("limit", *ipks.limit)
+	}
+	if ipks.startTime != nil {
+		r.SetParam("startTime", *ipks.startTime)
+	}
+	if ipks.endTime != nil {
+		r.SetParam("endTime", *ipks.endTime)
+	}
+	data, err := ipks.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
@@ -2,8 +2,10 @@ package delivery
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"net/http"
+
+	jsoniter "github.com/json-iterator/go"
 )
 
 // KlinesService list klines
@@ -49,50 +51,29 @@ func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 // Do send request
 func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/klines",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/klines",
 	}
-	r.setParam("symbol", s.symbol)
-	r.setParam("interval", s.interval)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("interval", s.interval)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []*Kline{}, err
 	}
-	j, err := newJSON(data)
+	res = make([]*Kline, 0)
+	err = json.Unmarshal(data, &res)
 	if err != nil {
 		return []*Kline{}, err
 	}
-	num := len(j.MustArray())
-	res = make([]*Kline, num)
-	for i := 0; i < num; i++ {
-		item := j.GetIndex(i)
-		if len(item.MustArray()) < 11 {
-			err = fmt.Errorf("invalid kline response")
-			return []*Kline{}, err
-		}
-		res[i] = &Kline{
-			OpenTime:                 item.GetIndex(0).MustInt64(),
-			Open:                     item.GetIndex(1).MustFloat64(),
-			High:                     item.GetIndex(2).MustFloat64(),
-			Low:                      item.GetIndex(3).MustFloat64(),
-			Close:                    item.GetIndex(4).MustFloat64(),
-			Volume:                   item.GetIndex(5).MustFloat64(),
-			CloseTime:                item.GetIndex(6).MustInt64(),
-			QuoteAssetVolume:         item.GetIndex(7).MustFloat64(),
-			TradeNum:                 item.GetIndex(8).MustInt64(),
-			TakerBuyBaseAssetVolume:  item.GetIndex(9).MustFloat64(),
-			TakerBuyQuoteAssetVolume: item.GetIndex(10).MustFloat64(),
-		}
-	}
 	return res, nil
 }
 
@@ -110,3 +91,25 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  float64 `json:"takerBuyBaseAssetVolume,string"`
 	TakerBuyQuoteAssetVolume float64 `json:"takerBuyQuoteAssetVolume,string"`
 }
+
+// UnmarshalJSON decode a kline from its array form
+func (kline *Kline) UnmarshalJSON(data []byte) error {
+	iter := jsoniter.Get(data)
+	if iter.Size() < 11 {
+		return errors.New("invalid kline response")
+	}
+
+	kline.OpenTime = iter.Get(0).ToInt64()
+	kline.Open = iter.Get(1).ToFloat64()
+	kline.High = iter.Get(2).ToFloat64()
+	kline.Low = iter.Get(3).ToFloat64()
+	kline.Close = iter.Get(4).ToFloat64()
+	kline.Volume = iter.Get(5).ToFloat64()
+	kline.CloseTime = iter.Get(6).ToInt64()
+	kline.QuoteAssetVolume = iter.Get(7).ToFloat64()
+	kline.TradeNum = iter.Get(8).ToInt64()
+	kline.TakerBuyBaseAssetVolume = iter.Get(9).ToFloat64()
+	kline.TakerBuyQuoteAssetVolume = iter.Get(10).ToFloat64()
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package delivery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/delivery"
+)
+
+func TestKline(t *testing.T) {
+	client := delivery.NewClient("", "")
+	res, err := client.NewKlinesService().Symbol("BTCUSD_PERP").Limit(1500).Interval("1m").Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+	t.Log(len(res))
+	t.Log(res[0])
+}
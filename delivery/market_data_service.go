@@ -0,0 +1,184 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+)
+
+// TakerBuySellVolumeService list taker buy/sell volume of a pair.
+type TakerBuySellVolumeService struct {
+	c            *Client
+	pair         string
+	contractType string
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *TakerBuySellVolumeService) Pair(pair string) *TakerBuySellVolumeService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, CURRENT_QUARTER, NEXT_QUARTER or PERPETUAL
+func (s *TakerBuySellVolumeService) ContractType(contractType string) *TakerBuySellVolumeService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *TakerBuySellVolumeService) Period(period string) *TakerBuySellVolumeService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TakerBuySellVolumeService) Limit(limit int) *TakerBuySellVolumeService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerBuySellVolumeService) StartTime(startTime int64) *TakerBuySellVolumeService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerBuySellVolumeService) EndTime(endTime int64) *TakerBuySellVolumeService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerBuySellVolumeService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerBuySellVolume, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/takerBuySellVol",
+	}
+	r.SetParam("pair", s.pair)
+	r.SetParam("contractType", s.contractType)
+	r.SetParam("period", s.period)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	res = make([]*TakerBuySellVolume, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	return res, nil
+}
+
+// TakerBuySellVolume define taker buy/sell volume, volumes are in contracts
+// and values in base asset
+type TakerBuySellVolume struct {
+	Pair              string `json:"pair"`
+	ContractType      string `json:"contractType"`
+	TakerBuyVolume    string `json:"takerBuyVol"`
+	TakerSellVolume   string `json:"takerSellVol"`
+	TakerBuyVolValue  string `json:"takerBuyVolValue"`
+	TakerSellVolValue string `json:"takerSellVolValue"`
+	Timestamp         int64  `json:"timestamp"`
+}
+
+// BasisService list basis of a pair.
+type BasisService struct {
+	c            *Client
+	pair         string
+	contractType string
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *BasisService) Pair(pair string) *BasisService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, CURRENT_QUARTER, NEXT_QUARTER or PERPETUAL
+func (s *BasisService) ContractType(contractType string) *BasisService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *BasisService) Period(period string) *BasisService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *BasisService) Limit(limit int) *BasisService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *BasisService) StartTime(startTime int64) *BasisService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *BasisService) EndTime(endTime int64) *BasisService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *BasisService) Do(ctx context.Context, opts ...RequestOption) (res []*Basis, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/basis",
+	}
+	r.SetParam("pair", s.pair)
+	r.SetParam("contractType", s.contractType)
+	r.SetParam("period", s.period)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	res = make([]*Basis, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	return res, nil
+}
+
+// Basis define basis between futures and index price
+type Basis struct {
+	Pair                string `json:"pair"`
+	ContractType        string `json:"contractType"`
+	IndexPrice          string `json:"indexPrice"`
+	FuturesPrice        string `json:"futuresPrice"`
+	Basis               string `json:"basis"`
+	BasisRate           string `json:"basisRate"`
+	AnnualizedBasisRate string `json:"annualizedBasisRate"`
+	Timestamp           int64  `json:"timestamp"`
+}
@@ -0,0 +1,421 @@
+package delivery
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BookPosition define a position kept by a PositionBook
+type BookPosition struct {
+	Symbol         string
+	PositionSide   PositionSideType
+	MarginType     MarginType
+	PositionAmt    float64
+	EntryPrice     float64
+	MarkPrice      float64
+	UnrealizedPnL  float64 // mark to market in the margin asset, updated with the mark price
+	IsolatedWallet float64
+	ContractSize   float64 // USD value of a contract
+	UpdateTime     int64
+}
+
+// unrealize update the unrealized PnL of an inverse position with the mark price
+func (p *BookPosition) unrealize() {
+	if p.EntryPrice == 0 || p.MarkPrice == 0 {
+		p.UnrealizedPnL = 0
+		return
+	}
+	p.UnrealizedPnL = p.PositionAmt * p.ContractSize * (1/p.EntryPrice - 1/p.MarkPrice)
+}
+
+// PnL define the realized PnL, fees and funding of a symbol or a strategy tag
+type PnL struct {
+	RealizedPnL float64            // realized PnL of the fills, in the margin asset
+	Fees        map[string]float64 // commissions paid by asset
+	Funding     float64            // funding fees paid, negative when received
+	Trades      int                // number of fills
+}
+
+func (p *PnL) clone() PnL {
+	res := *p
+	res.Fees = make(map[string]float64, len(p.Fees))
+	for asset, fee := range p.Fees {
+		res.Fees[asset] = fee
+	}
+	return res
+}
+
+type positionKey struct {
+	symbol string
+	side   PositionSideType
+}
+
+// DefaultStrategyTag return the part of a client order id before the first
+// "-", or "" when there is none
+func DefaultStrategyTag(clientOrderID string) string {
+	i := strings.Index(clientOrderID, "-")
+	if i <= 0 {
+		return ""
+	}
+	return clientOrderID[:i]
+}
+
+// PositionBook keep the positions, wallet balances and PnL of the account in
+// sync with the user data stream. Load it first, then feed HandleUserData
+// with the events of WsUserDataServe and HandleMarkPrice with the events of
+// WsMarkPriceServe or WsPairMarkPriceServe. Both one-way and hedge mode
+// positions are kept, by symbol and position side.
+//
+// The realized PnL, fees and funding are accumulated from the fills received
+// since the book was created, by symbol and by the strategy tag of the client
+// order id. Funding is shared between the tags by their part of the position,
+// the positions found by Load belong to the "" tag. Funding events of cross
+// margin positions do not name the position, the fee is then shared between
+// the open positions of its margin asset by notional.
+//
+// The positions are inverse, the amounts are in contracts and the PnL in the
+// margin asset.
+type PositionBook struct {
+	c *Client
+	// TagFunc return the strategy tag of a client order id, DefaultStrategyTag when nil
+	TagFunc func(clientOrderID string) string
+
+	mu        sync.Mutex
+	positions map[positionKey]*BookPosition
+	balances  map[string]float64
+	symbols   map[string]*PnL
+	tags      map[string]*PnL
+	tagAmts   map[positionKey]map[string]float64
+	sizes     map[string]float64
+	assets    map[string]string
+}
+
+// Load reset the positions and wallet balances with the position risk and
+// the account, and the contract sizes and margin assets with the exchange
+// info, the PnL is kept
+func (b *PositionBook) Load(ctx context.Context, opts ...RequestOption) error {
+	info, err := b.c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	risks, err := b.c.NewGetPositionRiskService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	account, err := b.c.NewGetAccountService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sizes = make(map[string]float64, len(info.Symbols))
+	b.assets = make(map[string]string, len(info.Symbols))
+	for _, s := range info.Symbols {
+		b.sizes[s.Symbol] = float64(s.ContractSize)
+		b.assets[s.Symbol] = s.MarginAsset
+	}
+	b.positions = make(map[positionKey]*BookPosition)
+	b.tagAmts = make(map[positionKey]map[string]float64)
+	for _, r := range risks {
+		amt := parseFloat(r.PositionAmt)
+		if amt == 0 {
+			continue
+		}
+		key := positionKey{r.Symbol, PositionSideType(r.PositionSide)}
+		b.positions[key] = &BookPosition{
+			Symbol:         r.Symbol,
+			PositionSide:   key.side,
+			MarginType:     MarginType(r.MarginType),
+			PositionAmt:    amt,
+			EntryPrice:     parseFloat(r.EntryPrice),
+			MarkPrice:      parseFloat(r.MarkPrice),
+			UnrealizedPnL:  parseFloat(r.UnRealizedProfit),
+			IsolatedWallet: parseFloat(r.IsolatedMargin),
+			ContractSize:   b.sizes[r.Symbol],
+		}
+		b.tagAmts[key] = map[string]float64{"": amt}
+	}
+	b.balances = make(map[string]float64, len(account.Assets))
+	for _, a := range account.Assets {
+		b.balances[a.Asset] = parseFloat(a.WalletBalance)
+	}
+	return nil
+}
+
+// HandleUserData update the book with an ACCOUNT_UPDATE or ORDER_TRADE_UPDATE event
+func (b *PositionBook) HandleUserData(event *WsUserDataEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch event.Event {
+	case UserDataEventTypeAccountUpdate:
+		b.applyAccountUpdate(event)
+	case UserDataEventTypeOrderTradeUpdate:
+		b.applyTrade(&event.OrderTradeUpdate)
+	}
+}
+
+func (b *PositionBook) applyAccountUpdate(event *WsUserDataEvent) {
+	u := &event.AccountUpdate
+	var funded []positionKey
+	for _, p := range u.Positions {
+		key := positionKey{p.Symbol, p.Side}
+		funded = append(funded, key)
+		amt := parseFloat(p.Amount)
+		if amt == 0 {
+			delete(b.positions, key)
+			b.pruneTagAmts(key)
+			continue
+		}
+		pos, ok := b.positions[key]
+		if !ok {
+			pos = &BookPosition{Symbol: p.Symbol, PositionSide: p.Side, ContractSize: b.sizes[p.Symbol]}
+			b.positions[key] = pos
+		}
+		pos.MarginType = p.MarginType
+		pos.PositionAmt = amt
+		pos.EntryPrice = parseFloat(p.EntryPrice)
+		if mark := parseFloat(p.MarkPrice); mark != 0 {
+			pos.MarkPrice = mark
+		}
+		pos.IsolatedWallet = parseFloat(p.IsolatedWallet)
+		pos.UpdateTime = event.TransactionTime
+		pos.unrealize()
+	}
+	for _, balance := range u.Balances {
+		b.balances[balance.Asset] = parseFloat(balance.Balance)
+		if change := parseFloat(balance.BalanceChange); u.Reason == UserDataEventReasonTypeFundingFee && change != 0 {
+			b.applyFunding(funded, balance.Asset, -change)
+		}
+	}
+}
+
+// applyFunding share a funding fee between the positions by notional, all
+// the open positions margined in asset when none is given
+func (b *PositionBook) applyFunding(keys []positionKey, asset string, fee float64) {
+	if len(keys) == 0 {
+		for key := range b.positions {
+			if b.assets[key.symbol] == asset {
+				keys = append(keys, key)
+			}
+		}
+	}
+	var total float64
+	notionals := make(map[positionKey]float64, len(keys))
+	for _, key := range keys {
+		if pos, ok := b.positions[key]; ok {
+			notionals[key] = math.Abs(pos.PositionAmt * pos.ContractSize)
+			total += notionals[key]
+		}
+	}
+	if total == 0 {
+		b.pnl(b.symbols, "").Funding += fee
+		b.pnl(b.tags, "").Funding += fee
+		return
+	}
+	for key, notional := range notionals {
+		share := fee * notional / total
+		b.pnl(b.symbols, key.symbol).Funding += share
+		b.shareTagFunding(key, share)
+	}
+}
+
+// shareTagFunding share the funding fee of a position between the tags
+// holding it
+func (b *PositionBook) shareTagFunding(key positionKey, fee float64) {
+	sign := math.Copysign(1, b.positions[key].PositionAmt)
+	var total float64
+	for _, amt := range b.tagAmts[key] {
+		if amt*sign > 0 {
+			total += amt * sign
+		}
+	}
+	if total == 0 {
+		b.pnl(b.tags, "").Funding += fee
+		return
+	}
+	for tag, amt := range b.tagAmts[key] {
+		if amt*sign > 0 {
+			b.pnl(b.tags, tag).Funding += fee * amt * sign / total
+		}
+	}
+}
+
+func (b *PositionBook) applyTrade(u *WsOrderTradeUpdate) {
+	qty := parseFloat(u.LastFilledQty)
+	if u.ExecutionType != OrderExecutionTypeTrade || qty == 0 {
+		return
+	}
+	tagFunc := b.TagFunc
+	if tagFunc == nil {
+		tagFunc = DefaultStrategyTag
+	}
+	tag := tagFunc(u.ClientOrderID)
+	realized, commission := parseFloat(u.RealizedPnL), parseFloat(u.Commission)
+	for _, pnl := range []*PnL{b.pnl(b.symbols, u.Symbol), b.pnl(b.tags, tag)} {
+		pnl.RealizedPnL += realized
+		pnl.Trades++
+		if commission != 0 {
+			pnl.Fees[u.CommissionAsset] += commission
+		}
+	}
+
+	side := u.PositionSide
+	if side == "" {
+		side = PositionSideTypeBoth
+	}
+	key := positionKey{u.Symbol, side}
+	amts, ok := b.tagAmts[key]
+	if !ok {
+		amts = make(map[string]float64)
+		b.tagAmts[key] = amts
+	}
+	if u.Side == SideTypeBuy {
+		amts[tag] += qty
+	} else {
+		amts[tag] -= qty
+	}
+	if _, ok := b.positions[key]; !ok {
+		b.pruneTagAmts(key)
+	}
+}
+
+// pruneTagAmts drop the tag amounts of a closed position once its fills are
+// all received, the ACCOUNT_UPDATE may arrive before or after them
+func (b *PositionBook) pruneTagAmts(key positionKey) {
+	var sum float64
+	for _, amt := range b.tagAmts[key] {
+		sum += amt
+	}
+	if math.Abs(sum) < 1e-9 {
+		delete(b.tagAmts, key)
+	}
+}
+
+func (b *PositionBook) pnl(m map[string]*PnL, name string) *PnL {
+	pnl, ok := m[name]
+	if !ok {
+		pnl = &PnL{Fees: make(map[string]float64)}
+		m[name] = pnl
+	}
+	return pnl
+}
+
+// HandleMarkPrice update the mark price and unrealized PnL of the positions of a symbol
+func (b *PositionBook) HandleMarkPrice(event *WsMarkPriceEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	mark := parseFloat(event.MarkPrice)
+	for _, pos := range b.positions {
+		if pos.Symbol != event.Symbol {
+			continue
+		}
+		pos.MarkPrice = mark
+		pos.unrealize()
+	}
+}
+
+// HandlePairMarkPrice update the book with the events of WsPairMarkPriceServe
+func (b *PositionBook) HandlePairMarkPrice(event WsPairMarkPriceEvent) {
+	for _, e := range event {
+		b.HandleMarkPrice(e)
+	}
+}
+
+// Position return a copy of the position of a symbol, use PositionSideTypeBoth in one-way mode
+func (b *PositionBook) Position(symbol string, side PositionSideType) (BookPosition, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	pos, ok := b.positions[positionKey{symbol, side}]
+	if !ok {
+		return BookPosition{}, false
+	}
+	return *pos, true
+}
+
+// Positions return a copy of the open positions, sorted by symbol and position side
+func (b *PositionBook) Positions() []BookPosition {
+	b.mu.Lock()
+	res := make([]BookPosition, 0, len(b.positions))
+	for _, pos := range b.positions {
+		res = append(res, *pos)
+	}
+	b.mu.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].PositionSide < res[j].PositionSide
+	})
+	return res
+}
+
+// UnrealizedPnL return the unrealized PnL of the open positions
+func (b *PositionBook) UnrealizedPnL() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var res float64
+	for _, pos := range b.positions {
+		res += pos.UnrealizedPnL
+	}
+	return res
+}
+
+// Balance return the wallet balance of an asset
+func (b *PositionBook) Balance(asset string) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.balances[asset]
+}
+
+// SymbolPnL return a copy of the PnL of a symbol
+func (b *PositionBook) SymbolPnL(symbol string) PnL {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return getPnL(b.symbols, symbol)
+}
+
+// TagPnL return a copy of the PnL of a strategy tag
+func (b *PositionBook) TagPnL(tag string) PnL {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return getPnL(b.tags, tag)
+}
+
+// SymbolsPnL return a copy of the PnL of every symbol
+func (b *PositionBook) SymbolsPnL() map[string]PnL {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return clonePnL(b.symbols)
+}
+
+// TagsPnL return a copy of the PnL of every strategy tag
+func (b *PositionBook) TagsPnL() map[string]PnL {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return clonePnL(b.tags)
+}
+
+func getPnL(m map[string]*PnL, name string) PnL {
+	if pnl, ok := m[name]; ok {
+		return pnl.clone()
+	}
+	return PnL{Fees: make(map[string]float64)}
+}
+
+func clonePnL(m map[string]*PnL) map[string]PnL {
+	res := make(map[string]PnL, len(m))
+	for name, pnl := range m {
+		res[name] = pnl.clone()
+	}
+	return res
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,118 @@
+package delivery_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/delivery"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-12
+}
+
+func TestPositionBook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		// the contract sizes differ to check the funding is shared by notional
+		case "/dapi/v1/exchangeInfo":
+			w.Write([]byte(`{"symbols":[
+				{"symbol":"BTCUSD_PERP","contractSize":100,"marginAsset":"BTC"},
+				{"symbol":"BTCUSD_250328","contractSize":10,"marginAsset":"BTC"},
+				{"symbol":"ETHUSD_PERP","contractSize":10,"marginAsset":"ETH"}]}`))
+		case "/dapi/v1/positionRisk":
+			w.Write([]byte(`[
+				{"symbol":"BTCUSD_PERP","positionAmt":"2","entryPrice":"50000","markPrice":"50000","unRealizedProfit":"0","marginType":"cross","positionSide":"BOTH"},
+				{"symbol":"BTCUSD_250328","positionAmt":"-10","entryPrice":"50000","markPrice":"50000","unRealizedProfit":"0","marginType":"cross","positionSide":"BOTH"},
+				{"symbol":"ETHUSD_PERP","positionAmt":"5","entryPrice":"2500","markPrice":"2500","unRealizedProfit":"0","marginType":"cross","positionSide":"BOTH"}]`))
+		case "/dapi/v1/account":
+			w.Write([]byte(`{"assets":[{"asset":"BTC","walletBalance":"1"},{"asset":"ETH","walletBalance":"10"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	client := delivery.NewClient("", "")
+	client.BaseURL = srv.URL
+	book := client.NewPositionBook()
+	if err := book.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if balance := book.Balance("BTC"); balance != 1 {
+		t.Fatalf("unexpected balance %v", balance)
+	}
+
+	// inverse PnL in the margin asset: contracts * size * (1/entry - 1/mark)
+	book.HandleMarkPrice(&delivery.WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "40000"})
+	pos, ok := book.Position("BTCUSD_PERP", delivery.PositionSideTypeBoth)
+	if !ok || pos.ContractSize != 100 || !near(pos.UnrealizedPnL, -0.001) {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	book.HandleMarkPrice(&delivery.WsMarkPriceEvent{Symbol: "BTCUSD_250328", MarkPrice: "40000"})
+	if pos, _ = book.Position("BTCUSD_250328", delivery.PositionSideTypeBoth); !near(pos.UnrealizedPnL, 0.0005) {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	if pnl := book.UnrealizedPnL(); !near(pnl, -0.0005) {
+		t.Fatalf("unexpected unrealized pnl %v", pnl)
+	}
+
+	// a cross funding fee without positions is shared between the BTC
+	// margined positions by notional, 200 and 100 USD
+	book.HandleUserData(&delivery.WsUserDataEvent{
+		Event: delivery.UserDataEventTypeAccountUpdate,
+		AccountUpdate: delivery.WsAccountUpdate{
+			Reason:   delivery.UserDataEventReasonTypeFundingFee,
+			Balances: []delivery.WsBalance{{Asset: "BTC", Balance: "0.9997", BalanceChange: "-0.0003"}},
+		},
+	})
+	if pnl := book.SymbolPnL("BTCUSD_PERP"); !near(pnl.Funding, 0.0002) {
+		t.Fatalf("unexpected symbol pnl %+v", pnl)
+	}
+	if pnl := book.SymbolPnL("BTCUSD_250328"); !near(pnl.Funding, 0.0001) {
+		t.Fatalf("unexpected symbol pnl %+v", pnl)
+	}
+	if pnl := book.SymbolPnL("ETHUSD_PERP"); pnl.Funding != 0 {
+		t.Fatalf("unexpected symbol pnl %+v", pnl)
+	}
+	if balance := book.Balance("BTC"); balance != 0.9997 {
+		t.Fatalf("unexpected balance %v", balance)
+	}
+
+	// the funding of a named position is shared between the tags holding it
+	book.HandleUserData(&delivery.WsUserDataEvent{
+		Event: delivery.UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: delivery.WsOrderTradeUpdate{
+			Symbol:          "BTCUSD_PERP",
+			ClientOrderID:   "a-1",
+			Side:            delivery.SideTypeBuy,
+			ExecutionType:   delivery.OrderExecutionTypeTrade,
+			LastFilledQty:   "1",
+			CommissionAsset: "BTC",
+			Commission:      "0.00001",
+		},
+	})
+	book.HandleUserData(&delivery.WsUserDataEvent{
+		Event: delivery.UserDataEventTypeAccountUpdate,
+		AccountUpdate: delivery.WsAccountUpdate{
+			Reason:   delivery.UserDataEventReasonTypeFundingFee,
+			Balances: []delivery.WsBalance{{Asset: "BTC", Balance: "0.9994", BalanceChange: "-0.0003"}},
+			Positions: []delivery.WsPosition{{Symbol: "BTCUSD_PERP", Side: delivery.PositionSideTypeBoth,
+				Amount: "3", EntryPrice: "45000", MarginType: "cross"}},
+		},
+	})
+	if pos, _ = book.Position("BTCUSD_PERP", delivery.PositionSideTypeBoth); pos.PositionAmt != 3 ||
+		!near(pos.UnrealizedPnL, 3*100*(1/45000.0-1/40000.0)) {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	if pnl := book.SymbolPnL("BTCUSD_PERP"); !near(pnl.Funding, 0.0005) || pnl.Trades != 1 || pnl.Fees["BTC"] != 0.00001 {
+		t.Fatalf("unexpected symbol pnl %+v", pnl)
+	}
+	a, untagged := book.TagPnL("a"), book.TagPnL("")
+	if !near(a.Funding, 0.0001) || !near(untagged.Funding, 0.0005) {
+		t.Fatalf("unexpected tag pnl %+v %+v", a, untagged)
+	}
+}
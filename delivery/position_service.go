@@ -27,11 +27,11 @@ func (s *ChangeLeverageService) Leverage(leverage int) *ChangeLeverageService {
 // Do send request
 func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *SymbolLeverage, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/dapi/v1/leverage",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/dapi/v1/leverage",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":   s.symbol,
 		"leverage": s.leverage,
 	})
@@ -76,11 +76,11 @@ func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMargi
 // Do send request
 func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/dapi/v1/marginType",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/dapi/v1/marginType",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":     s.symbol,
 		"marginType": s.marginType,
 	})
@@ -127,9 +127,9 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/dapi/v1/positionMargin",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/dapi/v1/positionMargin",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol": s.symbol,
@@ -139,7 +139,7 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 	if s.positionSide != nil {
 		m["positionSide"] = *s.positionSide
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 
 	_, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -167,11 +167,11 @@ func (s *ChangePositionModeService) DualSide(dualSide bool) *ChangePositionModeS
 // Do send request
 func (s *ChangePositionModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/dapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/dapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"dualSidePosition": s.dualSide,
 	})
 	_, err = s.c.callAPI(ctx, r, opts...)
@@ -194,11 +194,11 @@ type PositionMode struct {
 // Do send request
 func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption) (res *PositionMode, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{})
+	r.SetFormParams(params{})
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
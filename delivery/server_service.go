@@ -3,6 +3,8 @@ package delivery
 import (
 	"context"
 	"net/http"
+
+	"github.com/uncle-gua/gobinance/internal/transport"
 )
 
 // PingService ping server
@@ -13,8 +15,8 @@ type PingService struct {
 // Do send request
 func (s *PingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/ping",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/ping",
 	}
 	_, err = s.c.callAPI(ctx, r, opts...)
 	return err
@@ -28,8 +30,8 @@ type ServerTimeService struct {
 // Do send request
 func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/dapi/v1/time",
+		Method:   http.MethodGet,
+		Endpoint: "/dapi/v1/time",
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -55,7 +57,7 @@ func (s *SetServerTimeService) Do(ctx context.Context, opts ...RequestOption) (t
 	if err != nil {
 		return 0, err
 	}
-	timeOffset = currentTimestamp() - serverTime
+	timeOffset = transport.CurrentTimestamp() - serverTime
 	s.c.TimeOffset = timeOffset
 	return timeOffset, nil
 }
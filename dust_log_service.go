@@ -39,15 +39,15 @@ func (s *ListDustLogService) EndTime(endTime int64) *ListDustLogService {
 // Do sends the request.
 func (s *ListDustLogService) Do(ctx context.Context) (withdraws *DustResult, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/asset/dribblet",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/asset/dribblet",
+		SecType:  secTypeSigned,
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -102,12 +102,12 @@ func (s *DustTransferService) Asset(asset []string) *DustTransferService {
 // Do sends the request.
 func (s *DustTransferService) Do(ctx context.Context) (withdraws *DustTransferResponse, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/asset/dust",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/asset/dust",
+		SecType:  secTypeSigned,
 	}
 	for _, a := range s.asset {
-		r.addParam("asset", a)
+		r.AddParam("asset", a)
 	}
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
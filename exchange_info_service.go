@@ -32,9 +32,9 @@ func (s *ExchangeInfoService) Symbols(symbols ...string) *ExchangeInfoService {
 // Do send request
 func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/exchangeInfo",
-		secType:  secTypeNone,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/exchangeInfo",
+		SecType:  secTypeNone,
 	}
 	m := params{}
 	if s.symbol != "" {
@@ -43,7 +43,7 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (re
 	if len(s.symbols) != 0 {
 		m["symbols"] = s.symbols
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,524 @@
+// Package execution runs client-side execution algorithms, slicing a parent
+// order into child orders sent through the spot, futures or delivery order
+// services.
+//
+// TWAP spreads the quantity evenly over time, VWAP follows a volume profile
+// built from historical klines, POV follows the market volume and iceberg
+// only shows a clip of the quantity at a time. Fills are tracked from the
+// order responses and from the user data stream, the working iceberg clip is
+// also polled each interval so a stream is not required.
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// Strategy define execution strategy
+type Strategy string
+
+// Status define executor status
+type Status string
+
+const (
+	StrategyTWAP    Strategy = "TWAP"
+	StrategyVWAP    Strategy = "VWAP"
+	StrategyPOV     Strategy = "POV"
+	StrategyIceberg Strategy = "ICEBERG"
+
+	StatusPending  Status = "PENDING"
+	StatusRunning  Status = "RUNNING"
+	StatusPaused   Status = "PAUSED"
+	StatusDone     Status = "DONE"
+	StatusCanceled Status = "CANCELED"
+	StatusFailed   Status = "FAILED"
+
+	epsilon = 1e-12
+)
+
+// ErrCanceled is returned by Run when the executor is canceled
+var ErrCanceled = errors.New("execution canceled")
+
+// Params define the parent order and the schedule of an executor
+type Params struct {
+	Strategy Strategy
+	Symbol   string
+	Side     string // BUY or SELL
+	Quantity float64
+
+	// Duration and Slices define the schedule of TWAP and VWAP, Duration
+	// also bounds POV when set
+	Duration time.Duration
+	Slices   int
+	// Profile is the VWAP weight of each slice, see VolumeProfile
+	Profile []float64
+
+	// LimitPrice guards the execution: child orders are IOC limit orders at
+	// this price instead of market orders. Required by iceberg.
+	LimitPrice float64
+	// MaxParticipation caps each child at this share of the market volume
+	// reported with AddMarketVolume since the previous child, and is the
+	// target rate of POV
+	MaxParticipation float64
+	// ClipQuantity is the visible quantity of iceberg
+	ClipQuantity float64
+	// Interval is the polling period of POV and iceberg, one second when zero
+	Interval time.Duration
+
+	StepSize    float64 // quantity step of the symbol
+	MinQuantity float64 // children below this quantity are skipped
+}
+
+// Progress define fill progress of an executor
+type Progress struct {
+	ID       string
+	Status   Status
+	Quantity float64
+	Filled   float64
+	AvgPrice float64
+	Children int
+	Err      error
+}
+
+type child struct {
+	symbol   string
+	executed float64
+	avgPrice float64
+	final    bool
+}
+
+// Executor run an execution algorithm
+type Executor struct {
+	// OnProgress is called after each fill or status change
+	OnProgress func(p Progress)
+
+	placer OrderPlacer
+	params Params
+	id     string
+
+	mu       sync.Mutex
+	status   Status
+	err      error
+	seq      int
+	children map[string]*child
+	volume   float64
+	resume   chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// New init an executor sending child orders through placer
+func New(placer OrderPlacer, params Params) (*Executor, error) {
+	if params.Symbol == "" || (params.Side != "BUY" && params.Side != "SELL") || params.Quantity <= 0 {
+		return nil, errors.New("symbol, side and quantity are required")
+	}
+	switch params.Strategy {
+	case StrategyTWAP, StrategyVWAP:
+		if params.Slices <= 0 || params.Duration <= 0 {
+			return nil, errors.New("duration and slices are required")
+		}
+		if params.Strategy == StrategyVWAP && len(params.Profile) != params.Slices {
+			return nil, errors.New("profile must have one weight per slice")
+		}
+	case StrategyPOV:
+		if params.MaxParticipation <= 0 {
+			return nil, errors.New("max participation is required")
+		}
+	case StrategyIceberg:
+		if params.ClipQuantity <= 0 || params.LimitPrice <= 0 {
+			return nil, errors.New("clip quantity and limit price are required")
+		}
+	default:
+		return nil, fmt.Errorf("unknown strategy %q", params.Strategy)
+	}
+	if params.Interval <= 0 {
+		params.Interval = time.Second
+	}
+	return &Executor{
+		placer:   placer,
+		params:   params,
+		id:       "exe" + strconv.FormatInt(time.Now().UTC().UnixNano(), 36),
+		status:   StatusPending,
+		children: make(map[string]*child),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// ID return the prefix of the client order ids of the children
+func (e *Executor) ID() string {
+	return e.id
+}
+
+// Progress return the fill progress
+func (e *Executor) Progress() Progress {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.progress()
+}
+
+func (e *Executor) progress() Progress {
+	p := Progress{
+		ID:       e.id,
+		Status:   e.status,
+		Quantity: e.params.Quantity,
+		Children: len(e.children),
+		Err:      e.err,
+	}
+	var quote float64
+	for _, c := range e.children {
+		p.Filled += c.executed
+		quote += c.executed * c.avgPrice
+	}
+	if p.Filled > 0 {
+		p.AvgPrice = quote / p.Filled
+	}
+	return p
+}
+
+func (e *Executor) notify() {
+	if e.OnProgress != nil {
+		e.OnProgress(e.Progress())
+	}
+}
+
+func (e *Executor) setStatus(status Status, err error) {
+	e.mu.Lock()
+	e.status, e.err = status, err
+	e.mu.Unlock()
+	e.notify()
+}
+
+// Pause stop sending child orders until Resume
+func (e *Executor) Pause() {
+	e.mu.Lock()
+	if e.status != StatusRunning {
+		e.mu.Unlock()
+		return
+	}
+	e.status = StatusPaused
+	e.resume = make(chan struct{})
+	e.mu.Unlock()
+	e.notify()
+}
+
+// Resume resume a paused executor, the missed slices are caught up
+func (e *Executor) Resume() {
+	e.mu.Lock()
+	if e.status != StatusPaused {
+		e.mu.Unlock()
+		return
+	}
+	e.status = StatusRunning
+	close(e.resume)
+	e.mu.Unlock()
+	e.notify()
+}
+
+// Cancel stop the executor, Run cancels the working child and returns ErrCanceled
+func (e *Executor) Cancel() {
+	e.once.Do(func() { close(e.done) })
+}
+
+// AddMarketVolume report traded market volume, e.g. from an aggTrade stream,
+// used by POV and MaxParticipation
+func (e *Executor) AddMarketVolume(qty float64) {
+	e.mu.Lock()
+	e.volume += qty
+	e.mu.Unlock()
+}
+
+// HandleSpotUserData update the children with a spot executionReport
+func (e *Executor) HandleSpotUserData(event *binance.WsUserDataEvent) {
+	if event.Event != binance.UserDataEventTypeExecutionReport {
+		return
+	}
+	u := event.OrderUpdate
+	filled := parseFloat(u.FilledVolume)
+	var avg float64
+	if filled > 0 {
+		avg = parseFloat(u.FilledQuoteVolume) / filled
+	}
+	e.update(u.ClientOrderId, filled, avg, final(u.Status))
+}
+
+// HandleFuturesUserData update the children with a futures ORDER_TRADE_UPDATE
+func (e *Executor) HandleFuturesUserData(event *futures.WsUserDataEvent) {
+	if event.Event != futures.UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	u := event.OrderTradeUpdate
+	e.update(u.ClientOrderID, u.AccumulatedFilledQty, u.AveragePrice, final(string(u.Status)))
+}
+
+// HandleDeliveryUserData update the children with a delivery ORDER_TRADE_UPDATE
+func (e *Executor) HandleDeliveryUserData(event *delivery.WsUserDataEvent) {
+	if event.Event != delivery.UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	u := event.OrderTradeUpdate
+	e.update(u.ClientOrderID, parseFloat(u.AccumulatedFilledQty), parseFloat(u.AveragePrice), final(string(u.Status)))
+}
+
+func final(status string) bool {
+	switch status {
+	case "FILLED", "CANCELED", "EXPIRED", "REJECTED", "EXPIRED_IN_MATCH":
+		return true
+	}
+	return false
+}
+
+// update record the cumulative fill of a child, reports from the order
+// response and the user data stream may arrive in any order
+func (e *Executor) update(clientOrderID string, executed, avgPrice float64, done bool) {
+	e.mu.Lock()
+	c, ok := e.children[clientOrderID]
+	if !ok {
+		e.mu.Unlock()
+		return
+	}
+	changed := executed > c.executed+epsilon || (done && !c.final)
+	if executed > c.executed {
+		c.executed, c.avgPrice = executed, avgPrice
+	}
+	c.final = c.final || done
+	e.mu.Unlock()
+	if changed {
+		e.notify()
+	}
+}
+
+func (e *Executor) filled() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var filled float64
+	for _, c := range e.children {
+		filled += c.executed
+	}
+	return filled
+}
+
+// capped apply the participation cap to a child quantity and reset the
+// market volume
+func (e *Executor) capped(qty float64) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.params.MaxParticipation > 0 {
+		qty = math.Min(qty, e.params.MaxParticipation*e.volume)
+	}
+	e.volume = 0
+	return qty
+}
+
+func (e *Executor) round(qty float64) float64 {
+	if step := e.params.StepSize; step > 0 {
+		qty = math.Floor(qty/step+1e-9) * step
+		qty, _ = strconv.ParseFloat(strconv.FormatFloat(qty, 'f', 10, 64), 64)
+	}
+	if qty < e.params.MinQuantity-epsilon || qty <= epsilon {
+		return 0
+	}
+	return qty
+}
+
+// place send a child order
+func (e *Executor) place(ctx context.Context, qty float64, tif string) (string, error) {
+	e.mu.Lock()
+	e.seq++
+	o := &ChildOrder{
+		Symbol:        e.params.Symbol,
+		Side:          e.params.Side,
+		Quantity:      qty,
+		Price:         e.params.LimitPrice,
+		ClientOrderID: fmt.Sprintf("%s-%d", e.id, e.seq),
+	}
+	if o.Price > 0 {
+		o.TimeInForce = tif
+	}
+	e.children[o.ClientOrderID] = &child{symbol: o.Symbol}
+	e.mu.Unlock()
+
+	r, err := e.placer.PlaceOrder(ctx, o)
+	if err != nil {
+		e.mu.Lock()
+		delete(e.children, o.ClientOrderID)
+		e.mu.Unlock()
+		return "", err
+	}
+	e.update(o.ClientOrderID, r.Executed, r.AvgPrice, final(r.Status))
+	return o.ClientOrderID, nil
+}
+
+// wait block for d and while the executor is paused
+func (e *Executor) wait(ctx context.Context, d time.Duration) error {
+	if d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return ErrCanceled
+		case <-timer.C:
+		}
+	}
+	for {
+		e.mu.Lock()
+		paused, resume := e.status == StatusPaused, e.resume
+		e.mu.Unlock()
+		if !paused {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return ErrCanceled
+		case <-resume:
+		}
+	}
+}
+
+// Run execute the algorithm, blocking until the quantity is filled, the
+// schedule ends, the executor is canceled or a child order fails
+func (e *Executor) Run(ctx context.Context) (err error) {
+	e.mu.Lock()
+	if e.status != StatusPending {
+		e.mu.Unlock()
+		return errors.New("executor already started")
+	}
+	e.status = StatusRunning
+	e.mu.Unlock()
+	e.notify()
+
+	switch e.params.Strategy {
+	case StrategyTWAP, StrategyVWAP:
+		err = e.runSchedule(ctx)
+	case StrategyPOV:
+		err = e.runPOV(ctx)
+	case StrategyIceberg:
+		err = e.runIceberg(ctx)
+	}
+	switch {
+	case err == nil:
+		e.setStatus(StatusDone, nil)
+	case errors.Is(err, ErrCanceled):
+		e.setStatus(StatusCanceled, nil)
+	default:
+		e.setStatus(StatusFailed, err)
+	}
+	return err
+}
+
+// runSchedule send a child at the start of each slice, up to the cumulative
+// target of the schedule
+func (e *Executor) runSchedule(ctx context.Context) error {
+	p := e.params
+	size := p.Duration / time.Duration(p.Slices)
+	start := time.Now()
+	var target float64
+	for i := 0; i < p.Slices; i++ {
+		if err := e.wait(ctx, time.Until(start.Add(time.Duration(i)*size))); err != nil {
+			return err
+		}
+		if p.Strategy == StrategyVWAP {
+			target += p.Quantity * p.Profile[i]
+		} else {
+			target += p.Quantity / float64(p.Slices)
+		}
+		if i == p.Slices-1 {
+			target = p.Quantity
+		}
+		qty := e.round(e.capped(math.Min(target, p.Quantity) - e.filled()))
+		if qty == 0 {
+			continue
+		}
+		if _, err := e.place(ctx, qty, "IOC"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runPOV send a child each interval for the participation share of the
+// market volume traded since the previous one
+func (e *Executor) runPOV(ctx context.Context) error {
+	p := e.params
+	start := time.Now()
+	for {
+		if err := e.wait(ctx, p.Interval); err != nil {
+			return err
+		}
+		remaining := p.Quantity - e.filled()
+		if remaining <= epsilon {
+			return nil
+		}
+		if p.Duration > 0 && time.Since(start) >= p.Duration {
+			return nil
+		}
+		qty := e.round(e.capped(remaining))
+		if qty == 0 {
+			continue
+		}
+		if _, err := e.place(ctx, qty, "IOC"); err != nil {
+			return err
+		}
+	}
+}
+
+// runIceberg keep one GTC clip working at the limit price until filled, the
+// clip is queried each interval in case no user data stream is connected
+func (e *Executor) runIceberg(ctx context.Context) error {
+	p := e.params
+	var working string
+	for {
+		if working != "" {
+			e.mu.Lock()
+			done := e.children[working].final
+			e.mu.Unlock()
+			if done {
+				working = ""
+			}
+		}
+		if working == "" {
+			remaining := p.Quantity - e.filled()
+			if remaining <= epsilon {
+				return nil
+			}
+			qty := e.round(math.Min(p.ClipQuantity, remaining))
+			if qty == 0 {
+				return nil
+			}
+			id, err := e.place(ctx, qty, "GTC")
+			if err != nil {
+				return err
+			}
+			working = id
+			continue
+		}
+		if err := e.wait(ctx, p.Interval); err != nil {
+			return e.cancelClip(working, err)
+		}
+		r, err := e.placer.QueryOrder(ctx, p.Symbol, working)
+		if err != nil {
+			return e.cancelClip(working, err)
+		}
+		e.update(working, r.Executed, r.AvgPrice, final(r.Status))
+	}
+}
+
+// cancelClip cancel the working iceberg clip when the run stops with err
+func (e *Executor) cancelClip(clientOrderID string, err error) error {
+	if cerr := e.placer.CancelOrder(context.Background(), e.params.Symbol, clientOrderID); cerr != nil {
+		return errors.Join(err, cerr)
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+package execution_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/execution"
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/gobinance/paper"
+)
+
+func newFutures() (*paper.Exchange, *futures.Client) {
+	ex := paper.NewExchange()
+	ex.AddSymbol(&paper.Symbol{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", TickSize: 0.1, StepSize: 0.001})
+	ex.SetFuturesBalance("USDT", 100000)
+	ex.UpdateBook("BTCUSDT",
+		[]common.PriceLevel{{Price: 99, Quantity: 10}},
+		[]common.PriceLevel{{Price: 100, Quantity: 1}, {Price: 101, Quantity: 10}},
+	)
+	client := futures.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+	return ex, client
+}
+
+func TestTWAP(t *testing.T) {
+	ex, client := newFutures()
+	e, err := execution.New(execution.NewFuturesPlacer(client), execution.Params{
+		Strategy:   execution.StrategyTWAP,
+		Symbol:     "BTCUSDT",
+		Side:       "BUY",
+		Quantity:   2,
+		Duration:   40 * time.Millisecond,
+		Slices:     4,
+		LimitPrice: 100,
+		StepSize:   0.001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ex.FuturesHandler = e.HandleFuturesUserData
+	if err = e.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	p := e.Progress()
+	if p.Status != execution.StatusDone || p.Filled != 1 || p.AvgPrice != 100 || p.Children != 4 {
+		t.Fatalf("unexpected progress %+v", p)
+	}
+}
+
+func TestIceberg(t *testing.T) {
+	ex, client := newFutures()
+	e, err := execution.New(execution.NewFuturesPlacer(client), execution.Params{
+		Strategy:     execution.StrategyIceberg,
+		Symbol:       "BTCUSDT",
+		Side:         "SELL",
+		Quantity:     3,
+		ClipQuantity: 1,
+		LimitPrice:   100.5,
+		Interval:     5 * time.Millisecond,
+		StepSize:     0.001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ex.FuturesHandler = e.HandleFuturesUserData
+	done := make(chan error)
+	go func() { done <- e.Run(context.Background()) }()
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+		ex.UpdateBook("BTCUSDT",
+			[]common.PriceLevel{{Price: 100.5, Quantity: 1}},
+			[]common.PriceLevel{{Price: 101, Quantity: 10}},
+		)
+	}
+	time.Sleep(20 * time.Millisecond)
+	e.Cancel()
+	if err = <-done; err != execution.ErrCanceled {
+		t.Fatalf("expected cancel, got %v", err)
+	}
+	p := e.Progress()
+	if p.Status != execution.StatusCanceled || p.Filled != 2 || p.Children != 3 {
+		t.Fatalf("unexpected progress %+v", p)
+	}
+	orders, err := client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 0 {
+		t.Fatalf("expected no open orders, got %d", len(orders))
+	}
+}
+
+func TestIcebergWithoutUserData(t *testing.T) {
+	ex, client := newFutures()
+	e, err := execution.New(execution.NewFuturesPlacer(client), execution.Params{
+		Strategy:     execution.StrategyIceberg,
+		Symbol:       "BTCUSDT",
+		Side:         "SELL",
+		Quantity:     2,
+		ClipQuantity: 1,
+		LimitPrice:   100.5,
+		Interval:     5 * time.Millisecond,
+		StepSize:     0.001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- e.Run(context.Background()) }()
+
+	// the resting clips fill later and are only seen by polling
+	for i := 0; i < 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+		ex.UpdateBook("BTCUSDT",
+			[]common.PriceLevel{{Price: 100.5, Quantity: 1}},
+			[]common.PriceLevel{{Price: 101, Quantity: 10}},
+		)
+	}
+	select {
+	case err = <-done:
+	case <-time.After(time.Second):
+		e.Cancel()
+		err = <-done
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := e.Progress()
+	if p.Status != execution.StatusDone || p.Filled != 2 || p.Children != 2 {
+		t.Fatalf("unexpected progress %+v", p)
+	}
+}
+
+func TestVolumeProfile(t *testing.T) {
+	start := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	hour := int64(time.Hour / time.Millisecond)
+	yesterday := start.Add(-24*time.Hour).UnixNano() / int64(time.Millisecond)
+	klines := []execution.Kline{
+		{OpenTime: yesterday, Volume: 1},
+		{OpenTime: yesterday + hour, Volume: 3},
+		{OpenTime: yesterday + 5*hour, Volume: 100},
+	}
+	w := execution.VolumeProfile(klines, start, 2*time.Hour, 2)
+	if len(w) != 2 || math.Abs(w[0]-0.25) > 1e-9 || math.Abs(w[1]-0.75) > 1e-9 {
+		t.Fatalf("unexpected profile %v", w)
+	}
+}
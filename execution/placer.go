@@ -0,0 +1,207 @@
+package execution
+
+import (
+	"context"
+	"strconv"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// ChildOrder define a child order sent by an executor
+type ChildOrder struct {
+	Symbol        string
+	Side          string
+	Quantity      float64
+	Price         float64 // limit price, market order when zero
+	TimeInForce   string  // time in force of limit orders
+	ClientOrderID string
+}
+
+// ChildReport define the state of a child order returned by the exchange
+type ChildReport struct {
+	OrderID  int64
+	Status   string
+	Executed float64
+	AvgPrice float64
+}
+
+// OrderPlacer define a market child orders are sent to
+type OrderPlacer interface {
+	PlaceOrder(ctx context.Context, o *ChildOrder) (*ChildReport, error)
+	CancelOrder(ctx context.Context, symbol, clientOrderID string) error
+	QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildReport, error)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func parseFloat(s string) float64 {
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
+
+// SpotPlacer place child orders with the spot order services
+type SpotPlacer struct {
+	c *binance.Client
+}
+
+// NewSpotPlacer init spot placer
+func NewSpotPlacer(c *binance.Client) *SpotPlacer {
+	return &SpotPlacer{c: c}
+}
+
+// PlaceOrder implement OrderPlacer
+func (p *SpotPlacer) PlaceOrder(ctx context.Context, o *ChildOrder) (*ChildReport, error) {
+	s := p.c.NewCreateOrderService().Symbol(o.Symbol).Side(binance.SideType(o.Side)).
+		Quantity(formatFloat(o.Quantity)).NewClientOrderID(o.ClientOrderID).
+		NewOrderRespType(binance.NewOrderRespTypeRESULT)
+	if o.Price > 0 {
+		s.Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceType(o.TimeInForce)).Price(formatFloat(o.Price))
+	} else {
+		s.Type(binance.OrderTypeMarket)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: parseFloat(res.ExecutedQuantity),
+	}
+	if r.Executed > 0 {
+		r.AvgPrice = parseFloat(res.CummulativeQuoteQuantity) / r.Executed
+	}
+	return r, nil
+}
+
+// CancelOrder implement OrderPlacer
+func (p *SpotPlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) error {
+	_, err := p.c.NewCancelOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	return err
+}
+
+// QueryOrder implement OrderPlacer
+func (p *SpotPlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildReport, error) {
+	res, err := p.c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: parseFloat(res.ExecutedQuantity),
+	}
+	if r.Executed > 0 {
+		r.AvgPrice = parseFloat(res.CummulativeQuoteQuantity) / r.Executed
+	}
+	return r, nil
+}
+
+// FuturesPlacer place child orders with the USD-M futures order services
+type FuturesPlacer struct {
+	c *futures.Client
+}
+
+// NewFuturesPlacer init futures placer
+func NewFuturesPlacer(c *futures.Client) *FuturesPlacer {
+	return &FuturesPlacer{c: c}
+}
+
+// PlaceOrder implement OrderPlacer
+func (p *FuturesPlacer) PlaceOrder(ctx context.Context, o *ChildOrder) (*ChildReport, error) {
+	s := p.c.NewCreateOrderService().Symbol(o.Symbol).Side(futures.SideType(o.Side)).
+		Quantity(formatFloat(o.Quantity)).NewClientOrderID(o.ClientOrderID).
+		NewOrderResponseType(futures.NewOrderRespTypeRESULT)
+	if o.Price > 0 {
+		s.Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceType(o.TimeInForce)).Price(formatFloat(o.Price))
+	} else {
+		s.Type(futures.OrderTypeMarket)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: res.ExecutedQuantity,
+		AvgPrice: res.AvgPrice,
+	}, nil
+}
+
+// CancelOrder implement OrderPlacer
+func (p *FuturesPlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) error {
+	_, err := p.c.NewCancelOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	return err
+}
+
+// QueryOrder implement OrderPlacer
+func (p *FuturesPlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildReport, error) {
+	res, err := p.c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: res.ExecutedQuantity,
+		AvgPrice: res.AvgPrice,
+	}, nil
+}
+
+// DeliveryPlacer place child orders with the COIN-M futures order services,
+// quantities are in contracts
+type DeliveryPlacer struct {
+	c *delivery.Client
+}
+
+// NewDeliveryPlacer init delivery placer
+func NewDeliveryPlacer(c *delivery.Client) *DeliveryPlacer {
+	return &DeliveryPlacer{c: c}
+}
+
+// PlaceOrder implement OrderPlacer
+func (p *DeliveryPlacer) PlaceOrder(ctx context.Context, o *ChildOrder) (*ChildReport, error) {
+	s := p.c.NewCreateOrderService().Symbol(o.Symbol).Side(delivery.SideType(o.Side)).
+		Quantity(formatFloat(o.Quantity)).NewClientOrderID(o.ClientOrderID).
+		NewOrderResponseType(delivery.NewOrderRespTypeRESULT)
+	if o.Price > 0 {
+		s.Type(delivery.OrderTypeLimit).TimeInForce(delivery.TimeInForceType(o.TimeInForce)).Price(formatFloat(o.Price))
+	} else {
+		s.Type(delivery.OrderTypeMarket)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: parseFloat(res.ExecutedQuantity),
+		AvgPrice: parseFloat(res.AvgPrice),
+	}, nil
+}
+
+// CancelOrder implement OrderPlacer
+func (p *DeliveryPlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) error {
+	_, err := p.c.NewCancelOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	return err
+}
+
+// QueryOrder implement OrderPlacer
+func (p *DeliveryPlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildReport, error) {
+	res, err := p.c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChildReport{
+		OrderID:  res.OrderID,
+		Status:   string(res.Status),
+		Executed: parseFloat(res.ExecutedQuantity),
+		AvgPrice: parseFloat(res.AvgPrice),
+	}, nil
+}
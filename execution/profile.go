@@ -0,0 +1,91 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+const day = 24 * time.Hour
+
+// Kline define the open time and volume of a historical kline
+type Kline struct {
+	OpenTime int64
+	Volume   float64
+}
+
+// VolumeProfile return the share of the daily volume traded in each of the
+// slices of the period [start, start+duration), by time of day (UTC) of the
+// historical klines. The weights sum to 1 and are uniform without volume.
+func VolumeProfile(klines []Kline, start time.Time, duration time.Duration, slices int) []float64 {
+	if slices <= 0 {
+		return nil
+	}
+	weights := make([]float64, slices)
+	size := duration / time.Duration(slices)
+	startOfDay := time.Duration(start.UTC().UnixNano()) % day
+	var total float64
+	if size > 0 {
+		for _, k := range klines {
+			tod := time.Duration(k.OpenTime) * time.Millisecond % day
+			i := int(((tod - startOfDay + day) % day) / size)
+			if i < slices {
+				weights[i] += k.Volume
+				total += k.Volume
+			}
+		}
+	}
+	for i := range weights {
+		if total > 0 {
+			weights[i] /= total
+		} else {
+			weights[i] = 1 / float64(slices)
+		}
+	}
+	return weights
+}
+
+// SpotKlines fetch klines from the spot market for VolumeProfile
+func SpotKlines(ctx context.Context, c *binance.Client, symbol, interval string, startTime, endTime int64) ([]Kline, error) {
+	klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+		StartTime(startTime).EndTime(endTime).Limit(1000).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Kline, 0, len(klines))
+	for _, k := range klines {
+		res = append(res, Kline{OpenTime: k.OpenTime, Volume: parseFloat(k.Volume)})
+	}
+	return res, nil
+}
+
+// FuturesKlines fetch klines from the USD-M futures market for VolumeProfile
+func FuturesKlines(ctx context.Context, c *futures.Client, symbol, interval string, startTime, endTime int64) ([]Kline, error) {
+	klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+		StartTime(startTime).EndTime(endTime).Limit(1500).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Kline, 0, len(klines))
+	for _, k := range klines {
+		res = append(res, Kline{OpenTime: k.OpenTime, Volume: k.Volume})
+	}
+	return res, nil
+}
+
+// DeliveryKlines fetch klines from the COIN-M futures market for VolumeProfile
+func DeliveryKlines(ctx context.Context, c *delivery.Client, symbol, interval string, startTime, endTime int64) ([]Kline, error) {
+	klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+		StartTime(startTime).EndTime(endTime).Limit(1500).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Kline, 0, len(klines))
+	for _, k := range klines {
+		res = append(res, Kline{OpenTime: k.OpenTime, Volume: k.Volume})
+	}
+	return res, nil
+}
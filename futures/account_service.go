@@ -13,9 +13,9 @@ type GetBalanceService struct {
 // Do send request
 func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/balance",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/balance",
+		SecType:  secTypeSigned,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -44,9 +44,9 @@ type GetAccountService struct {
 // Do send request
 func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v2/account",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v2/account",
+		SecType:  secTypeSigned,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -122,9 +122,9 @@ type GetSymbolConfigService struct {
 // Do send request
 func (s *GetSymbolConfigService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolConfig, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/symbolConfig",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/symbolConfig",
+		SecType:  secTypeSigned,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -171,12 +171,12 @@ func (s *TradingStatusService) Symbol(symbol string) *TradingStatusService {
 
 func (s *TradingStatusService) Do(ctx context.Context, opts ...RequestOption) (TradingStatus, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/apiTradingStatus",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/apiTradingStatus",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
 
 	var res TradingStatus
@@ -187,3 +187,88 @@ func (s *TradingStatusService) Do(ctx context.Context, opts ...RequestOption) (T
 	err = json.Unmarshal(data, &res)
 	return res, err
 }
+
+// FeeBurn define BNB fee burn status
+type FeeBurn struct {
+	FeeBurn bool `json:"feeBurn"`
+}
+
+// GetFeeBurnService get whether the fees are paid with BNB
+type GetFeeBurnService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (bool, error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/feeBurn",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return false, err
+	}
+	res := new(FeeBurn)
+	if err := json.Unmarshal(data, res); err != nil {
+		return false, err
+	}
+	return res.FeeBurn, nil
+}
+
+// ChangeFeeBurnService change whether the fees are paid with BNB
+type ChangeFeeBurnService struct {
+	c       *Client
+	feeBurn string
+}
+
+// FeeBurn set fee burn: true - pay fees with BNB, false - pay fees with the margin asset
+func (s *ChangeFeeBurnService) FeeBurn(feeBurn bool) *ChangeFeeBurnService {
+	if feeBurn {
+		s.feeBurn = "true"
+	} else {
+		s.feeBurn = "false"
+	}
+	return s
+}
+
+// Do send request
+func (s *ChangeFeeBurnService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/feeBurn",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"feeBurn": s.feeBurn,
+	})
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetOrderRateLimitService get the order rate limits of the account
+type GetOrderRateLimitService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetOrderRateLimitService) Do(ctx context.Context, opts ...RequestOption) (res []*RateLimit, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/rateLimit/order",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*RateLimit{}, err
+	}
+	res = make([]*RateLimit, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*RateLimit{}, err
+	}
+	return res, nil
+}
@@ -150,9 +150,9 @@ func (s *CreateAlgoOrderService) ClosePosition(closePosition bool) *CreateAlgoOr
 
 func (s *CreateAlgoOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"algoType":         "CONDITIONAL",
@@ -209,7 +209,7 @@ func (s *CreateAlgoOrderService) createOrder(ctx context.Context, endpoint strin
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	data, header, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -278,12 +278,12 @@ func (s *ListAlgoOpenOrdersService) Symbol(symbol string) *ListAlgoOpenOrdersSer
 // Do send request
 func (s *ListAlgoOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*AlgoOrder, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/openAlgoOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/openAlgoOrders",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -355,16 +355,16 @@ func (s *CancelAlgoOrderService) ClientAlgoId(clientAlgoId string) *CancelAlgoOr
 // Do send request
 func (s *CancelAlgoOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAlgoOrderResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/fapi/v1/algoOrder",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/fapi/v1/algoOrder",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.algoId != nil {
-		r.setFormParam("algoId", *s.algoId)
+		r.SetFormParam("algoId", *s.algoId)
 	}
 	if s.clientAlgoId != nil {
-		r.setFormParam("clientAlgoId", *s.clientAlgoId)
+		r.SetFormParam("clientAlgoId", *s.clientAlgoId)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -0,0 +1,453 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BracketStatus define status of a bracket order
+type BracketStatus string
+
+// BracketLeg define a leg of a bracket order
+type BracketLeg string
+
+const (
+	BracketStatusPending  BracketStatus = "PENDING"  // entry is working
+	BracketStatusOpen     BracketStatus = "OPEN"     // entry is filled, take profit and stop loss are working
+	BracketStatusClosed   BracketStatus = "CLOSED"   // a leg is filled and its sibling canceled
+	BracketStatusCanceled BracketStatus = "CANCELED" // entry or both legs ended without a fill
+
+	BracketLegEntry      BracketLeg = "e"
+	BracketLegTakeProfit BracketLeg = "tp"
+	BracketLegStopLoss   BracketLeg = "sl"
+
+	bracketPrefix = "bkt"
+)
+
+// BracketOrder define an entry order protected by a take profit and a stop loss
+type BracketOrder struct {
+	ID              string           // generated when empty, at most 26 characters
+	Symbol          string           // symbol
+	Side            SideType         // side of the entry order
+	PositionSide    PositionSideType // position side in hedge mode
+	Quantity        string           // quantity of the entry order
+	Price           string           // price of the entry order, market order when empty
+	TakeProfitPrice string           // stop price of the TAKE_PROFIT_MARKET leg
+	StopLossPrice   string           // stop price of the STOP_MARKET leg
+	WorkingType     WorkingType      // working type of the legs
+	ClosePosition   bool             // legs close the whole position instead of the filled quantity
+
+	Status            BracketStatus
+	ClosedBy          BracketLeg // leg which closed the bracket
+	EntryOrderID      int64
+	TakeProfitOrderID int64
+	StopLossOrderID   int64
+
+	tpDone bool
+	slDone bool
+}
+
+func (b *BracketOrder) exitSide() SideType {
+	if b.Side == SideTypeBuy {
+		return SideTypeSell
+	}
+	return SideTypeBuy
+}
+
+// BracketClientOrderID return the client order id of a leg of a bracket
+func BracketClientOrderID(id string, leg BracketLeg) string {
+	return fmt.Sprintf("%s-%s-%s", bracketPrefix, id, leg)
+}
+
+// ParseBracketClientOrderID return the bracket id and leg of a client order id
+func ParseBracketClientOrderID(clientOrderID string) (id string, leg BracketLeg, ok bool) {
+	if !strings.HasPrefix(clientOrderID, bracketPrefix+"-") {
+		return "", "", false
+	}
+	s := strings.TrimPrefix(clientOrderID, bracketPrefix+"-")
+	i := strings.LastIndex(s, "-")
+	if i <= 0 {
+		return "", "", false
+	}
+	leg = BracketLeg(s[i+1:])
+	switch leg {
+	case BracketLegEntry, BracketLegTakeProfit, BracketLegStopLoss:
+		return s[:i], leg, true
+	}
+	return "", "", false
+}
+
+// BracketOrderManager place bracket orders and keep their legs in sync
+// with the user data stream. Feed HandleUserData with the events of
+// WsUserDataServe.
+type BracketOrderManager struct {
+	c *Client
+	// ErrHandler receives the errors of requests sent from HandleUserData
+	ErrHandler ErrHandler
+
+	mu       sync.Mutex
+	brackets map[string]*BracketOrder
+}
+
+// Place place the entry order of a bracket, the take profit and stop loss
+// legs are placed once the entry is filled
+func (m *BracketOrderManager) Place(ctx context.Context, b *BracketOrder, opts ...RequestOption) error {
+	if b.Symbol == "" || b.Quantity == "" || b.TakeProfitPrice == "" || b.StopLossPrice == "" {
+		return errors.New("symbol, quantity, take profit price and stop loss price are required")
+	}
+	if b.ID == "" {
+		b.ID = strconv.FormatInt(time.Now().UTC().UnixNano(), 36) + strconv.FormatUint(uint64(rand.Uint32()%1296), 36)
+	}
+	if strings.Contains(b.ID, "-") || len(BracketClientOrderID(b.ID, BracketLegTakeProfit)) > 36 {
+		return fmt.Errorf("invalid bracket id %q", b.ID)
+	}
+	m.mu.Lock()
+	if _, ok := m.brackets[b.ID]; ok {
+		m.mu.Unlock()
+		return fmt.Errorf("duplicate bracket id %q", b.ID)
+	}
+	b.Status = BracketStatusPending
+	m.brackets[b.ID] = b
+	m.mu.Unlock()
+
+	s := m.c.NewCreateOrderService().Symbol(b.Symbol).Side(b.Side).Quantity(b.Quantity).
+		NewClientOrderID(BracketClientOrderID(b.ID, BracketLegEntry))
+	if b.PositionSide != "" {
+		s.PositionSide(b.PositionSide)
+	}
+	if b.Price == "" {
+		s.Type(OrderTypeMarket)
+	} else {
+		s.Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Price(b.Price)
+	}
+	res, err := s.Do(ctx, opts...)
+	if err != nil {
+		m.mu.Lock()
+		delete(m.brackets, b.ID)
+		b.Status = BracketStatusCanceled
+		m.mu.Unlock()
+		return err
+	}
+	m.mu.Lock()
+	b.EntryOrderID = res.OrderID
+	m.mu.Unlock()
+	if res.Status == OrderStatusTypeFilled {
+		return m.placeLegs(ctx, b.ID, b.Quantity, opts...)
+	}
+	return nil
+}
+
+// placeLegs place the take profit and stop loss of a bracket whose entry is done
+func (m *BracketOrderManager) placeLegs(ctx context.Context, id string, quantity string, opts ...RequestOption) error {
+	m.mu.Lock()
+	b, ok := m.brackets[id]
+	if !ok || b.Status != BracketStatusPending {
+		m.mu.Unlock()
+		return nil
+	}
+	if b.TakeProfitPrice == "" || b.StopLossPrice == "" {
+		m.mu.Unlock()
+		return fmt.Errorf("bracket %s: entry filled without leg prices", id)
+	}
+	b.Status = BracketStatusOpen
+	m.mu.Unlock()
+
+	var errs []error
+	for _, leg := range []BracketLeg{BracketLegTakeProfit, BracketLegStopLoss} {
+		s := m.c.NewCreateOrderService().Symbol(b.Symbol).Side(b.exitSide()).
+			NewClientOrderID(BracketClientOrderID(b.ID, leg))
+		if leg == BracketLegTakeProfit {
+			s.Type(OrderTypeTakeProfitMarket).StopPrice(b.TakeProfitPrice)
+		} else {
+			s.Type(OrderTypeStopMarket).StopPrice(b.StopLossPrice)
+		}
+		if b.WorkingType != "" {
+			s.WorkingType(b.WorkingType)
+		}
+		switch {
+		case b.ClosePosition:
+			s.ClosePosition(true)
+		case b.PositionSide == "" || b.PositionSide == PositionSideTypeBoth:
+			s.Quantity(quantity).ReduceOnly(true)
+		default:
+			s.Quantity(quantity)
+		}
+		if b.PositionSide != "" {
+			s.PositionSide(b.PositionSide)
+		}
+		res, err := s.Do(ctx, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bracket %s: place %s: %w", b.ID, leg, err))
+			continue
+		}
+		m.mu.Lock()
+		if leg == BracketLegTakeProfit {
+			b.TakeProfitOrderID = res.OrderID
+		} else {
+			b.StopLossOrderID = res.OrderID
+		}
+		m.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// cancelLeg cancel a leg of a bracket
+func (m *BracketOrderManager) cancelLeg(ctx context.Context, b *BracketOrder, leg BracketLeg, opts ...RequestOption) error {
+	_, err := m.c.NewCancelOrderService().Symbol(b.Symbol).
+		OrigClientOrderID(BracketClientOrderID(b.ID, leg)).Do(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("bracket %s: cancel %s: %w", b.ID, leg, err)
+	}
+	return nil
+}
+
+// Cancel cancel the working orders of a bracket, the filled part of a
+// pending entry is left without legs
+func (m *BracketOrderManager) Cancel(ctx context.Context, id string, opts ...RequestOption) error {
+	m.mu.Lock()
+	b, ok := m.brackets[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("unknown bracket %q", id)
+	}
+	status, tpDone, slDone := b.Status, b.tpDone, b.slDone
+	if status == BracketStatusPending || status == BracketStatusOpen {
+		b.Status = BracketStatusCanceled
+	}
+	m.mu.Unlock()
+
+	var legs []BracketLeg
+	switch status {
+	case BracketStatusPending:
+		legs = []BracketLeg{BracketLegEntry}
+	case BracketStatusOpen:
+		if !tpDone {
+			legs = append(legs, BracketLegTakeProfit)
+		}
+		if !slDone {
+			legs = append(legs, BracketLegStopLoss)
+		}
+	}
+	var errs []error
+	for _, leg := range legs {
+		if err := m.cancelLeg(ctx, b, leg, opts...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HandleUserData update the brackets with an ORDER_TRADE_UPDATE event,
+// placing the legs when the entry fills and canceling the sibling when a leg fills
+func (m *BracketOrderManager) HandleUserData(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	u := event.OrderTradeUpdate
+	id, leg, ok := ParseBracketClientOrderID(u.ClientOrderID)
+	if !ok {
+		return
+	}
+	final := u.Status == OrderStatusTypeCanceled || u.Status == OrderStatusTypeExpired
+	m.mu.Lock()
+	b, ok := m.brackets[id]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+	var action func(ctx context.Context) error
+	switch leg {
+	case BracketLegEntry:
+		b.EntryOrderID = u.ID
+		if b.Status != BracketStatusPending {
+			break
+		}
+		switch {
+		case u.Status == OrderStatusTypeFilled:
+			action = func(ctx context.Context) error { return m.placeLegs(ctx, id, b.Quantity) }
+		case final && u.AccumulatedFilledQty > 0:
+			qty := strconv.FormatFloat(u.AccumulatedFilledQty, 'f', -1, 64)
+			action = func(ctx context.Context) error { return m.placeLegs(ctx, id, qty) }
+		case final:
+			b.Status = BracketStatusCanceled
+		}
+	case BracketLegTakeProfit, BracketLegStopLoss:
+		sibling := BracketLegStopLoss
+		if leg == BracketLegTakeProfit {
+			b.TakeProfitOrderID = u.ID
+		} else {
+			b.StopLossOrderID = u.ID
+			sibling = BracketLegTakeProfit
+		}
+		if b.Status != BracketStatusOpen {
+			break
+		}
+		switch {
+		case u.Status == OrderStatusTypeFilled:
+			b.Status = BracketStatusClosed
+			b.ClosedBy = leg
+			action = func(ctx context.Context) error { return m.cancelLeg(ctx, b, sibling) }
+		case final:
+			if leg == BracketLegTakeProfit {
+				b.tpDone = true
+			} else {
+				b.slDone = true
+			}
+			if b.tpDone && b.slDone {
+				b.Status = BracketStatusCanceled
+			}
+		}
+	}
+	m.mu.Unlock()
+
+	if action == nil {
+		return
+	}
+	if err := action(context.Background()); err != nil && m.ErrHandler != nil {
+		m.ErrHandler(err)
+	}
+}
+
+// Recover rebuild the state of the brackets of a symbol from its open orders,
+// e.g. after a restart. A bracket whose entry is still open carries no leg
+// prices on the exchange, set them with SetLegPrices before the entry fills.
+// When only one leg is left, the other one is queried and the remaining leg
+// is canceled if its sibling filled meanwhile.
+func (m *BracketOrderManager) Recover(ctx context.Context, symbol string, opts ...RequestOption) (res []*BracketOrder, err error) {
+	orders, err := m.c.NewListOpenOrdersService().Symbol(symbol).Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]*BracketOrder)
+	for _, o := range orders {
+		id, leg, ok := ParseBracketClientOrderID(o.ClientOrderID)
+		if !ok {
+			continue
+		}
+		b, ok := found[id]
+		if !ok {
+			b = &BracketOrder{
+				ID:           id,
+				Symbol:       o.Symbol,
+				PositionSide: o.PositionSide,
+				Status:       BracketStatusOpen,
+				tpDone:       true,
+				slDone:       true,
+			}
+			found[id] = b
+		}
+		switch leg {
+		case BracketLegEntry:
+			b.Side = o.Side
+			b.Quantity = strconv.FormatFloat(o.OrigQuantity, 'f', -1, 64)
+			if o.Type == OrderTypeLimit {
+				b.Price = strconv.FormatFloat(o.Price, 'f', -1, 64)
+			}
+			b.EntryOrderID = o.OrderID
+			b.Status = BracketStatusPending
+		case BracketLegTakeProfit, BracketLegStopLoss:
+			b.Side = SideTypeBuy
+			if o.Side == SideTypeBuy {
+				b.Side = SideTypeSell
+			}
+			b.WorkingType = o.WorkingType
+			b.ClosePosition = o.ClosePosition
+			if !o.ClosePosition {
+				b.Quantity = strconv.FormatFloat(o.OrigQuantity, 'f', -1, 64)
+			}
+			price := strconv.FormatFloat(o.StopPrice, 'f', -1, 64)
+			if leg == BracketLegTakeProfit {
+				b.TakeProfitPrice, b.TakeProfitOrderID, b.tpDone = price, o.OrderID, false
+			} else {
+				b.StopLossPrice, b.StopLossOrderID, b.slDone = price, o.OrderID, false
+			}
+		}
+	}
+
+	var errs []error
+	for _, b := range found {
+		if b.Status == BracketStatusPending || b.tpDone == b.slDone {
+			continue
+		}
+		missing, remaining := BracketLegTakeProfit, BracketLegStopLoss
+		if b.slDone {
+			missing, remaining = BracketLegStopLoss, BracketLegTakeProfit
+		}
+		o, err := m.c.NewGetOrderService().Symbol(b.Symbol).
+			OrigClientOrderID(BracketClientOrderID(b.ID, missing)).Do(ctx, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bracket %s: query %s: %w", b.ID, missing, err))
+			continue
+		}
+		if o.Status != OrderStatusTypeFilled {
+			continue
+		}
+		b.Status = BracketStatusClosed
+		b.ClosedBy = missing
+		if err = m.cancelLeg(ctx, b, remaining, opts...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	m.mu.Lock()
+	for id, b := range found {
+		if old, ok := m.brackets[id]; ok && b.Status == BracketStatusPending {
+			b.TakeProfitPrice, b.StopLossPrice = old.TakeProfitPrice, old.StopLossPrice
+		}
+		m.brackets[id] = b
+	}
+	m.mu.Unlock()
+	res = make([]*BracketOrder, 0, len(found))
+	for _, b := range found {
+		res = append(res, b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, errors.Join(errs...)
+}
+
+// SetLegPrices set the take profit and stop loss prices of a bracket whose
+// entry is still working
+func (m *BracketOrderManager) SetLegPrices(id string, takeProfitPrice, stopLossPrice string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.brackets[id]
+	if !ok {
+		return fmt.Errorf("unknown bracket %q", id)
+	}
+	if b.Status != BracketStatusPending {
+		return fmt.Errorf("bracket %s is %s", id, b.Status)
+	}
+	b.TakeProfitPrice = takeProfitPrice
+	b.StopLossPrice = stopLossPrice
+	return nil
+}
+
+// Bracket return a copy of a bracket
+func (m *BracketOrderManager) Bracket(id string) (BracketOrder, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.brackets[id]
+	if !ok {
+		return BracketOrder{}, false
+	}
+	return *b, true
+}
+
+// Brackets return a copy of all brackets
+func (m *BracketOrderManager) Brackets() []BracketOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make([]BracketOrder, 0, len(m.brackets))
+	for _, b := range m.brackets {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
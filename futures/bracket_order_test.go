@@ -0,0 +1,58 @@
+package futures_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/gobinance/paper"
+)
+
+func TestBracketOrder(t *testing.T) {
+	ex := paper.NewExchange()
+	ex.AddSymbol(&paper.Symbol{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", TickSize: 0.1, StepSize: 0.001})
+	ex.SetFuturesBalance("USDT", 1000)
+	ex.UpdateBook("BTCUSDT", []common.PriceLevel{{Price: 99, Quantity: 5}}, []common.PriceLevel{{Price: 101, Quantity: 5}})
+
+	client := futures.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+	m := client.NewBracketOrderManager()
+	m.ErrHandler = func(err error) { t.Error(err) }
+	ex.FuturesHandler = m.HandleUserData
+
+	err := m.Place(context.Background(), &futures.BracketOrder{
+		ID:              "b1",
+		Symbol:          "BTCUSDT",
+		Side:            futures.SideTypeBuy,
+		Quantity:        "1",
+		TakeProfitPrice: "110",
+		StopLossPrice:   "90",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := m.Bracket("b1"); b.Status != futures.BracketStatusOpen || b.TakeProfitOrderID == 0 || b.StopLossOrderID == 0 {
+		t.Fatalf("unexpected bracket %+v", b)
+	}
+
+	recovered, err := client.NewBracketOrderManager().Recover(context.Background(), "BTCUSDT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recovered) != 1 || recovered[0].Status != futures.BracketStatusOpen || recovered[0].StopLossPrice != "90" {
+		t.Fatalf("unexpected recovered brackets %+v", recovered)
+	}
+
+	ex.UpdateBook("BTCUSDT", []common.PriceLevel{{Price: 111, Quantity: 5}}, []common.PriceLevel{{Price: 112, Quantity: 5}})
+	if b, _ := m.Bracket("b1"); b.Status != futures.BracketStatusClosed || b.ClosedBy != futures.BracketLegTakeProfit {
+		t.Fatalf("unexpected bracket %+v", b)
+	}
+	orders, err := client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 0 {
+		t.Fatalf("expected no open orders, got %d", len(orders))
+	}
+}
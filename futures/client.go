@@ -1,21 +1,15 @@
 package futures
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"crypto/tls"
-	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
-	"time"
 
 	jsoniter "github.com/json-iterator/go"
-	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/internal/transport"
 )
 
 // SideType define side type of order
@@ -66,6 +60,15 @@ type MarginType string
 // ContractType define contract type
 type ContractType string
 
+// StatisticsPeriod define period of the futures data statistics
+type StatisticsPeriod string
+
+// KlineInterval define interval of the klines
+type KlineInterval string
+
+// DownloadStatus define status of a history export
+type DownloadStatus string
+
 // UserDataEventType define user data event type
 type UserDataEventType string
 
@@ -166,7 +169,38 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
-	ContractTypePerpetual ContractType = "PERPETUAL"
+	ContractTypePerpetual      ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter    ContractType = "NEXT_QUARTER"
+
+	StatisticsPeriod5m  StatisticsPeriod = "5m"
+	StatisticsPeriod15m StatisticsPeriod = "15m"
+	StatisticsPeriod30m StatisticsPeriod = "30m"
+	StatisticsPeriod1h  StatisticsPeriod = "1h"
+	StatisticsPeriod2h  StatisticsPeriod = "2h"
+	StatisticsPeriod4h  StatisticsPeriod = "4h"
+	StatisticsPeriod6h  StatisticsPeriod = "6h"
+	StatisticsPeriod12h StatisticsPeriod = "12h"
+	StatisticsPeriod1d  StatisticsPeriod = "1d"
+
+	KlineInterval1m  KlineInterval = "1m"
+	KlineInterval3m  KlineInterval = "3m"
+	KlineInterval5m  KlineInterval = "5m"
+	KlineInterval15m KlineInterval = "15m"
+	KlineInterval30m KlineInterval = "30m"
+	KlineInterval1h  KlineInterval = "1h"
+	KlineInterval2h  KlineInterval = "2h"
+	KlineInterval4h  KlineInterval = "4h"
+	KlineInterval6h  KlineInterval = "6h"
+	KlineInterval8h  KlineInterval = "8h"
+	KlineInterval12h KlineInterval = "12h"
+	KlineInterval1d  KlineInterval = "1d"
+	KlineInterval3d  KlineInterval = "3d"
+	KlineInterval1w  KlineInterval = "1w"
+	KlineInterval1M  KlineInterval = "1M"
+
+	DownloadStatusCompleted  DownloadStatus = "completed"
+	DownloadStatusProcessing DownloadStatus = "processing"
 
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
@@ -196,16 +230,8 @@ const (
 	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
 	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
 	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
-
-	timestampKey  = "timestamp"
-	signatureKey  = "signature"
-	recvWindowKey = "recvWindow"
 )
 
-func currentTimestamp() int64 {
-	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
-}
-
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
@@ -240,7 +266,7 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 	}
 }
 
-type doFunc func(req *http.Request) (*http.Response, error)
+type doFunc = transport.DoFunc
 
 // Client define API client
 type Client struct {
@@ -255,125 +281,30 @@ type Client struct {
 	do         doFunc
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
-	}
+func (c *Client) UseTestnet(testnet bool) *Client {
+	c.Testnet = testnet
+	return c
 }
 
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	baseUrl := baseApiMainUrl
+func (c *Client) transport() *transport.Client {
+	baseURL := baseApiMainUrl
 	if c.Testnet {
-		baseUrl = baseApiTestnetUrl
+		baseURL = baseApiTestnetUrl
 	}
-
-	// set request options from user
-	for _, opt := range opts {
-		opt(r)
-	}
-	err = r.validate()
-	if err != nil {
-		return err
+	return &transport.Client{
+		APIKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		BaseURL:    baseURL,
+		HTTPClient: c.HTTPClient,
+		Debug:      c.Debug,
+		Logger:     c.Logger,
+		TimeOffset: c.TimeOffset,
+		Do:         c.do,
 	}
-
-	fullURL := fmt.Sprintf("%s%s", baseUrl, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow)
-	}
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
-		if err != nil {
-			return err
-		}
-		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
-	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
-	return nil
-}
-
-func (c *Client) UseTestnet(testnet bool) *Client {
-	c.Testnet = testnet
-	return c
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
-	}
-	res, err := f(req)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	data, err = io.ReadAll(res.Body)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the retured error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, &http.Header{}, apiErr
-	}
-	return data, &res.Header, nil
+	return c.transport().CallAPI(ctx, r, opts...)
 }
 
 // NewPingService init ping service
@@ -416,6 +347,16 @@ func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
 	return &IndexPriceKlinesService{c: c}
 }
 
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
 // NewMarkPriceKlinesService init markPriceKlines service
 func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
 	return &MarkPriceKlinesService{c: c}
@@ -471,6 +412,23 @@ func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
 }
 
+// NewBracketOrderManager init bracket order manager
+func (c *Client) NewBracketOrderManager() *BracketOrderManager {
+	return &BracketOrderManager{c: c, brackets: make(map[string]*BracketOrder)}
+}
+
+// NewPositionBook init position book
+func (c *Client) NewPositionBook() *PositionBook {
+	return &PositionBook{
+		c:         c,
+		positions: make(map[positionKey]*BookPosition),
+		balances:  make(map[string]float64),
+		symbols:   make(map[string]*PnL),
+		tags:      make(map[string]*PnL),
+		tagAmts:   make(map[positionKey]map[string]float64),
+	}
+}
+
 // NewCancelOrderService init cancel order service
 func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
@@ -481,6 +439,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -586,6 +549,21 @@ func (c *Client) NewChangeLeverageService() *ChangeLeverageService {
 	return &ChangeLeverageService{c: c}
 }
 
+// NewGetFundingInfoService init funding info service
+func (c *Client) NewGetFundingInfoService() *GetFundingInfoService {
+	return &GetFundingInfoService{c: c}
+}
+
+// NewEstimateFundingCostService init estimate funding cost service
+func (c *Client) NewEstimateFundingCostService() *EstimateFundingCostService {
+	return &EstimateFundingCostService{c: c}
+}
+
+// NewFundingAggregator init funding aggregator
+func (c *Client) NewFundingAggregator() *FundingAggregator {
+	return &FundingAggregator{c: c, intervals: make(map[string]*FundingInfo), rates: make(map[string]*FundingSnapshot)}
+}
+
 // NewGetLeverageBracketService init change leverage service
 func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
 	return &GetLeverageBracketService{c: c}
@@ -611,6 +589,81 @@ func (c *Client) NewChangePositionModeService() *ChangePositionModeService {
 	return &ChangePositionModeService{c: c}
 }
 
+// NewGetMultiAssetsModeService init get multi-assets mode service
+func (c *Client) NewGetMultiAssetsModeService() *GetMultiAssetsModeService {
+	return &GetMultiAssetsModeService{c: c}
+}
+
+// NewChangeMultiAssetsModeService init change multi-assets mode service
+func (c *Client) NewChangeMultiAssetsModeService() *ChangeMultiAssetsModeService {
+	return &ChangeMultiAssetsModeService{c: c}
+}
+
+// NewGetADLQuantileService init ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
+// NewListPositionADLService init list position ADL service
+func (c *Client) NewListPositionADLService() *ListPositionADLService {
+	return &ListPositionADLService{c: c}
+}
+
+// NewGetFeeBurnService init get fee burn service
+func (c *Client) NewGetFeeBurnService() *GetFeeBurnService {
+	return &GetFeeBurnService{c: c}
+}
+
+// NewChangeFeeBurnService init change fee burn service
+func (c *Client) NewChangeFeeBurnService() *ChangeFeeBurnService {
+	return &ChangeFeeBurnService{c: c}
+}
+
+// NewGetOrderRateLimitService init order rate limit service
+func (c *Client) NewGetOrderRateLimitService() *GetOrderRateLimitService {
+	return &GetOrderRateLimitService{c: c}
+}
+
+// NewListOrderAmendmentService init list order amendment service
+func (c *Client) NewListOrderAmendmentService() *ListOrderAmendmentService {
+	return &ListOrderAmendmentService{c: c}
+}
+
+// NewGetIncomeDownloadIDService init get income download id service
+func (c *Client) NewGetIncomeDownloadIDService() *GetIncomeDownloadIDService {
+	return &GetIncomeDownloadIDService{c: c}
+}
+
+// NewGetIncomeDownloadLinkService init get income download link service
+func (c *Client) NewGetIncomeDownloadLinkService() *GetIncomeDownloadLinkService {
+	return &GetIncomeDownloadLinkService{c: c}
+}
+
+// NewHistoryExporter init history exporter
+func (c *Client) NewHistoryExporter() *HistoryExporter {
+	return &HistoryExporter{c: c}
+}
+
+// NewGetOrderDownloadIDService init get order download id service
+func (c *Client) NewGetOrderDownloadIDService() *GetOrderDownloadIDService {
+	return &GetOrderDownloadIDService{c: c}
+}
+
+// NewGetOrderDownloadLinkService init get order download link service
+func (c *Client) NewGetOrderDownloadLinkService() *GetOrderDownloadLinkService {
+	return &GetOrderDownloadLinkService{c: c}
+}
+
+// NewGetTradeDownloadIDService init get trade download id service
+func (c *Client) NewGetTradeDownloadIDService() *GetTradeDownloadIDService {
+	return &GetTradeDownloadIDService{c: c}
+}
+
+// NewGetTradeDownloadLinkService init get trade download link service
+func (c *Client) NewGetTradeDownloadLinkService() *GetTradeDownloadLinkService {
+	return &GetTradeDownloadLinkService{c: c}
+}
+
 // NewGetAccountConfigService get account config service
 func (c *Client) NewGetAccountConfigService() *GetAccountConfigService {
 	return &GetAccountConfigService{c: c}
@@ -651,6 +704,36 @@ func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRati
 	return &TopLongShortPositionRatioService{c: c}
 }
 
+// NewTakerLongShortRatioService init taker long short ratio service
+func (c *Client) NewTakerLongShortRatioService() *TakerLongShortRatioService {
+	return &TakerLongShortRatioService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewDeliveryPriceService init delivery price service
+func (c *Client) NewDeliveryPriceService() *DeliveryPriceService {
+	return &DeliveryPriceService{c: c}
+}
+
+// NewIndexInfoService init index info service
+func (c *Client) NewIndexInfoService() *IndexInfoService {
+	return &IndexInfoService{c: c}
+}
+
+// NewConstituentsService init constituents service
+func (c *Client) NewConstituentsService() *ConstituentsService {
+	return &ConstituentsService{c: c}
+}
+
+// NewAssetIndexService init asset index service
+func (c *Client) NewAssetIndexService() *AssetIndexService {
+	return &AssetIndexService{c: c}
+}
+
 func (c *Client) NewTradingStatusService() *TradingStatusService {
 	return &TradingStatusService{c: c}
 }
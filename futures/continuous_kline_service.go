@@ -0,0 +1,100 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ContinuousKlinesService list klines of a pair's contract type across its contracts
+type ContinuousKlinesService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	interval     KlineInterval
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (ckls *ContinuousKlinesService) Pair(pair string) *ContinuousKlinesService {
+	ckls.pair = pair
+	return ckls
+}
+
+// ContractType set contractType
+func (ckls *ContinuousKlinesService) ContractType(contractType ContractType) *ContinuousKlinesService {
+	ckls.contractType = contractType
+	return ckls
+}
+
+// Interval set interval
+func (ckls *ContinuousKlinesService) Interval(interval KlineInterval) *ContinuousKlinesService {
+	ckls.interval = interval
+	return ckls
+}
+
+// Limit set limit
+func (ckls *ContinuousKlinesService) Limit(limit int) *ContinuousKlinesService {
+	ckls.limit = &limit
+	return ckls
+}
+
+// StartTime set startTime
+func (ckls *ContinuousKlinesService) StartTime(startTime int64) *ContinuousKlinesService {
+	ckls.startTime = &startTime
+	return ckls
+}
+
+// EndTime set endTime
+func (ckls *ContinuousKlinesService) EndTime(endTime int64) *ContinuousKlinesService {
+	ckls.endTime = &endTime
+	return ckls
+}
+
+// Do send request
+func (ckls *ContinuousKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/continuousKlines",
+	}
+	r.SetParam("pair", ckls.pair)
+	r.SetParam("contractType", ckls.contractType)
+	r.SetParam("interval", ckls.interval)
+	if ckls.limit != nil {
+		r.SetParam("limit", *ckls.limit)
+	}
+	if ckls.startTime != nil {
+		r.SetParam("startTime", *ckls.startTime)
+	}
+	if ckls.endTime != nil {
+		r.SetParam("endTime", *ckls.endTime)
+	}
+	data, _, err := ckls.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+// DoAll send requests from StartTime to EndTime, or now when not set, 1500
+// klines at a time. Klines are not kept for a limited time like the
+// statistics, so StartTime is required instead of defaulting to a window.
+func (ckls *ContinuousKlinesService) DoAll(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	if ckls.startTime == nil {
+		return []*Kline{}, errors.New("startTime is required")
+	}
+	end := time.Now().UnixMilli()
+	if ckls.endTime != nil {
+		end = *ckls.endTime
+	}
+	return klinePages(*ckls.startTime, end, func(from, to int64) ([]*Kline, error) {
+		page := *ckls
+		page.startTime, page.endTime = &from, &to
+		page.Limit(klineMaxLimit)
+		return page.Do(ctx, opts...)
+	})
+}
@@ -0,0 +1,50 @@
+package futures_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+func TestContinuousKlinesDoAll(t *testing.T) {
+	const minute = 60000
+	var requests int
+	client := futures.NewClient("", "")
+	client.HTTPClient = handlerClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if r.URL.Path != "/fapi/v1/continuousKlines" || q.Get("interval") != "1m" || q.Get("limit") != "1500" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		from, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+		to, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+		var rows []string
+		for open := (from + minute - 1) / minute * minute; open <= to && len(rows) < 1500; open += minute {
+			rows = append(rows, fmt.Sprintf(`[%d,"1","1","1","1","1",%d,"1",1,"1","1","0"]`, open, open+minute-1))
+		}
+		w.Write([]byte("[" + strings.Join(rows, ",") + "]"))
+	})
+
+	_, err := client.NewContinuousKlinesService().Pair("BTCUSDT").Interval(futures.KlineInterval1m).DoAll(context.Background())
+	if err == nil {
+		t.Fatal("expected an error without startTime")
+	}
+	klines, err := client.NewContinuousKlinesService().Pair("BTCUSDT").ContractType(futures.ContractTypePerpetual).
+		Interval(futures.KlineInterval1m).StartTime(0).EndTime(3200*minute - 1).DoAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 3 || len(klines) != 3200 {
+		t.Fatalf("unexpected %d klines in %d requests", len(klines), requests)
+	}
+	for i, k := range klines {
+		if k.OpenTime != int64(i)*minute {
+			t.Fatalf("unexpected kline %d %+v", i, k)
+		}
+	}
+}
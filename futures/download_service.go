@@ -0,0 +1,190 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetIncomeDownloadIDService request an export of the income history, the
+// range is at most 1 year
+type GetIncomeDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetIncomeDownloadIDService) StartTime(startTime int64) *GetIncomeDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetIncomeDownloadIDService) EndTime(endTime int64) *GetIncomeDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetIncomeDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return getDownloadID(ctx, s.c, "/fapi/v1/income/asyn", s.startTime, s.endTime, opts...)
+}
+
+// GetIncomeDownloadLinkService get the link of an income history export
+type GetIncomeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadID
+func (s *GetIncomeDownloadLinkService) DownloadID(downloadID string) *GetIncomeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetIncomeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/income/asyn/id", s.downloadID, opts...)
+}
+
+// GetOrderDownloadIDService request an export of the order history, the
+// range is at most 1 year
+type GetOrderDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetOrderDownloadIDService) StartTime(startTime int64) *GetOrderDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetOrderDownloadIDService) EndTime(endTime int64) *GetOrderDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetOrderDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return getDownloadID(ctx, s.c, "/fapi/v1/order/asyn", s.startTime, s.endTime, opts...)
+}
+
+// GetOrderDownloadLinkService get the link of an order history export
+type GetOrderDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadID
+func (s *GetOrderDownloadLinkService) DownloadID(downloadID string) *GetOrderDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetOrderDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/order/asyn/id", s.downloadID, opts...)
+}
+
+// GetTradeDownloadIDService request an export of the trade history, the
+// range is at most 1 year
+type GetTradeDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetTradeDownloadIDService) StartTime(startTime int64) *GetTradeDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetTradeDownloadIDService) EndTime(endTime int64) *GetTradeDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetTradeDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	return getDownloadID(ctx, s.c, "/fapi/v1/trade/asyn", s.startTime, s.endTime, opts...)
+}
+
+// GetTradeDownloadLinkService get the link of a trade history export
+type GetTradeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadID
+func (s *GetTradeDownloadLinkService) DownloadID(downloadID string) *GetTradeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetTradeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	return getDownloadLink(ctx, s.c, "/fapi/v1/trade/asyn/id", s.downloadID, opts...)
+}
+
+// DownloadID define the id of a requested export
+type DownloadID struct {
+	AvgCostTimestampOfLast30d int64  `json:"avgCostTimestampOfLast30d"` // average time to prepare an export, in milliseconds
+	DownloadID                string `json:"downloadId"`
+}
+
+// DownloadLink define the status and link of an export, URL is set once
+// Status is completed
+type DownloadLink struct {
+	DownloadID          string         `json:"downloadId"`
+	Status              DownloadStatus `json:"status"`
+	URL                 string         `json:"url"`
+	Notified            bool           `json:"notified"`
+	ExpirationTimestamp int64          `json:"expirationTimestamp"`
+	IsExpired           *bool          `json:"isExpired"`
+}
+
+func getDownloadID(ctx context.Context, c *Client, endpoint string, startTime, endTime int64, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	r.SetParams(params{
+		"startTime": startTime,
+		"endTime":   endTime,
+	})
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadID)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func getDownloadLink(ctx context.Context, c *Client, endpoint string, downloadID string, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("downloadId", downloadID)
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadLink)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
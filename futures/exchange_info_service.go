@@ -14,9 +14,9 @@ type ExchangeInfoService struct {
 // Do send request
 func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/exchangeInfo",
-		secType:  secTypeNone,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/exchangeInfo",
+		SecType:  secTypeNone,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
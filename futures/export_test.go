@@ -0,0 +1,4 @@
+package futures
+
+// StatisticsWindows export statisticsWindows to the tests
+var StatisticsWindows = statisticsWindows
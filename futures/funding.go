@@ -0,0 +1,219 @@
+package futures
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uncle-gua/wsc"
+)
+
+const defaultFundingIntervalHours = 8
+
+// FundingPayment return the funding paid by a position at a funding rate,
+// negative when received. Longs pay shorts when the rate is positive.
+func FundingPayment(positionAmt, markPrice, fundingRate float64) float64 {
+	return positionAmt * markPrice * fundingRate
+}
+
+// AnnualizedFundingRate return the yearly rate of a funding rate paid every
+// intervalHours, without compounding
+func AnnualizedFundingRate(fundingRate float64, intervalHours int) float64 {
+	if intervalHours <= 0 {
+		intervalHours = defaultFundingIntervalHours
+	}
+	return fundingRate * 24 / float64(intervalHours) * 365
+}
+
+// EstimateFundingCostService estimate the next funding payments of the open
+// positions from the premium index
+type EstimateFundingCostService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *EstimateFundingCostService) Symbol(symbol string) *EstimateFundingCostService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send requests
+func (s *EstimateFundingCostService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingCost, err error) {
+	positions := s.c.NewGetPositionRiskService()
+	premiums := s.c.NewPremiumIndexService()
+	if s.symbol != nil {
+		positions.Symbol(*s.symbol)
+		premiums.Symbol(*s.symbol)
+	}
+	risks, err := positions.Do(ctx, opts...)
+	if err != nil {
+		return []*FundingCost{}, err
+	}
+	indexes, err := premiums.Do(ctx, opts...)
+	if err != nil {
+		return []*FundingCost{}, err
+	}
+	infos, err := s.c.NewGetFundingInfoService().Do(ctx, opts...)
+	if err != nil {
+		return []*FundingCost{}, err
+	}
+
+	premiumBySymbol := make(map[string]*PremiumIndex, len(indexes))
+	for _, p := range indexes {
+		premiumBySymbol[p.Symbol] = p
+	}
+	intervals := make(map[string]int, len(infos))
+	for _, info := range infos {
+		intervals[info.Symbol] = info.FundingIntervalHours
+	}
+	res = make([]*FundingCost, 0)
+	for _, r := range risks {
+		p, ok := premiumBySymbol[r.Symbol]
+		if r.PositionAmt == 0 || !ok {
+			continue
+		}
+		interval := intervals[r.Symbol]
+		if interval <= 0 {
+			interval = defaultFundingIntervalHours
+		}
+		payment := FundingPayment(r.PositionAmt, p.MarkPrice, p.LastFundingRate)
+		res = append(res, &FundingCost{
+			Symbol:               r.Symbol,
+			PositionSide:         r.PositionSide,
+			PositionAmt:          r.PositionAmt,
+			MarkPrice:            p.MarkPrice,
+			FundingRate:          p.LastFundingRate,
+			FundingIntervalHours: interval,
+			NextFundingTime:      p.NextFundingTime,
+			NextPayment:          payment,
+			DailyPayment:         payment * 24 / float64(interval),
+		})
+	}
+	return res, nil
+}
+
+// FundingCost define the estimated funding of a position, the payments are
+// negative when received
+type FundingCost struct {
+	Symbol               string
+	PositionSide         PositionSideType
+	PositionAmt          float64
+	MarkPrice            float64
+	FundingRate          float64
+	FundingIntervalHours int
+	NextFundingTime      int64
+	NextPayment          float64
+	DailyPayment         float64 // at the current rate and mark price
+}
+
+// FundingSnapshot define the latest funding of a symbol
+type FundingSnapshot struct {
+	Symbol               string
+	MarkPrice            float64
+	IndexPrice           float64
+	FundingRate          float64
+	FundingIntervalHours int
+	NextFundingTime      int64
+	AnnualizedRate       float64 // FundingRate over a year, received by shorts when positive
+	FundingRateCap       float64 // 0 when the symbol uses the default caps
+	FundingRateFloor     float64
+	Time                 int64
+}
+
+// FundingAggregator keep the funding rates of all symbols from the mark
+// price stream and rank them, e.g. to find carry trades. Call Load for the
+// funding intervals, then feed HandleAllMarkPrice with the events of
+// WsAllMarkPriceServeWithRate, or call Serve.
+type FundingAggregator struct {
+	c *Client
+
+	mu        sync.Mutex
+	intervals map[string]*FundingInfo
+	rates     map[string]*FundingSnapshot
+}
+
+// Load load the funding intervals and caps of the symbols
+func (a *FundingAggregator) Load(ctx context.Context, opts ...RequestOption) error {
+	infos, err := a.c.NewGetFundingInfoService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.intervals = make(map[string]*FundingInfo, len(infos))
+	for _, info := range infos {
+		a.intervals[info.Symbol] = info
+	}
+	for _, snapshot := range a.rates {
+		a.annotate(snapshot)
+	}
+	return nil
+}
+
+// annotate set the funding interval, caps and annualized rate of a snapshot
+func (a *FundingAggregator) annotate(snapshot *FundingSnapshot) {
+	snapshot.FundingIntervalHours = defaultFundingIntervalHours
+	snapshot.FundingRateCap, snapshot.FundingRateFloor = 0, 0
+	if info, ok := a.intervals[snapshot.Symbol]; ok {
+		if info.FundingIntervalHours > 0 {
+			snapshot.FundingIntervalHours = info.FundingIntervalHours
+		}
+		snapshot.FundingRateCap = info.AdjustedFundingRateCap
+		snapshot.FundingRateFloor = info.AdjustedFundingRateFloor
+	}
+	snapshot.AnnualizedRate = AnnualizedFundingRate(snapshot.FundingRate, snapshot.FundingIntervalHours)
+}
+
+// HandleAllMarkPrice update the funding rates with the events of WsAllMarkPriceServe
+func (a *FundingAggregator) HandleAllMarkPrice(event WsAllMarkPriceEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, e := range event {
+		snapshot := &FundingSnapshot{
+			Symbol:          e.Symbol,
+			MarkPrice:       e.MarkPrice,
+			IndexPrice:      e.IndexPrice,
+			FundingRate:     e.FundingRate,
+			NextFundingTime: e.NextFundingTime,
+			Time:            e.Time,
+		}
+		a.annotate(snapshot)
+		a.rates[e.Symbol] = snapshot
+	}
+}
+
+// Serve start WsAllMarkPriceServeWithRate feeding the aggregator, rate is 1s or 3s
+func (a *FundingAggregator) Serve(rate time.Duration, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	return WsAllMarkPriceServeWithRate(rate, a.HandleAllMarkPrice, errHandler)
+}
+
+// Snapshot return the latest funding of a symbol
+func (a *FundingAggregator) Snapshot(symbol string) (FundingSnapshot, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	snapshot, ok := a.rates[symbol]
+	if !ok {
+		return FundingSnapshot{}, false
+	}
+	return *snapshot, true
+}
+
+// Ranking return the latest funding of the symbols by annualized rate, from
+// the highest (best to be short) to the lowest (best to be long)
+func (a *FundingAggregator) Ranking() []FundingSnapshot {
+	a.mu.Lock()
+	res := make([]FundingSnapshot, 0, len(a.rates))
+	for _, snapshot := range a.rates {
+		res = append(res, *snapshot)
+	}
+	a.mu.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].AnnualizedRate != res[j].AnnualizedRate {
+			return res[i].AnnualizedRate > res[j].AnnualizedRate
+		}
+		return res[i].Symbol < res[j].Symbol
+	})
+	return res
+}
@@ -0,0 +1,111 @@
+package futures_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+func TestAnnualizedFundingRate(t *testing.T) {
+	tests := []struct {
+		rate     float64
+		interval int
+		want     float64
+	}{
+		{0.0001, 8, 0.1095},
+		{0.0001, 4, 0.219},
+		{0.0001, 0, 0.1095},
+		{-0.0002, 4, -0.438},
+	}
+	for _, test := range tests {
+		if got := futures.AnnualizedFundingRate(test.rate, test.interval); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("AnnualizedFundingRate(%v, %d) = %v, want %v", test.rate, test.interval, got, test.want)
+		}
+	}
+}
+
+func TestFundingAggregator(t *testing.T) {
+	client := futures.NewClient("", "")
+	client.HTTPClient = handlerClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fapi/v1/fundingInfo" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"symbol":"ETHUSDT","adjustedFundingRateCap":"0.02","adjustedFundingRateFloor":"-0.02","fundingIntervalHours":4}]`))
+	})
+
+	a := client.NewFundingAggregator()
+	a.HandleAllMarkPrice(futures.WsAllMarkPriceEvent{
+		{Symbol: "BTCUSDT", MarkPrice: 100000, FundingRate: 0.0001, Time: 1},
+		{Symbol: "ETHUSDT", MarkPrice: 2500, FundingRate: 0.00008, Time: 1},
+		{Symbol: "SOLUSDT", MarkPrice: 150, FundingRate: -0.0003, Time: 1},
+		{Symbol: "XRPUSDT", MarkPrice: 2, FundingRate: 0.0001, Time: 1},
+	})
+	symbols := func() []string {
+		var res []string
+		for _, s := range a.Ranking() {
+			res = append(res, s.Symbol)
+		}
+		return res
+	}
+	// 8h intervals until the funding info is loaded, ties by symbol
+	if got := symbols(); !equalStrings(got, []string{"BTCUSDT", "XRPUSDT", "ETHUSDT", "SOLUSDT"}) {
+		t.Fatalf("unexpected ranking %v", got)
+	}
+
+	// Load annotates the existing snapshots with the 4h interval of ETHUSDT
+	if err := a.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := symbols(); !equalStrings(got, []string{"ETHUSDT", "BTCUSDT", "XRPUSDT", "SOLUSDT"}) {
+		t.Fatalf("unexpected ranking %v", got)
+	}
+	eth, ok := a.Snapshot("ETHUSDT")
+	if !ok || eth.FundingIntervalHours != 4 || math.Abs(eth.AnnualizedRate-0.1752) > 1e-9 ||
+		eth.FundingRateCap != 0.02 || eth.FundingRateFloor != -0.02 {
+		t.Fatalf("unexpected snapshot %+v", eth)
+	}
+	btc, _ := a.Snapshot("BTCUSDT")
+	if btc.FundingIntervalHours != 8 || math.Abs(btc.AnnualizedRate-0.1095) > 1e-9 || btc.FundingRateCap != 0 {
+		t.Fatalf("unexpected snapshot %+v", btc)
+	}
+
+	// new events keep the loaded intervals
+	a.HandleAllMarkPrice(futures.WsAllMarkPriceEvent{{Symbol: "ETHUSDT", MarkPrice: 2500, FundingRate: -0.0001, Time: 2}})
+	if eth, _ = a.Snapshot("ETHUSDT"); eth.FundingIntervalHours != 4 || math.Abs(eth.AnnualizedRate+0.219) > 1e-9 {
+		t.Fatalf("unexpected snapshot %+v", eth)
+	}
+	if got := symbols(); !equalStrings(got, []string{"BTCUSDT", "XRPUSDT", "ETHUSDT", "SOLUSDT"}) {
+		t.Fatalf("unexpected ranking %v", got)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// handlerClient return an HTTP client served by handler instead of the network
+func handlerClient(handler http.HandlerFunc) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w.Result(), nil
+	})}
+}
@@ -0,0 +1,402 @@
+package futures
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	exportMaxRange            = 365 * 24 * time.Hour
+	exportDefaultPollInterval = 10 * time.Second
+)
+
+// exportKind define the endpoints of a history export
+type exportKind struct {
+	name string
+	id   func(c *Client, ctx context.Context, startTime, endTime int64, opts ...RequestOption) (*DownloadID, error)
+	link func(c *Client, ctx context.Context, downloadID string, opts ...RequestOption) (*DownloadLink, error)
+}
+
+var (
+	incomeExport = exportKind{
+		name: "income",
+		id: func(c *Client, ctx context.Context, startTime, endTime int64, opts ...RequestOption) (*DownloadID, error) {
+			return c.NewGetIncomeDownloadIDService().StartTime(startTime).EndTime(endTime).Do(ctx, opts...)
+		},
+		link: func(c *Client, ctx context.Context, downloadID string, opts ...RequestOption) (*DownloadLink, error) {
+			return c.NewGetIncomeDownloadLinkService().DownloadID(downloadID).Do(ctx, opts...)
+		},
+	}
+	orderExport = exportKind{
+		name: "order",
+		id: func(c *Client, ctx context.Context, startTime, endTime int64, opts ...RequestOption) (*DownloadID, error) {
+			return c.NewGetOrderDownloadIDService().StartTime(startTime).EndTime(endTime).Do(ctx, opts...)
+		},
+		link: func(c *Client, ctx context.Context, downloadID string, opts ...RequestOption) (*DownloadLink, error) {
+			return c.NewGetOrderDownloadLinkService().DownloadID(downloadID).Do(ctx, opts...)
+		},
+	}
+	tradeExport = exportKind{
+		name: "trade",
+		id: func(c *Client, ctx context.Context, startTime, endTime int64, opts ...RequestOption) (*DownloadID, error) {
+			return c.NewGetTradeDownloadIDService().StartTime(startTime).EndTime(endTime).Do(ctx, opts...)
+		},
+		link: func(c *Client, ctx context.Context, downloadID string, opts ...RequestOption) (*DownloadLink, error) {
+			return c.NewGetTradeDownloadLinkService().DownloadID(downloadID).Do(ctx, opts...)
+		},
+	}
+)
+
+// HistoryExporter fetch the income, order and trade history over long ranges
+// with the asynchronous exports. A range is split in exports of at most 1
+// year, each one is requested, polled until ready, downloaded and parsed.
+// Binance limits the number of exports per month, a range longer than a year
+// uses several of them.
+type HistoryExporter struct {
+	c *Client
+	// PollInterval is the wait between two polls of an export, default 10s
+	PollInterval time.Duration
+	// OnProgress is called when an export is requested and when it is ready, optional
+	OnProgress func(link *DownloadLink)
+}
+
+// Income return the income history between startTime and endTime, in milliseconds
+func (e *HistoryExporter) Income(ctx context.Context, startTime, endTime int64, opts ...RequestOption) (res []*IncomeHistory, err error) {
+	res = make([]*IncomeHistory, 0)
+	err = e.export(ctx, incomeExport, startTime, endTime, func(r io.Reader) error {
+		rows, err := ParseIncomeCSV(r)
+		res = append(res, rows...)
+		return err
+	}, opts...)
+	return res, err
+}
+
+// Orders return the order history between startTime and endTime, in milliseconds
+func (e *HistoryExporter) Orders(ctx context.Context, startTime, endTime int64, opts ...RequestOption) (res []*Order, err error) {
+	res = make([]*Order, 0)
+	err = e.export(ctx, orderExport, startTime, endTime, func(r io.Reader) error {
+		rows, err := ParseOrderCSV(r)
+		res = append(res, rows...)
+		return err
+	}, opts...)
+	return res, err
+}
+
+// Trades return the trade history between startTime and endTime, in milliseconds
+func (e *HistoryExporter) Trades(ctx context.Context, startTime, endTime int64, opts ...RequestOption) (res []*AccountTrade, err error) {
+	res = make([]*AccountTrade, 0)
+	err = e.export(ctx, tradeExport, startTime, endTime, func(r io.Reader) error {
+		rows, err := ParseTradeCSV(r)
+		res = append(res, rows...)
+		return err
+	}, opts...)
+	return res, err
+}
+
+// export run the exports of a range one after the other and parse each file
+func (e *HistoryExporter) export(ctx context.Context, kind exportKind, startTime, endTime int64, parse func(r io.Reader) error, opts ...RequestOption) error {
+	if endTime < startTime {
+		return errors.New("end time before start time")
+	}
+	step := exportMaxRange.Milliseconds()
+	for from := startTime; from <= endTime; from += step {
+		to := from + step - 1
+		if to > endTime {
+			to = endTime
+		}
+		data, err := e.download(ctx, kind, from, to, opts...)
+		if err != nil {
+			return err
+		}
+		if err = parse(bytes.NewReader(data)); err != nil {
+			return fmt.Errorf("%s export %d-%d: %w", kind.name, from, to, err)
+		}
+	}
+	return nil
+}
+
+// download request an export, wait for it and return the CSV file
+func (e *HistoryExporter) download(ctx context.Context, kind exportKind, startTime, endTime int64, opts ...RequestOption) ([]byte, error) {
+	id, err := kind.id(e.c, ctx, startTime, endTime, opts...)
+	if err != nil {
+		return nil, err
+	}
+	interval := e.PollInterval
+	if interval <= 0 {
+		interval = exportDefaultPollInterval
+	}
+	var link *DownloadLink
+	for {
+		link, err = kind.link(e.c, ctx, id.DownloadID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if e.OnProgress != nil {
+			e.OnProgress(link)
+		}
+		if link.Status == DownloadStatusCompleted && link.URL != "" {
+			break
+		}
+		if link.IsExpired != nil && *link.IsExpired {
+			return nil, fmt.Errorf("%s export %s expired", kind.name, id.DownloadID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := e.c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("%s export %s: download status %d", kind.name, id.DownloadID, res.StatusCode)
+	}
+	return unzipCSV(data)
+}
+
+// unzipCSV return the first CSV file of a zip archive, or data when it is not one
+func unzipCSV(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return data, nil
+	}
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range r.File {
+		if !strings.HasSuffix(strings.ToLower(f.Name), ".csv") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	return nil, errors.New("no csv file in the export")
+}
+
+// csvTable read the rows of a CSV file by header, the headers are matched
+// case and punctuation insensitively so "Date(UTC)" matches "dateutc"
+type csvTable struct {
+	columns map[string]int
+	rows    [][]string
+}
+
+func readCSVTable(r io.Reader) (*csvTable, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	t := &csvTable{columns: make(map[string]int)}
+	if len(records) == 0 {
+		return t, nil
+	}
+	for i, header := range records[0] {
+		t.columns[csvKey(header)] = i
+	}
+	t.rows = records[1:]
+	return t, nil
+}
+
+func csvKey(header string) string {
+	header = strings.TrimPrefix(header, "\ufeff")
+	var b strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// get return the cell of the first header found
+func (t *csvTable) get(row []string, headers ...string) string {
+	for _, h := range headers {
+		if i, ok := t.columns[h]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+	}
+	return ""
+}
+
+// amount return the number of a cell such as "1,234.5" or "0.12 USDT" and its unit
+func (t *csvTable) amount(row []string, headers ...string) (value string, unit string) {
+	fields := strings.Fields(t.get(row, headers...))
+	if len(fields) == 0 {
+		return "", ""
+	}
+	if len(fields) > 1 {
+		unit = fields[1]
+	}
+	return strings.ReplaceAll(fields[0], ",", ""), unit
+}
+
+// number return the amount of a cell as a float and its unit
+func (t *csvTable) number(row []string, headers ...string) (value float64, unit string) {
+	amount, unit := t.amount(row, headers...)
+	value, _ = strconv.ParseFloat(amount, 64)
+	return value, unit
+}
+
+func (t *csvTable) float(row []string, headers ...string) float64 {
+	value, _ := t.number(row, headers...)
+	return value
+}
+
+func (t *csvTable) int(row []string, headers ...string) int64 {
+	value, _ := strconv.ParseInt(t.get(row, headers...), 10, 64)
+	return value
+}
+
+func (t *csvTable) bool(row []string, headers ...string) bool {
+	switch strings.ToLower(t.get(row, headers...)) {
+	case "true", "yes", "1":
+		return true
+	}
+	return false
+}
+
+var csvTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.000",
+	"06-01-02 15:04:05",
+	time.RFC3339,
+}
+
+// time return the time of a cell in milliseconds, dates are in UTC
+func (t *csvTable) time(row []string, headers ...string) int64 {
+	s := t.get(row, headers...)
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ms
+	}
+	for _, layout := range csvTimeLayouts {
+		if v, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return v.UnixMilli()
+		}
+	}
+	return 0
+}
+
+// ParseIncomeCSV parse an income history export
+func ParseIncomeCSV(r io.Reader) (res []*IncomeHistory, err error) {
+	t, err := readCSVTable(r)
+	if err != nil {
+		return []*IncomeHistory{}, err
+	}
+	res = make([]*IncomeHistory, 0, len(t.rows))
+	for _, row := range t.rows {
+		income, unit := t.amount(row, "amount", "income", "change")
+		asset := t.get(row, "asset", "coin")
+		if asset == "" {
+			asset = unit
+		}
+		res = append(res, &IncomeHistory{
+			Asset:      asset,
+			Income:     income,
+			IncomeType: t.get(row, "incometype", "type"),
+			Info:       t.get(row, "info", "remark"),
+			Symbol:     t.get(row, "symbol"),
+			Time:       t.time(row, "time", "dateutc", "date"),
+			TranID:     t.int(row, "tranid", "transactionid", "id"),
+			TradeID:    t.get(row, "tradeid"),
+		})
+	}
+	return res, nil
+}
+
+// ParseOrderCSV parse an order history export
+func ParseOrderCSV(r io.Reader) (res []*Order, err error) {
+	t, err := readCSVTable(r)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0, len(t.rows))
+	for _, row := range t.rows {
+		res = append(res, &Order{
+			Symbol:           t.get(row, "symbol"),
+			OrderID:          t.int(row, "orderid", "orderno"),
+			ClientOrderID:    t.get(row, "clientorderid"),
+			Price:            t.float(row, "price", "orderprice"),
+			ReduceOnly:       t.bool(row, "reduceonly"),
+			OrigQuantity:     t.float(row, "origqty", "orderamount", "quantity"),
+			ExecutedQuantity: t.float(row, "executedqty", "filled", "executed"),
+			CumQuote:         t.float(row, "cumquote", "total"),
+			Status:           OrderStatusType(strings.ToUpper(t.get(row, "status"))),
+			TimeInForce:      TimeInForceType(t.get(row, "timeinforce")),
+			Type:             OrderType(strings.ToUpper(t.get(row, "type", "ordertype"))),
+			Side:             SideType(strings.ToUpper(t.get(row, "side"))),
+			StopPrice:        t.float(row, "stopprice", "triggerprice"),
+			Time:             t.time(row, "time", "dateutc", "date"),
+			UpdateTime:       t.time(row, "updatetime"),
+			AvgPrice:         t.float(row, "avgprice", "avgtradingprice", "averageprice"),
+			PositionSide:     PositionSideType(strings.ToUpper(t.get(row, "positionside"))),
+			ClosePosition:    t.bool(row, "closeposition"),
+		})
+	}
+	return res, nil
+}
+
+// ParseTradeCSV parse a trade history export
+func ParseTradeCSV(r io.Reader) (res []*AccountTrade, err error) {
+	t, err := readCSVTable(r)
+	if err != nil {
+		return []*AccountTrade{}, err
+	}
+	res = make([]*AccountTrade, 0, len(t.rows))
+	for _, row := range t.rows {
+		commission, unit := t.number(row, "commission", "fee")
+		asset := t.get(row, "commissionasset", "feeasset", "feecoin")
+		if asset == "" {
+			asset = unit
+		}
+		side := SideType(strings.ToUpper(t.get(row, "side")))
+		maker := t.bool(row, "maker") || strings.EqualFold(t.get(row, "role", "liquidity"), "maker")
+		res = append(res, &AccountTrade{
+			Buyer:           side == SideTypeBuy,
+			Commission:      commission,
+			CommissionAsset: asset,
+			ID:              t.int(row, "tradeid", "id"),
+			Maker:           maker,
+			OrderID:         t.int(row, "orderid", "orderno"),
+			Price:           t.float(row, "price"),
+			Quantity:        t.float(row, "qty", "quantity", "executed"),
+			QuoteQuantity:   t.float(row, "quoteqty", "amount", "total"),
+			RealizedPnl:     t.float(row, "realizedpnl", "realizedprofit"),
+			Side:            side,
+			PositionSide:    PositionSideType(strings.ToUpper(t.get(row, "positionside"))),
+			Symbol:          t.get(row, "symbol"),
+			Time:            t.time(row, "time", "dateutc", "date"),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package futures_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+func TestParseTradeCSV(t *testing.T) {
+	data := "\ufeffDate(UTC),Symbol,Side,Price,Quantity,Amount,Fee,Realized Profit,Order No,Role\n" +
+		"2024-01-02 03:04:05,BTCUSDT,SELL,\"42,000.5\",0.01,420.005,0.168 USDT,1.5,123,Maker\n"
+	trades, err := futures.ParseTradeCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 {
+		t.Fatalf("unexpected trades %d", len(trades))
+	}
+	trade := trades[0]
+	if trade.Time != 1704164645000 || trade.Symbol != "BTCUSDT" || trade.Side != futures.SideTypeSell || trade.Buyer {
+		t.Fatalf("unexpected trade %+v", trade)
+	}
+	if trade.Price != 42000.5 || trade.Quantity != 0.01 || trade.QuoteQuantity != 420.005 {
+		t.Fatalf("unexpected trade amounts %+v", trade)
+	}
+	if trade.Commission != 0.168 || trade.CommissionAsset != "USDT" || trade.RealizedPnl != 1.5 || trade.OrderID != 123 || !trade.Maker {
+		t.Fatalf("unexpected trade fee %+v", trade)
+	}
+}
+
+func TestParseIncomeCSV(t *testing.T) {
+	data := "Date(UTC),Symbol,Type,Amount,Asset\n" +
+		"2024-01-02 00:00:00,ETHUSDT,FUNDING_FEE,-0.0123,USDT\n" +
+		"2024-01-02 08:00:00,,TRANSFER,100,USDT\n"
+	incomes, err := futures.ParseIncomeCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(incomes) != 2 {
+		t.Fatalf("unexpected incomes %d", len(incomes))
+	}
+	if in := incomes[0]; in.Income != "-0.0123" || in.IncomeType != "FUNDING_FEE" || in.Asset != "USDT" || in.Time != 1704153600000 {
+		t.Fatalf("unexpected income %+v", in)
+	}
+}
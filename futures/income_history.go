@@ -48,22 +48,22 @@ func (s *GetIncomeHistoryService) Limit(limit int64) *GetIncomeHistoryService {
 // Do send request
 func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*IncomeHistory, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/income",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/income",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.incomeType != "" {
-		r.setParam("incomeType", s.incomeType)
+		r.SetParam("incomeType", s.incomeType)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -48,19 +48,19 @@ func (ipks *IndexPriceKlinesService) EndTime(endTime int64) *IndexPriceKlinesSer
 // Do send request
 func (ipks *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/indexPriceKlines",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/indexPriceKlines",
 	}
-	r.setParam("pair", ipks.pair)
-	r.setParam("interval", ipks.interval)
+	r.SetParam("pair", ipks.pair)
+	r.SetParam("interval", ipks.interval)
 	if ipks.limit != nil {
-		r.setParam("limit", *ipks.limit)
+		r.SetParam("limit", *ipks.limit)
 	}
 	if ipks.startTime != nil {
-		r.setParam("startTime", *ipks.startTime)
+		r.SetParam("startTime", *ipks.startTime)
 	}
 	if ipks.endTime != nil {
-		r.setParam("endTime", *ipks.endTime)
+		r.SetParam("endTime", *ipks.endTime)
 	}
 	data, _, err := ipks.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -51,19 +51,19 @@ func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 // Do send request
 func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/klines",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/klines",
 	}
-	r.setParam("symbol", s.symbol)
-	r.setParam("interval", s.interval)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("interval", s.interval)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -73,6 +73,26 @@ func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*K
 	return res, err
 }
 
+const klineMaxLimit = 1500
+
+// klinePages page klines from start to end by open time, do returns at most
+// klineMaxLimit klines of [from, to]
+func klinePages(start, end int64, do func(from, to int64) ([]*Kline, error)) ([]*Kline, error) {
+	res := make([]*Kline, 0)
+	for from := start; from <= end; {
+		rows, err := do(from, end)
+		if err != nil {
+			return []*Kline{}, err
+		}
+		res = append(res, rows...)
+		if len(rows) < klineMaxLimit {
+			break
+		}
+		from = rows[len(rows)-1].OpenTime + 1
+	}
+	return res, nil
+}
+
 // Kline define kline info
 type Kline struct {
 	OpenTime                 int64   `json:"openTime"`
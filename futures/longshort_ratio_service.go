@@ -56,21 +56,21 @@ func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
 // Do send request
 func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/futures/data/globalLongShortAccountRatio",
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/globalLongShortAccountRatio",
 	}
 
-	r.setParam("symbol", s.symbol)
-	r.setParam("period", s.period)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
 
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -130,21 +130,21 @@ func (s *TopLongShortAccountRatioService) EndTime(endTime int64) *TopLongShortAc
 // Do send request
 func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/futures/data/topLongShortAccountRatio",
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/topLongShortAccountRatio",
 	}
 
-	r.setParam("symbol", s.symbol)
-	r.setParam("period", s.period)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
 
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -204,21 +204,21 @@ func (s *TopLongShortPositionRatioService) EndTime(endTime int64) *TopLongShortP
 // Do send request
 func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/futures/data/topLongShortPositionRatio",
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/topLongShortPositionRatio",
 	}
 
-	r.setParam("symbol", s.symbol)
-	r.setParam("period", s.period)
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
 
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -48,19 +48,19 @@ func (mpks *MarkPriceKlinesService) EndTime(endTime int64) *MarkPriceKlinesServi
 // Do send request
 func (mpks *MarkPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/markPriceKlines",
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/markPriceKlines",
 	}
-	r.setParam("symbol", mpks.symbol)
-	r.setParam("interval", mpks.interval)
+	r.SetParam("symbol", mpks.symbol)
+	r.SetParam("interval", mpks.interval)
 	if mpks.limit != nil {
-		r.setParam("limit", *mpks.limit)
+		r.SetParam("limit", *mpks.limit)
 	}
 	if mpks.startTime != nil {
-		r.setParam("startTime", *mpks.startTime)
+		r.SetParam("startTime", *mpks.startTime)
 	}
 	if mpks.endTime != nil {
-		r.setParam("endTime", *mpks.endTime)
+		r.SetParam("endTime", *mpks.endTime)
 	}
 	data, _, err := mpks.c.callAPI(ctx, r, opts...)
 	if err != nil {
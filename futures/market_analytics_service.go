@@ -0,0 +1,445 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+const (
+	statisticsMaxLimit  = 500
+	statisticsRetention = 30 * 24 * time.Hour
+)
+
+var statisticsPeriods = map[StatisticsPeriod]time.Duration{
+	StatisticsPeriod5m:  5 * time.Minute,
+	StatisticsPeriod15m: 15 * time.Minute,
+	StatisticsPeriod30m: 30 * time.Minute,
+	StatisticsPeriod1h:  time.Hour,
+	StatisticsPeriod2h:  2 * time.Hour,
+	StatisticsPeriod4h:  4 * time.Hour,
+	StatisticsPeriod6h:  6 * time.Hour,
+	StatisticsPeriod12h: 12 * time.Hour,
+	StatisticsPeriod1d:  24 * time.Hour,
+}
+
+// Duration return the duration of a period, 0 when unknown
+func (p StatisticsPeriod) Duration() time.Duration {
+	return statisticsPeriods[p]
+}
+
+// statisticsWindows split [startTime, endTime] in windows of at most
+// statisticsMaxLimit periods, in milliseconds. The range defaults to the
+// last 30 days, the only ones kept by the exchange, and is clamped to them.
+func statisticsWindows(period StatisticsPeriod, startTime, endTime *int64, now time.Time) ([][2]int64, error) {
+	d := period.Duration()
+	if d == 0 {
+		return nil, errors.New("invalid period")
+	}
+	end := now.UnixMilli()
+	if endTime != nil && *endTime < end {
+		end = *endTime
+	}
+	oldest := now.Add(-statisticsRetention).UnixMilli()
+	start := oldest
+	if startTime != nil && *startTime > start {
+		start = *startTime
+	}
+	var res [][2]int64
+	step := statisticsMaxLimit * d.Milliseconds()
+	for from := start; from <= end; from += step {
+		to := from + step - 1
+		if to > end {
+			to = end
+		}
+		res = append(res, [2]int64{from, to})
+	}
+	return res, nil
+}
+
+// TakerLongShortRatioService list the taker buy and sell volumes of a symbol
+type TakerLongShortRatioService struct {
+	c         *Client
+	symbol    string
+	period    StatisticsPeriod
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *TakerLongShortRatioService) Symbol(symbol string) *TakerLongShortRatioService {
+	s.symbol = symbol
+	return s
+}
+
+// Period set period
+func (s *TakerLongShortRatioService) Period(period StatisticsPeriod) *TakerLongShortRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, default 30, max 500
+func (s *TakerLongShortRatioService) Limit(limit int) *TakerLongShortRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerLongShortRatioService) StartTime(startTime int64) *TakerLongShortRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerLongShortRatioService) EndTime(endTime int64) *TakerLongShortRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerLongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerLongShortRatio, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/takerlongshortRatio",
+	}
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("period", s.period)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerLongShortRatio{}, err
+	}
+	res = make([]*TakerLongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerLongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// DoAll send requests over the last 30 days, or the part of them between
+// StartTime and EndTime, 500 periods at a time. The exchange keeps only the
+// last 30 days: a StartTime older than that is silently moved to 30 days ago.
+func (s *TakerLongShortRatioService) DoAll(ctx context.Context, opts ...RequestOption) (res []*TakerLongShortRatio, err error) {
+	windows, err := statisticsWindows(s.period, s.startTime, s.endTime, time.Now())
+	if err != nil {
+		return []*TakerLongShortRatio{}, err
+	}
+	res = make([]*TakerLongShortRatio, 0)
+	for _, w := range windows {
+		page := *s
+		page.startTime, page.endTime = &w[0], &w[1]
+		page.Limit(statisticsMaxLimit)
+		rows, err := page.Do(ctx, opts...)
+		if err != nil {
+			return []*TakerLongShortRatio{}, err
+		}
+		res = append(res, rows...)
+	}
+	return res, nil
+}
+
+// TakerLongShortRatio define the taker volumes of a period
+type TakerLongShortRatio struct {
+	BuySellRatio float64 `json:"buySellRatio,string"`
+	BuyVol       float64 `json:"buyVol,string"`
+	SellVol      float64 `json:"sellVol,string"`
+	Timestamp    int64   `json:"timestamp"`
+}
+
+// BasisService list the basis between a contract and the index of a pair
+type BasisService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       StatisticsPeriod
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *BasisService) Pair(pair string) *BasisService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType
+func (s *BasisService) ContractType(contractType ContractType) *BasisService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period
+func (s *BasisService) Period(period StatisticsPeriod) *BasisService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, default 30, max 500
+func (s *BasisService) Limit(limit int) *BasisService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *BasisService) StartTime(startTime int64) *BasisService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *BasisService) EndTime(endTime int64) *BasisService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *BasisService) Do(ctx context.Context, opts ...RequestOption) (res []*Basis, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/basis",
+	}
+	r.SetParam("pair", s.pair)
+	r.SetParam("contractType", s.contractType)
+	r.SetParam("period", s.period)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	res = make([]*Basis, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	return res, nil
+}
+
+// DoAll send requests over the last 30 days, or the part of them between
+// StartTime and EndTime, 500 periods at a time. The exchange keeps only the
+// last 30 days: a StartTime older than that is silently moved to 30 days ago.
+func (s *BasisService) DoAll(ctx context.Context, opts ...RequestOption) (res []*Basis, err error) {
+	windows, err := statisticsWindows(s.period, s.startTime, s.endTime, time.Now())
+	if err != nil {
+		return []*Basis{}, err
+	}
+	res = make([]*Basis, 0)
+	for _, w := range windows {
+		page := *s
+		page.startTime, page.endTime = &w[0], &w[1]
+		page.Limit(statisticsMaxLimit)
+		rows, err := page.Do(ctx, opts...)
+		if err != nil {
+			return []*Basis{}, err
+		}
+		res = append(res, rows...)
+	}
+	return res, nil
+}
+
+// Basis define the basis of a period
+type Basis struct {
+	Pair                string       `json:"pair"`
+	ContractType        ContractType `json:"contractType"`
+	IndexPrice          float64      `json:"indexPrice,string"`
+	FuturesPrice        float64      `json:"futuresPrice,string"`
+	Basis               float64      `json:"basis,string"`
+	BasisRate           float64      `json:"basisRate,string"`
+	AnnualizedBasisRate string       `json:"annualizedBasisRate"` // empty for perpetual contracts
+	Timestamp           int64        `json:"timestamp"`
+}
+
+// DeliveryPriceService list the settlement prices of the quarterly contracts of a pair
+type DeliveryPriceService struct {
+	c    *Client
+	pair string
+}
+
+// Pair set pair
+func (s *DeliveryPriceService) Pair(pair string) *DeliveryPriceService {
+	s.pair = pair
+	return s
+}
+
+// Do send request
+func (s *DeliveryPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*DeliveryPrice, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/futures/data/delivery-price",
+	}
+	r.SetParam("pair", s.pair)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*DeliveryPrice{}, err
+	}
+	res = make([]*DeliveryPrice, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*DeliveryPrice{}, err
+	}
+	return res, nil
+}
+
+// DeliveryPrice define the settlement price of a quarterly contract
+type DeliveryPrice struct {
+	DeliveryTime  int64   `json:"deliveryTime"`
+	DeliveryPrice float64 `json:"deliveryPrice"`
+}
+
+// IndexInfoService get the base assets of the composite indexes
+type IndexInfoService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *IndexInfoService) Symbol(symbol string) *IndexInfoService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *IndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*IndexInfo, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/indexInfo",
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*IndexInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*IndexInfo{}, err
+	}
+	return res, nil
+}
+
+// IndexInfo define a composite index
+type IndexInfo struct {
+	Symbol        string `json:"symbol"`
+	Time          int64  `json:"time"`
+	Component     string `json:"component"`
+	BaseAssetList []struct {
+		BaseAsset          string  `json:"baseAsset"`
+		QuoteAsset         string  `json:"quoteAsset"`
+		WeightInQuantity   float64 `json:"weightInQuantity,string"`
+		WeightInPercentage float64 `json:"weightInPercentage,string"`
+	} `json:"baseAssetList"`
+}
+
+// ConstituentsService get the exchanges and markets making the index price of a symbol
+type ConstituentsService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *ConstituentsService) Symbol(symbol string) *ConstituentsService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *ConstituentsService) Do(ctx context.Context, opts ...RequestOption) (res *Constituents, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/constituents",
+	}
+	r.SetParam("symbol", s.symbol)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Constituents)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Constituents define the constituents of an index price
+type Constituents struct {
+	Symbol       string `json:"symbol"`
+	Time         int64  `json:"time"`
+	Constituents []struct {
+		Exchange string `json:"exchange"`
+		Symbol   string `json:"symbol"`
+	} `json:"constituents"`
+}
+
+// AssetIndexService get the asset indexes used by the multi-assets mode
+type AssetIndexService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, e.g. BTCUSD
+func (s *AssetIndexService) Symbol(symbol string) *AssetIndexService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *AssetIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*AssetIndex, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/assetIndex",
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*AssetIndex{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*AssetIndex, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*AssetIndex{}, err
+	}
+	return res, nil
+}
+
+// AssetIndex define the index of an asset in the multi-assets mode
+type AssetIndex struct {
+	Symbol                string  `json:"symbol"`
+	Time                  int64   `json:"time"`
+	Index                 float64 `json:"index,string"`
+	BidBuffer             float64 `json:"bidBuffer,string"`
+	AskBuffer             float64 `json:"askBuffer,string"`
+	BidRate               float64 `json:"bidRate,string"`
+	AskRate               float64 `json:"askRate,string"`
+	AutoExchangeBidBuffer float64 `json:"autoExchangeBidBuffer,string"`
+	AutoExchangeAskBuffer float64 `json:"autoExchangeAskBuffer,string"`
+	AutoExchangeBidRate   float64 `json:"autoExchangeBidRate,string"`
+	AutoExchangeAskRate   float64 `json:"autoExchangeAskRate,string"`
+}
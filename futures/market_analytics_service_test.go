@@ -0,0 +1,86 @@
+package futures_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+func TestStatisticsWindows(t *testing.T) {
+	now := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	ms := func(t time.Time) *int64 {
+		v := t.UnixMilli()
+		return &v
+	}
+	retention := now.Add(-30 * 24 * time.Hour).UnixMilli()
+	tests := []struct {
+		name       string
+		period     futures.StatisticsPeriod
+		start, end *int64
+		want       [][2]int64
+	}{
+		{
+			name:   "default range 1d",
+			period: futures.StatisticsPeriod1d,
+			want:   [][2]int64{{retention, now.UnixMilli()}},
+		},
+		{
+			name:   "start clamped to 30 days",
+			period: futures.StatisticsPeriod1d,
+			start:  ms(now.Add(-90 * 24 * time.Hour)),
+			end:    ms(now.Add(-24 * time.Hour)),
+			want:   [][2]int64{{retention, now.Add(-24 * time.Hour).UnixMilli()}},
+		},
+		{
+			name:   "end clamped to now",
+			period: futures.StatisticsPeriod1h,
+			start:  ms(now.Add(-time.Hour)),
+			end:    ms(now.Add(time.Hour)),
+			want:   [][2]int64{{now.Add(-time.Hour).UnixMilli(), now.UnixMilli()}},
+		},
+		{
+			name:   "500 periods per window",
+			period: futures.StatisticsPeriod1h,
+			start:  ms(now.Add(-1000 * time.Hour)),
+			end:    ms(now.Add(-time.Hour)),
+			want: [][2]int64{
+				{retention, retention + 500*time.Hour.Milliseconds() - 1},
+				{retention + 500*time.Hour.Milliseconds(), now.Add(-time.Hour).UnixMilli()},
+			},
+		},
+		{
+			name:   "exact multiple of 500 periods",
+			period: futures.StatisticsPeriod5m,
+			start:  ms(now.Add(-1000 * 5 * time.Minute)),
+			end:    ms(now.Add(-5*time.Minute - time.Millisecond)),
+			want: [][2]int64{
+				{now.Add(-1000 * 5 * time.Minute).UnixMilli(), now.Add(-500*5*time.Minute).UnixMilli() - 1},
+				{now.Add(-500 * 5 * time.Minute).UnixMilli(), now.Add(-5*time.Minute - time.Millisecond).UnixMilli()},
+			},
+		},
+		{
+			name:   "empty range",
+			period: futures.StatisticsPeriod1h,
+			start:  ms(now.Add(-time.Hour)),
+			end:    ms(now.Add(-2 * time.Hour)),
+		},
+	}
+	for _, test := range tests {
+		got, err := futures.StatisticsWindows(test.period, test.start, test.end, now)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("%s: got %v, want %v", test.name, got, test.want)
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Fatalf("%s: got %v, want %v", test.name, got, test.want)
+			}
+		}
+	}
+	if _, err := futures.StatisticsWindows("3m", nil, nil, now); err == nil {
+		t.Fatal("expected an error for an unknown period")
+	}
+}
@@ -0,0 +1,68 @@
+package futures_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/gobinance/paper"
+)
+
+func TestPositionBook(t *testing.T) {
+	ex := paper.NewExchange()
+	ex.AddSymbol(&paper.Symbol{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", TickSize: 0.1, StepSize: 0.001})
+	ex.SetFuturesBalance("USDT", 1000)
+	ex.UpdateBook("BTCUSDT", []common.PriceLevel{{Price: 99, Quantity: 5}}, []common.PriceLevel{{Price: 100, Quantity: 5}})
+
+	client := futures.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+	book := client.NewPositionBook()
+	if err := book.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if balance := book.Balance("USDT"); balance != 1000 {
+		t.Fatalf("unexpected balance %v", balance)
+	}
+	ex.FuturesHandler = book.HandleUserData
+
+	order := func(id string, side futures.SideType) {
+		_, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(side).Type(futures.OrderTypeMarket).
+			Quantity("1").NewClientOrderID(id).Do(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	order("a-1", futures.SideTypeBuy)
+	order("b-1", futures.SideTypeBuy)
+	book.HandleMarkPrice(&futures.WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: 110})
+	pos, ok := book.Position("BTCUSDT", futures.PositionSideTypeBoth)
+	if !ok || pos.PositionAmt != 2 || pos.UnrealizedPnL != 20 {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+
+	book.HandleUserData(&futures.WsUserDataEvent{
+		Event: futures.UserDataEventTypeAccountUpdate,
+		AccountUpdate: futures.WsAccountUpdate{
+			Reason:   futures.UserDataEventReasonTypeFundingFee,
+			Balances: []futures.WsBalance{{Asset: "USDT", Balance: 990, ChangeBalance: -2}},
+		},
+	})
+	ex.UpdateBook("BTCUSDT", []common.PriceLevel{{Price: 110, Quantity: 5}}, []common.PriceLevel{{Price: 111, Quantity: 5}})
+	order("b-2", futures.SideTypeSell)
+
+	if pnl := book.SymbolPnL("BTCUSDT"); pnl.RealizedPnL != 10 || pnl.Funding != 2 || pnl.Trades != 3 {
+		t.Fatalf("unexpected symbol pnl %+v", pnl)
+	}
+	a, b := book.TagPnL("a"), book.TagPnL("b")
+	if a.RealizedPnL != 0 || a.Funding != 1 || b.RealizedPnL != 10 || b.Funding != 1 {
+		t.Fatalf("unexpected tag pnl %+v %+v", a, b)
+	}
+	if math.Abs(a.Fees["USDT"]+b.Fees["USDT"]-book.SymbolPnL("BTCUSDT").Fees["USDT"]) > 1e-9 {
+		t.Fatalf("unexpected fees %+v %+v", a, b)
+	}
+	if pos, _ = book.Position("BTCUSDT", futures.PositionSideTypeBoth); pos.PositionAmt != 1 {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+}
@@ -48,22 +48,22 @@ func (s *GetPositionMarginHistoryService) Limit(limit int64) *GetPositionMarginH
 // Do send request
 func (s *GetPositionMarginHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionMarginHistory, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/positionMargin/history",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/positionMargin/history",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s._type != nil {
-		r.setParam("type", *s._type)
+		r.SetParam("type", *s._type)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
@@ -3,6 +3,8 @@ package futures
 import (
 	"context"
 	"net/http"
+
+	"github.com/uncle-gua/gobinance/common"
 )
 
 // ChangeLeverageService change user's initial leverage of specific symbol market
@@ -27,11 +29,11 @@ func (s *ChangeLeverageService) Leverage(leverage int) *ChangeLeverageService {
 // Do send request
 func (s *ChangeLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *SymbolLeverage, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/leverage",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/leverage",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":   s.symbol,
 		"leverage": s.leverage,
 	})
@@ -76,11 +78,11 @@ func (s *ChangeMarginTypeService) MarginType(marginType MarginType) *ChangeMargi
 // Do send request
 func (s *ChangeMarginTypeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/marginType",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/marginType",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"symbol":     s.symbol,
 		"marginType": s.marginType,
 	})
@@ -127,9 +129,9 @@ func (s *UpdatePositionMarginService) Type(actionType int) *UpdatePositionMargin
 // Do send request
 func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/positionMargin",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/positionMargin",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol": s.symbol,
@@ -139,7 +141,7 @@ func (s *UpdatePositionMarginService) Do(ctx context.Context, opts ...RequestOpt
 	if s.positionSide != nil {
 		m["positionSide"] = *s.positionSide
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 
 	_, _, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -160,9 +162,9 @@ type GetPositionModeService struct {
 // Do send request
 func (s *GetPositionModeService) Do(ctx context.Context, opts ...RequestOption) (bool, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -194,11 +196,11 @@ func (s *ChangePositionModeService) DualSide(dualSide bool) *ChangePositionModeS
 // Do send request
 func (s *ChangePositionModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/fapi/v1/positionSide/dual",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/positionSide/dual",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{
+	r.SetFormParams(params{
 		"dualSidePosition": s.dualSide,
 	})
 	_, _, err = s.c.callAPI(ctx, r, opts...)
@@ -226,11 +228,11 @@ type AccountConfig struct {
 // Do send request
 func (s *GetAccountConfigService) Do(ctx context.Context, opts ...RequestOption) (res *AccountConfig, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/accountConfig",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/accountConfig",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParams(params{})
+	r.SetFormParams(params{})
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -242,3 +244,182 @@ func (s *GetAccountConfigService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// MultiAssetsMode define multi-assets mode
+type MultiAssetsMode struct {
+	MultiAssetsMargin bool `json:"multiAssetsMargin"`
+}
+
+// GetMultiAssetsModeService get user's multi-assets mode
+type GetMultiAssetsModeService struct {
+	c *Client
+}
+
+// Do send request, true - Multi-Assets Mode, false - Single-Asset Mode
+func (s *GetMultiAssetsModeService) Do(ctx context.Context, opts ...RequestOption) (bool, error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/multiAssetsMargin",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return false, err
+	}
+	mode := new(MultiAssetsMode)
+	if err := json.Unmarshal(data, mode); err != nil {
+		return false, err
+	}
+	return mode.MultiAssetsMargin, nil
+}
+
+// ChangeMultiAssetsModeService change user's multi-assets mode
+type ChangeMultiAssetsModeService struct {
+	c                 *Client
+	multiAssetsMargin string
+}
+
+// MultiAssetsMargin set multi-assets mode: true - Multi-Assets Mode, false - Single-Asset Mode
+func (s *ChangeMultiAssetsModeService) MultiAssetsMargin(multiAssetsMargin bool) *ChangeMultiAssetsModeService {
+	if multiAssetsMargin {
+		s.multiAssetsMargin = "true"
+	} else {
+		s.multiAssetsMargin = "false"
+	}
+	return s
+}
+
+// Do send request
+func (s *ChangeMultiAssetsModeService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/multiAssetsMargin",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"multiAssetsMargin": s.multiAssetsMargin,
+	})
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetADLQuantileService get the auto-deleveraging queue position of the positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*ADLQuantile, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/adlQuantile",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*ADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// ADLQuantile define the ADL quantiles of the positions of a symbol, from 0
+// to 4, the higher the sooner the position is deleveraged. Cross hedge mode
+// positions share a quantile and set HEDGE instead of BOTH.
+type ADLQuantile struct {
+	Symbol      string `json:"symbol"`
+	ADLQuantile struct {
+		Long  int `json:"LONG"`
+		Short int `json:"SHORT"`
+		Both  int `json:"BOTH"`
+		Hedge int `json:"HEDGE"`
+	} `json:"adlQuantile"`
+}
+
+// Quantile return the quantile of a position, positionAmt tells the side of
+// a one-way position
+func (q *ADLQuantile) Quantile(positionSide PositionSideType, positionAmt float64) int {
+	switch {
+	case positionSide == PositionSideTypeLong:
+		return q.ADLQuantile.Long
+	case positionSide == PositionSideTypeShort:
+		return q.ADLQuantile.Short
+	case q.ADLQuantile.Both != 0:
+		return q.ADLQuantile.Both
+	case positionAmt > 0:
+		return q.ADLQuantile.Long
+	case positionAmt < 0:
+		return q.ADLQuantile.Short
+	}
+	return 0
+}
+
+// ListPositionADLService list the open positions with their ADL quantile
+type ListPositionADLService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *ListPositionADLService) Symbol(symbol string) *ListPositionADLService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send requests
+func (s *ListPositionADLService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionADL, err error) {
+	positions := s.c.NewGetPositionRiskService()
+	quantiles := s.c.NewGetADLQuantileService()
+	if s.symbol != nil {
+		positions.Symbol(*s.symbol)
+		quantiles.Symbol(*s.symbol)
+	}
+	risks, err := positions.Do(ctx, opts...)
+	if err != nil {
+		return []*PositionADL{}, err
+	}
+	adls, err := quantiles.Do(ctx, opts...)
+	if err != nil {
+		return []*PositionADL{}, err
+	}
+	bySymbol := make(map[string]*ADLQuantile, len(adls))
+	for _, q := range adls {
+		bySymbol[q.Symbol] = q
+	}
+	res = make([]*PositionADL, 0)
+	for _, r := range risks {
+		if r.PositionAmt == 0 {
+			continue
+		}
+		p := &PositionADL{PositionRisk: r}
+		if q, ok := bySymbol[r.Symbol]; ok {
+			p.ADLQuantile = q.Quantile(r.PositionSide, r.PositionAmt)
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// PositionADL define a position and its ADL quantile
+type PositionADL struct {
+	*PositionRisk
+	ADLQuantile int
+}
@@ -22,12 +22,12 @@ func (s *PremiumIndexService) Symbol(symbol string) *PremiumIndexService {
 // Do send request
 func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*PremiumIndex, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/premiumIndex",
-		secType:  secTypeNone,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/premiumIndex",
+		SecType:  secTypeNone,
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	data = common.ToJSONList(data)
@@ -83,19 +83,19 @@ func (s *FundingRateService) Limit(limit int) *FundingRateService {
 // Do send request
 func (s *FundingRateService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingRate, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/fundingRate",
-		secType:  secTypeNone,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/fundingRate",
+		SecType:  secTypeNone,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -113,6 +113,41 @@ type FundingRate struct {
 	Time        int64   `json:"time"`
 }
 
+// GetFundingInfoService get the funding interval and rate caps of the
+// symbols with adjusted funding parameters
+type GetFundingInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetFundingInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*FundingInfo, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/fundingInfo",
+		SecType:  secTypeNone,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*FundingInfo{}, err
+	}
+	res = make([]*FundingInfo, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*FundingInfo{}, err
+	}
+	return res, nil
+}
+
+// FundingInfo define the funding parameters of a symbol, symbols not listed
+// are funded every 8 hours with the default caps
+type FundingInfo struct {
+	Symbol                   string  `json:"symbol"`
+	AdjustedFundingRateCap   float64 `json:"adjustedFundingRateCap,string"`
+	AdjustedFundingRateFloor float64 `json:"adjustedFundingRateFloor,string"`
+	FundingIntervalHours     int     `json:"fundingIntervalHours"`
+	Disclaimer               bool    `json:"disclaimer"`
+}
+
 // GetLeverageBracketService get funding rate
 type GetLeverageBracketService struct {
 	c      *Client
@@ -128,13 +163,13 @@ func (s *GetLeverageBracketService) Symbol(symbol string) *GetLeverageBracketSer
 // Do send request
 func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOption) (res []*LeverageBracket, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/leverageBracket",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/leverageBracket",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
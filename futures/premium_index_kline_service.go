@@ -0,0 +1,91 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// PremiumIndexKlinesService list premium index klines
+type PremiumIndexKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  KlineInterval
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (piks *PremiumIndexKlinesService) Symbol(symbol string) *PremiumIndexKlinesService {
+	piks.symbol = symbol
+	return piks
+}
+
+// Interval set interval
+func (piks *PremiumIndexKlinesService) Interval(interval KlineInterval) *PremiumIndexKlinesService {
+	piks.interval = interval
+	return piks
+}
+
+// Limit set limit
+func (piks *PremiumIndexKlinesService) Limit(limit int) *PremiumIndexKlinesService {
+	piks.limit = &limit
+	return piks
+}
+
+// StartTime set startTime
+func (piks *PremiumIndexKlinesService) StartTime(startTime int64) *PremiumIndexKlinesService {
+	piks.startTime = &startTime
+	return piks
+}
+
+// EndTime set endTime
+func (piks *PremiumIndexKlinesService) EndTime(endTime int64) *PremiumIndexKlinesService {
+	piks.endTime = &endTime
+	return piks
+}
+
+// Do send request
+func (piks *PremiumIndexKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/premiumIndexKlines",
+	}
+	r.SetParam("symbol", piks.symbol)
+	r.SetParam("interval", piks.interval)
+	if piks.limit != nil {
+		r.SetParam("limit", *piks.limit)
+	}
+	if piks.startTime != nil {
+		r.SetParam("startTime", *piks.startTime)
+	}
+	if piks.endTime != nil {
+		r.SetParam("endTime", *piks.endTime)
+	}
+	data, _, err := piks.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+// DoAll send requests from StartTime, which is required, to EndTime or now,
+// 1500 klines at a time
+func (piks *PremiumIndexKlinesService) DoAll(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	if piks.startTime == nil {
+		return []*Kline{}, errors.New("startTime is required")
+	}
+	end := time.Now().UnixMilli()
+	if piks.endTime != nil {
+		end = *piks.endTime
+	}
+	return klinePages(*piks.startTime, end, func(from, to int64) ([]*Kline, error) {
+		page := *piks
+		page.startTime, page.endTime = &from, &to
+		page.Limit(klineMaxLimit)
+		return page.Do(ctx, opts...)
+	})
+}
@@ -27,16 +27,16 @@ func (s *GetRebateNewUserService) Type(type_future int) *GetRebateNewUserService
 // Do send request
 func (s *GetRebateNewUserService) Do(ctx context.Context, opts ...RequestOption) (res *RebateNewUser, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/fapi/v1/apiReferral/ifNewUser",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/fapi/v1/apiReferral/ifNewUser",
+		SecType:  secTypeSigned,
 	}
 
 	if s.brokerageID != "" {
-		r.setParam("brokerId", s.brokerageID)
+		r.SetParam("brokerId", s.brokerageID)
 	}
 	if s.type_future != 0 {
-		r.setParam("type", s.type_future)
+		r.SetParam("type", s.type_future)
 	}
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
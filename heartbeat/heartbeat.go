@@ -0,0 +1,239 @@
+// Package heartbeat keeps a countdown cancel-all (dead man's switch) armed
+// while the process is healthy.
+//
+// Each symbol's countdown is refreshed on an interval shorter than the
+// countdown itself. If the process dies, hangs, fails its health check or
+// its context is cancelled, the refreshes stop and the exchange cancels all
+// open orders of the symbols once the countdown expires.
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// ErrUnhealthy is returned by Run when the health check fails
+var ErrUnhealthy = errors.New("heartbeat: health check failed")
+
+// Countdowner arm, refresh or disarm (countdown of 0) the countdown cancel
+// all of a symbol
+type Countdowner interface {
+	CountdownCancelAll(ctx context.Context, symbol string, countdown time.Duration) error
+}
+
+// FuturesCountdowner refresh the countdown with the USDT-M futures service
+type FuturesCountdowner struct {
+	c *futures.Client
+}
+
+// NewFuturesCountdowner init futures countdowner
+func NewFuturesCountdowner(c *futures.Client) *FuturesCountdowner {
+	return &FuturesCountdowner{c: c}
+}
+
+// CountdownCancelAll implement Countdowner
+func (f *FuturesCountdowner) CountdownCancelAll(ctx context.Context, symbol string, countdown time.Duration) error {
+	_, err := f.c.NewCountdownCancelAllService().Symbol(symbol).CountdownTime(countdown.Milliseconds()).Do(ctx)
+	return err
+}
+
+// DeliveryCountdowner refresh the countdown with the COIN-M delivery service
+type DeliveryCountdowner struct {
+	c *delivery.Client
+}
+
+// NewDeliveryCountdowner init delivery countdowner
+func NewDeliveryCountdowner(c *delivery.Client) *DeliveryCountdowner {
+	return &DeliveryCountdowner{c: c}
+}
+
+// CountdownCancelAll implement Countdowner
+func (d *DeliveryCountdowner) CountdownCancelAll(ctx context.Context, symbol string, countdown time.Duration) error {
+	_, err := d.c.NewCountdownCancelAllService().Symbol(symbol).CountdownTime(countdown.Milliseconds()).Do(ctx)
+	return err
+}
+
+// HealthCheck report whether the process is healthy enough to keep its
+// orders alive
+type HealthCheck func(ctx context.Context) error
+
+// Stats define the refresh metrics of a symbol
+type Stats struct {
+	Refreshes   int64         // successful refreshes
+	Failures    int64         // failed refreshes
+	Misses      int64         // successful refreshes sent after the previous deadline
+	LastRefresh time.Time     // time of the last successful refresh
+	Deadline    time.Time     // time the orders are canceled without a new refresh
+	LastMargin  time.Duration // time left before the previous deadline at the last refresh
+	MinMargin   time.Duration // smallest time left before a deadline, negative after a miss
+	LastError   error         // error of the last failed refresh
+}
+
+// Heartbeat refresh the countdown cancel all of symbols on an interval
+type Heartbeat struct {
+	Countdown   time.Duration                  // countdown sent on each refresh
+	Interval    time.Duration                  // refresh interval, a third of Countdown when zero
+	HealthCheck HealthCheck                    // checked before each round of refreshes, optional
+	OnError     func(symbol string, err error) // called when a refresh fails, optional
+
+	c       Countdowner
+	mu      sync.Mutex
+	symbols []string
+	stats   map[string]*Stats
+}
+
+// New init a heartbeat refreshing the countdown of symbols
+func New(c Countdowner, countdown time.Duration, symbols ...string) *Heartbeat {
+	h := &Heartbeat{
+		Countdown: countdown,
+		c:         c,
+		stats:     make(map[string]*Stats),
+	}
+	for _, symbol := range symbols {
+		h.AddSymbol(symbol)
+	}
+	return h
+}
+
+// AddSymbol start refreshing the countdown of symbol on the next round
+func (h *Heartbeat) AddSymbol(symbol string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.stats[symbol]; ok {
+		return
+	}
+	h.symbols = append(h.symbols, symbol)
+	h.stats[symbol] = &Stats{}
+}
+
+// RemoveSymbol stop refreshing the countdown of symbol, the countdown already
+// armed keeps running unless disarmed
+func (h *Heartbeat) RemoveSymbol(symbol string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.stats, symbol)
+	for i, s := range h.symbols {
+		if s == symbol {
+			h.symbols = append(h.symbols[:i], h.symbols[i+1:]...)
+			break
+		}
+	}
+}
+
+// Stats return the metrics of a symbol
+func (h *Heartbeat) Stats(symbol string) (Stats, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st, ok := h.stats[symbol]
+	if !ok {
+		return Stats{}, false
+	}
+	return *st, true
+}
+
+func (h *Heartbeat) interval() time.Duration {
+	if h.Interval > 0 {
+		return h.Interval
+	}
+	return h.Countdown / 3
+}
+
+// Run refresh the countdowns until ctx is done or the health check fails.
+// The first round is sent at once. It returns ctx.Err() or an error wrapping
+// ErrUnhealthy, in both cases the armed countdowns are left to expire.
+func (h *Heartbeat) Run(ctx context.Context) error {
+	if h.Countdown <= 0 {
+		return errors.New("heartbeat: countdown must be positive")
+	}
+	if h.interval() <= 0 || h.interval() >= h.Countdown {
+		return errors.New("heartbeat: interval must be positive and shorter than the countdown")
+	}
+	ticker := time.NewTicker(h.interval())
+	defer ticker.Stop()
+	for {
+		if err := h.Beat(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Beat run the health check then refresh the countdown of every symbol once
+func (h *Heartbeat) Beat(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if h.HealthCheck != nil {
+		if err := h.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("%w: %v", ErrUnhealthy, err)
+		}
+	}
+	h.mu.Lock()
+	symbols := append([]string(nil), h.symbols...)
+	h.mu.Unlock()
+	for _, symbol := range symbols {
+		err := h.c.CountdownCancelAll(ctx, symbol, h.Countdown)
+		h.record(symbol, time.Now(), err)
+		if err != nil && h.OnError != nil {
+			h.OnError(symbol, err)
+		}
+	}
+	return nil
+}
+
+func (h *Heartbeat) record(symbol string, now time.Time, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st, ok := h.stats[symbol]
+	if !ok {
+		return
+	}
+	if err != nil {
+		st.Failures++
+		st.LastError = err
+		return
+	}
+	if !st.Deadline.IsZero() {
+		st.LastMargin = st.Deadline.Sub(now)
+		if st.Refreshes == 1 || st.LastMargin < st.MinMargin {
+			st.MinMargin = st.LastMargin
+		}
+		if st.LastMargin <= 0 {
+			st.Misses++
+		}
+	}
+	st.Refreshes++
+	st.LastRefresh = now
+	st.Deadline = now.Add(h.Countdown)
+}
+
+// Disarm cancel the countdown of every symbol, used on a clean shutdown
+// after Run returned so that the open orders are kept
+func (h *Heartbeat) Disarm(ctx context.Context) error {
+	h.mu.Lock()
+	symbols := append([]string(nil), h.symbols...)
+	h.mu.Unlock()
+	var errs []error
+	for _, symbol := range symbols {
+		if err := h.c.CountdownCancelAll(ctx, symbol, 0); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
+			continue
+		}
+		h.mu.Lock()
+		if st, ok := h.stats[symbol]; ok {
+			st.Deadline = time.Time{}
+		}
+		h.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
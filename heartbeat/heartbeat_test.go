@@ -0,0 +1,98 @@
+package heartbeat_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/uncle-gua/gobinance/heartbeat"
+)
+
+type fakeCountdowner struct {
+	mu    sync.Mutex
+	calls map[string][]time.Duration
+	fail  error
+}
+
+func (f *fakeCountdowner) CountdownCancelAll(ctx context.Context, symbol string, countdown time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.calls == nil {
+		f.calls = make(map[string][]time.Duration)
+	}
+	f.calls[symbol] = append(f.calls[symbol], countdown)
+	return f.fail
+}
+
+func (f *fakeCountdowner) count(symbol string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.calls[symbol])
+}
+
+func TestHeartbeatRefreshesUntilCancelled(t *testing.T) {
+	f := &fakeCountdowner{}
+	h := heartbeat.New(f, 300*time.Millisecond, "BTCUSDT", "ETHUSDT")
+	h.Interval = 20 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 110*time.Millisecond)
+	defer cancel()
+	err := h.Run(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if n := f.count("BTCUSDT"); n < 3 {
+		t.Errorf("expected several refreshes, got %d", n)
+	}
+	st, ok := h.Stats("ETHUSDT")
+	if !ok || st.Refreshes < 3 || st.Misses != 0 {
+		t.Errorf("unexpected stats %+v", st)
+	}
+	if st.MinMargin <= 0 || st.MinMargin > 300*time.Millisecond {
+		t.Errorf("unexpected min margin %v", st.MinMargin)
+	}
+}
+
+func TestHeartbeatStopsWhenUnhealthy(t *testing.T) {
+	f := &fakeCountdowner{}
+	h := heartbeat.New(f, time.Second, "BTCUSDT")
+	h.Interval = 10 * time.Millisecond
+	beats := 0
+	h.HealthCheck = func(ctx context.Context) error {
+		beats++
+		if beats > 2 {
+			return errors.New("stale market data")
+		}
+		return nil
+	}
+	err := h.Run(context.Background())
+	if !errors.Is(err, heartbeat.ErrUnhealthy) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if n := f.count("BTCUSDT"); n != 2 {
+		t.Errorf("expected 2 refreshes, got %d", n)
+	}
+}
+
+func TestHeartbeatFailuresAndDisarm(t *testing.T) {
+	f := &fakeCountdowner{fail: errors.New("timeout")}
+	h := heartbeat.New(f, time.Second, "BTCUSDT")
+	var failed []string
+	h.OnError = func(symbol string, err error) { failed = append(failed, symbol) }
+	if err := h.Beat(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	st, _ := h.Stats("BTCUSDT")
+	if st.Failures != 1 || st.Refreshes != 0 || len(failed) != 1 {
+		t.Errorf("unexpected stats %+v", st)
+	}
+
+	f.fail = nil
+	if err := h.Disarm(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if last := f.calls["BTCUSDT"][len(f.calls["BTCUSDT"])-1]; last != 0 {
+		t.Errorf("expected a countdown of 0, got %v", last)
+	}
+}
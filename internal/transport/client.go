@@ -0,0 +1,172 @@
+// Package transport is the HTTP and websocket plumbing shared by the spot,
+// futures, delivery, options and portfolio margin clients: request building,
+// signing, error decoding and websocket wiring live here once.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/uncle-gua/gobinance/common"
+)
+
+// Redefining the standard package
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+const (
+	timestampKey  = "timestamp"
+	signatureKey  = "signature"
+	recvWindowKey = "recvWindow"
+)
+
+// CurrentTimestamp return the current time in milliseconds
+func CurrentTimestamp() int64 {
+	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// DoFunc send an HTTP request, it replaces HTTPClient.Do when set
+type DoFunc func(req *http.Request) (*http.Response, error)
+
+// Client hold what is needed to send a request to one API. The market
+// clients build it from their own exported settings on each call, so
+// changing Debug or TimeOffset on them keeps working as before.
+type Client struct {
+	APIKey     string
+	SecretKey  string
+	BaseURL    string
+	HTTPClient *http.Client
+	Debug      bool
+	Logger     *log.Logger
+	TimeOffset int64
+	Do         DoFunc
+}
+
+func (c *Client) debug(format string, v ...interface{}) {
+	if c.Debug && c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	}
+}
+
+// ParseRequest apply opts to r, then build its full URL, headers and body,
+// signing it when required
+func (c *Client) ParseRequest(r *Request, opts ...RequestOption) (err error) {
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
+	}
+	err = r.validate()
+	if err != nil {
+		return err
+	}
+
+	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.Endpoint)
+	if r.RecvWindow > 0 {
+		r.SetParam(recvWindowKey, r.RecvWindow)
+	}
+	if r.SecType == SecTypeSigned {
+		r.SetParam(timestampKey, CurrentTimestamp()-c.TimeOffset)
+	}
+	queryString := r.Query.Encode()
+	body := &bytes.Buffer{}
+	bodyString := r.Form.Encode()
+	header := http.Header{}
+	if r.Header != nil {
+		header = r.Header.Clone()
+	}
+	if bodyString != "" {
+		header.Set("Content-Type", "application/x-www-form-urlencoded")
+		body = bytes.NewBufferString(bodyString)
+	}
+	if r.SecType == SecTypeAPIKey || r.SecType == SecTypeSigned {
+		header.Set("X-MBX-APIKEY", c.APIKey)
+	}
+
+	if r.SecType == SecTypeSigned {
+		raw := fmt.Sprintf("%s%s", queryString, bodyString)
+		mac := hmac.New(sha256.New, []byte(c.SecretKey))
+		_, err = mac.Write([]byte(raw))
+		if err != nil {
+			return err
+		}
+		v := url.Values{}
+		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
+		if queryString == "" {
+			queryString = v.Encode()
+		} else {
+			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
+		}
+	}
+	if queryString != "" {
+		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	}
+	c.debug("full url: %s, body: %s", fullURL, bodyString)
+
+	r.FullURL = fullURL
+	r.Header = header
+	r.Body = body
+	return nil
+}
+
+// CallAPI send r and return the response body and headers. A status code of
+// 400 or above is returned as a *common.APIError.
+func (c *Client) CallAPI(ctx context.Context, r *Request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	err = c.ParseRequest(r, opts...)
+	if err != nil {
+		return []byte{}, &http.Header{}, err
+	}
+	req, err := http.NewRequest(r.Method, r.FullURL, r.Body)
+	if err != nil {
+		return []byte{}, &http.Header{}, err
+	}
+	req = req.WithContext(ctx)
+	req.Header = r.Header
+	c.debug("request: %#v", req)
+	f := c.Do
+	if f == nil {
+		f = c.HTTPClient.Do
+	}
+	res, err := f(req)
+	if err != nil {
+		return []byte{}, &http.Header{}, err
+	}
+	data, err = io.ReadAll(res.Body)
+	if err != nil {
+		return []byte{}, &http.Header{}, err
+	}
+	defer func() {
+		cerr := res.Body.Close()
+		// Only overwrite the retured error if the original error was nil and an
+		// error occurred while closing the body.
+		if err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+	c.debug("response: %#v", res)
+	c.debug("response body: %s", string(data))
+	c.debug("response status code: %d", res.StatusCode)
+
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := new(common.APIError)
+		e := json.Unmarshal(data, apiErr)
+		if e != nil {
+			c.debug("failed to unmarshal json: %s", e)
+		}
+		if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusTeapot {
+			if seconds, e := strconv.Atoi(res.Header.Get("Retry-After")); e == nil {
+				apiErr.RetryAfter = time.Duration(seconds) * time.Second
+			}
+		}
+		return nil, &http.Header{}, apiErr
+	}
+	return data, &res.Header, nil
+}
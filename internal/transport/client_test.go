@@ -0,0 +1,84 @@
+package transport_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/internal/transport"
+)
+
+func TestCallAPISigned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-MBX-APIKEY") != "key" {
+			t.Errorf("missing api key header")
+		}
+		query := r.URL.RawQuery
+		i := strings.Index(query, "&signature=")
+		if i < 0 {
+			t.Fatalf("missing signature in %s", query)
+		}
+		_ = r.ParseForm()
+		body := r.PostForm.Encode()
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(query[:i] + body))
+		if query[i+len("&signature="):] != fmt.Sprintf("%x", mac.Sum(nil)) {
+			t.Errorf("bad signature")
+		}
+		if r.URL.Query().Get("recvWindow") != "5000" || r.URL.Query().Get("timestamp") == "" {
+			t.Errorf("unexpected query %s", query)
+		}
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	c := &transport.Client{APIKey: "key", SecretKey: "secret", BaseURL: server.URL, HTTPClient: http.DefaultClient}
+	r := &transport.Request{Method: http.MethodPost, Endpoint: "/api/v3/order", SecType: transport.SecTypeSigned}
+	r.SetParam("symbol", "BTCUSDT")
+	r.SetFormParam("side", "BUY")
+	data, _, err := c.CallAPI(context.Background(), r, transport.WithRecvWindow(5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"ok":true}` {
+		t.Errorf("unexpected body %s", data)
+	}
+}
+
+func TestCallAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+	}))
+	defer server.Close()
+
+	c := &transport.Client{BaseURL: server.URL, HTTPClient: http.DefaultClient}
+	_, _, err := c.CallAPI(context.Background(), &transport.Request{Method: http.MethodGet, Endpoint: "/api/v3/ping"})
+	apiErr, ok := err.(*common.APIError)
+	if !ok || apiErr.Code != -1121 {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCallAPIRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"code":-1003,"msg":"Too many requests."}`))
+	}))
+	defer server.Close()
+
+	c := &transport.Client{BaseURL: server.URL, HTTPClient: http.DefaultClient}
+	_, _, err := c.CallAPI(context.Background(), &transport.Request{Method: http.MethodGet, Endpoint: "/api/v3/ping"})
+	apiErr, ok := err.(*common.APIError)
+	if !ok || apiErr.Code != -1003 || apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("unexpected error %v", err)
+	}
+}
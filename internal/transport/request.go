@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SecType define the security type of an endpoint
+type SecType int
+
+// Security types
+const (
+	SecTypeNone SecType = iota
+	SecTypeAPIKey
+	SecTypeSigned // if the 'timestamp' parameter is required
+)
+
+// Params define request parameters
+type Params map[string]interface{}
+
+// Request define an API request
+type Request struct {
+	Method     string
+	Endpoint   string
+	Query      url.Values
+	Form       url.Values
+	RecvWindow int64
+	SecType    SecType
+	Header     http.Header
+	Body       io.Reader
+	FullURL    string
+}
+
+// AddParam add param with key/value to query string
+func (r *Request) AddParam(key string, value interface{}) *Request {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	r.Query.Add(key, fmt.Sprintf("%v", value))
+	return r
+}
+
+// SetParam set param with key/value to query string
+func (r *Request) SetParam(key string, value interface{}) *Request {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	r.Query.Set(key, fmt.Sprintf("%v", value))
+	return r
+}
+
+// SetParams set params with key/values to query string
+func (r *Request) SetParams(m Params) *Request {
+	for k, v := range m {
+		r.SetParam(k, v)
+	}
+	return r
+}
+
+// SetFormParam set param with key/value to request form body
+func (r *Request) SetFormParam(key string, value interface{}) *Request {
+	if r.Form == nil {
+		r.Form = url.Values{}
+	}
+	r.Form.Set(key, fmt.Sprintf("%v", value))
+	return r
+}
+
+// SetFormParams set params with key/values to request form body
+func (r *Request) SetFormParams(m Params) *Request {
+	for k, v := range m {
+		r.SetFormParam(k, v)
+	}
+	return r
+}
+
+func (r *Request) validate() (err error) {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	if r.Form == nil {
+		r.Form = url.Values{}
+	}
+	return nil
+}
+
+// RequestOption define option type for request
+type RequestOption func(*Request)
+
+// WithRecvWindow set recvWindow param for the request
+func WithRecvWindow(recvWindow int64) RequestOption {
+	return func(r *Request) {
+		r.RecvWindow = recvWindow
+	}
+}
+
+// WithHeader set or add a header value to the request
+func WithHeader(key, value string, replace bool) RequestOption {
+	return func(r *Request) {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		if replace {
+			r.Header.Set(key, value)
+		} else {
+			r.Header.Add(key, value)
+		}
+	}
+}
+
+// WithHeaders set or replace the headers of the request
+func WithHeaders(header http.Header) RequestOption {
+	return func(r *Request) {
+		r.Header = header.Clone()
+	}
+}
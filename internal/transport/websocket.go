@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"github.com/uncle-gua/gobinance/log"
+	"github.com/uncle-gua/wsc"
+)
+
+// NewWs create a websocket client on endpoint, wired to handler, errHandler
+// and the log settings
+func NewWs(endpoint string, handler func(message []byte), errHandler func(err error)) *wsc.Wsc {
+	ws := wsc.New(endpoint)
+	ws.OnConnected(func() {
+		if log.Default.OnConnected {
+			log.Default.Log("websocket connected")
+		}
+	})
+	ws.OnConnectError(errHandler)
+	ws.OnDisconnected(errHandler)
+	ws.OnClose(func(code int, text string) {
+		if log.Default.OnClose {
+			log.Default.Log("websocket closed, code: %d, message: %s", code, text)
+		}
+	})
+	ws.OnSentError(errHandler)
+	ws.OnPingReceived(func(appData string) {
+		if log.Default.OnPingReceived {
+			log.Default.Log("ping received, data: %s", appData)
+		}
+	})
+	ws.OnPongReceived(func(appData string) {
+		if log.Default.OnPongReceived {
+			log.Default.Log("pong received, data: %s", appData)
+		}
+	})
+	ws.OnTextMessageReceived(handler)
+	ws.OnKeepalive(func() {
+		if log.Default.OnKeepalive {
+			log.Default.Log("keep alive")
+		}
+	})
+	return ws
+}
+
+// Serve connect ws and close it once done is closed or receives a value.
+// With async the connection is made in a goroutine and Serve returns at once,
+// otherwise it returns after the first connection attempt.
+func Serve(ws *wsc.Wsc, async bool) (done chan struct{}) {
+	done = make(chan struct{})
+	wait := func() {
+		<-done
+		ws.Close()
+	}
+	if async {
+		go func() {
+			ws.Connect()
+			wait()
+		}()
+		return done
+	}
+	go wait()
+	ws.Connect()
+	return done
+}
@@ -0,0 +1,495 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// Source define a history service collected in the ledger
+type Source string
+
+// Source of the ledger
+const (
+	SourceDeposits      Source = "deposits"
+	SourceWithdraws     Source = "withdraws"
+	SourceTrades        Source = "trades"
+	SourceDust          Source = "dust"
+	SourceDividends     Source = "dividends"
+	SourceConvert       Source = "convert"
+	SourceC2C           Source = "c2c"
+	SourcePay           Source = "pay"
+	SourceFiat          Source = "fiat"
+	SourceSimpleEarn    Source = "simple_earn"
+	SourceFuturesIncome Source = "futures_income"
+	// SourceInterest is the interest of the retired lending products, it is
+	// not in AllSources and only useful for old accounts
+	SourceInterest Source = "interest"
+)
+
+// AllSources is the default of Collector.Sources
+var AllSources = []Source{
+	SourceDeposits, SourceWithdraws, SourceTrades, SourceDust, SourceDividends, SourceConvert,
+	SourceC2C, SourcePay, SourceFiat, SourceSimpleEarn, SourceFuturesIncome,
+}
+
+const (
+	day = 24 * time.Hour
+	// maxRetries is the number of times a rate limited request is sent again
+	maxRetries = 3
+)
+
+// Collector pull the history services over a range into a ledger. The
+// range is split in the windows each service allows, and a window that
+// returns a full page is split in two until the pages are not full; the
+// transactions seen twice are kept once. Only the completed deposits,
+// withdrawals, conversions, P2P trades and fiat orders are collected.
+type Collector struct {
+	spot    *binance.Client
+	futures *futures.Client
+	// Symbols are the spot symbols whose trades are collected, the trades
+	// service has no endpoint for all symbols
+	Symbols []string
+	// Sources are the collected services, default AllSources.
+	// SourceFuturesIncome is skipped without a futures client.
+	Sources []Source
+}
+
+// NewCollector init collector, futures is optional
+func NewCollector(spot *binance.Client, futures *futures.Client, symbols ...string) *Collector {
+	return &Collector{spot: spot, futures: futures, Symbols: symbols}
+}
+
+// Collect return the ledger of the transactions between startTime and
+// endTime, in milliseconds
+func (c *Collector) Collect(ctx context.Context, startTime, endTime int64) (*Ledger, error) {
+	sources := c.Sources
+	if len(sources) == 0 {
+		sources = AllSources
+	}
+	l := New()
+	for _, source := range sources {
+		var err error
+		switch source {
+		case SourceDeposits:
+			err = c.collectDeposits(ctx, l, startTime, endTime)
+		case SourceWithdraws:
+			err = c.collectWithdraws(ctx, l, startTime, endTime)
+		case SourceTrades:
+			err = c.collectTrades(ctx, l, startTime, endTime)
+		case SourceDust:
+			err = c.collectDust(ctx, l, startTime, endTime)
+		case SourceDividends:
+			err = c.collectDividends(ctx, l, startTime, endTime)
+		case SourceConvert:
+			err = c.collectConvert(ctx, l, startTime, endTime)
+		case SourceC2C:
+			err = c.collectC2C(ctx, l, startTime, endTime)
+		case SourcePay:
+			err = c.collectPay(ctx, l, startTime, endTime)
+		case SourceFiat:
+			err = c.collectFiat(ctx, l, startTime, endTime)
+		case SourceSimpleEarn:
+			err = c.collectSimpleEarn(ctx, l, startTime, endTime)
+		case SourceInterest:
+			err = c.collectInterest(ctx, l, startTime, endTime)
+		case SourceFuturesIncome:
+			if c.futures != nil {
+				err = c.collectFuturesIncome(ctx, l, startTime, endTime)
+			}
+		default:
+			err = errors.New("unknown source")
+		}
+		if err != nil {
+			return l, fmt.Errorf("ledger: %s: %w", source, err)
+		}
+	}
+	return l, nil
+}
+
+// windows call fetch on the windows of at most span between startTime and
+// endTime. A window whose page is full is split in two.
+func windows(ctx context.Context, startTime, endTime int64, span time.Duration, limit int, fetch func(from, to int64) (int, error)) error {
+	var split func(from, to int64) error
+	split = func(from, to int64) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := fetch(from, to)
+		if err != nil {
+			return err
+		}
+		if n < limit || to-from < 1000 {
+			return nil
+		}
+		mid := from + (to-from)/2
+		if err = split(from, mid); err != nil {
+			return err
+		}
+		return split(mid+1, to)
+	}
+	step := span.Milliseconds()
+	for from := startTime; from <= endTime; from += step {
+		to := from + step - 1
+		if to > endTime {
+			to = endTime
+		}
+		if err := split(from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retry call f again after the Retry-After delay of a rate limited request
+func retry(ctx context.Context, f func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := f()
+		var apiErr *common.APIError
+		if !errors.As(err, &apiErr) || apiErr.RetryAfter == 0 || attempt == maxRetries {
+			return err
+		}
+		timer := time.NewTimer(apiErr.RetryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pages call fetch with the pages from 1 until a page is not full
+func pages(ctx context.Context, rows int, fetch func(page int32) (int, error)) error {
+	for page := int32(1); ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := fetch(page)
+		if err != nil || n < rows {
+			return err
+		}
+	}
+}
+
+func (c *Collector) collectDeposits(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 1000
+	return windows(ctx, startTime, endTime, 90*day, limit, func(from, to int64) (int, error) {
+		deposits, err := c.spot.NewListDepositsService().StartTime(from).EndTime(to).Limit(limit).Do(ctx)
+		for _, d := range deposits {
+			// 1 success, 6 credited but cannot withdraw
+			if d.Status == 1 || d.Status == 6 {
+				l.Add(FromDeposit(d))
+			}
+		}
+		return len(deposits), err
+	})
+}
+
+func (c *Collector) collectWithdraws(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 1000
+	return windows(ctx, startTime, endTime, 90*day, limit, func(from, to int64) (int, error) {
+		withdraws, err := c.spot.NewListWithdrawsService().StartTime(from).EndTime(to).Limit(limit).Do(ctx)
+		for _, w := range withdraws {
+			// 6 completed
+			if w.Status == 6 {
+				l.Add(FromWithdraw(w))
+			}
+		}
+		return len(withdraws), err
+	})
+}
+
+// collectTrades page the trades of each symbol by id from the first trade of
+// the range. Rate limited requests are sent again after their Retry-After
+// delay, the trades service is the most expensive one.
+func (c *Collector) collectTrades(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	if len(c.Symbols) == 0 {
+		return nil
+	}
+	info, err := c.spot.NewExchangeInfoService().Symbols(c.Symbols...).Do(ctx)
+	if err != nil {
+		return err
+	}
+	const limit = 1000
+	for _, symbol := range info.Symbols {
+		first, err := c.firstTrade(ctx, symbol.Symbol, startTime, endTime)
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			continue
+		}
+		fromID := first.ID
+		for {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+			trades, err := c.listTrades(ctx, c.spot.NewListTradesService().Symbol(symbol.Symbol).FromID(fromID).Limit(limit))
+			if err != nil {
+				return err
+			}
+			for _, trade := range trades {
+				if trade.Time >= startTime && trade.Time <= endTime {
+					l.Add(FromTrade(trade, symbol.BaseAsset, symbol.QuoteAsset))
+				}
+				fromID = trade.ID + 1
+			}
+			if len(trades) < limit || trades[len(trades)-1].Time > endTime {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// firstTrade return the first trade of a symbol in the range, nil when there
+// is none. The trades service only allows time ranges of 24 hours, so the
+// first day is queried and the rest of the range is searched by id: ids grow
+// with time and fromId returns the first trade at or after an id, idle
+// periods cost one request whatever their length.
+func (c *Collector) firstTrade(ctx context.Context, symbol string, startTime, endTime int64) (*binance.TradeV3, error) {
+	to := startTime + day.Milliseconds() - 1
+	if to > endTime {
+		to = endTime
+	}
+	trades, err := c.listTrades(ctx, c.spot.NewListTradesService().Symbol(symbol).StartTime(startTime).EndTime(to).Limit(1))
+	if err != nil || len(trades) > 0 || to == endTime {
+		return first(trades), err
+	}
+
+	// the last trade bounds the search
+	trades, err = c.listTrades(ctx, c.spot.NewListTradesService().Symbol(symbol).Limit(1))
+	if err != nil || len(trades) == 0 || trades[0].Time <= to {
+		return nil, err
+	}
+	found := trades[0]
+	for lo, hi := int64(0), found.ID-1; lo <= hi; {
+		mid := lo + (hi-lo)/2
+		trades, err = c.listTrades(ctx, c.spot.NewListTradesService().Symbol(symbol).FromID(mid).Limit(1))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(trades) == 0 || trades[0].ID >= found.ID:
+			hi = mid - 1
+		case trades[0].Time > to:
+			found, hi = trades[0], mid-1
+		default:
+			lo = trades[0].ID + 1
+		}
+	}
+	if found.Time > endTime {
+		return nil, nil
+	}
+	return found, nil
+}
+
+func (c *Collector) listTrades(ctx context.Context, s *binance.ListTradesService) (trades []*binance.TradeV3, err error) {
+	err = retry(ctx, func() error {
+		trades, err = s.Do(ctx)
+		return err
+	})
+	return trades, err
+}
+
+func first(trades []*binance.TradeV3) *binance.TradeV3 {
+	if len(trades) == 0 {
+		return nil
+	}
+	return trades[0]
+}
+
+func (c *Collector) collectDust(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	// the dust log returns the last 100 conversions of a window
+	const limit = 100
+	return windows(ctx, startTime, endTime, 90*day, limit, func(from, to int64) (int, error) {
+		res, err := c.spot.NewListDustLogService().StartTime(from).EndTime(to).Do(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, dribblet := range res.UserAssetDribblets {
+			for _, detail := range dribblet.UserAssetDribbletDetails {
+				l.Add(FromDust(detail))
+			}
+		}
+		return len(res.UserAssetDribblets), nil
+	})
+}
+
+func (c *Collector) collectDividends(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 500
+	return windows(ctx, startTime, endTime, 90*day, limit, func(from, to int64) (int, error) {
+		res, err := c.spot.NewAssetDividendService().StartTime(from).EndTime(to).Limit(limit).Do(ctx)
+		if err != nil || res.Rows == nil {
+			return 0, err
+		}
+		for _, d := range *res.Rows {
+			l.Add(FromDividend(d))
+		}
+		return len(*res.Rows), nil
+	})
+}
+
+func (c *Collector) collectConvert(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 1000
+	return windows(ctx, startTime, endTime, 30*day, limit, func(from, to int64) (int, error) {
+		res, err := c.spot.NewConvertTradeHistoryService().StartTime(from).EndTime(to).Limit(limit).Do(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range res.List {
+			if item.OrderStatus == "SUCCESS" {
+				l.Add(FromConvert(item))
+			}
+		}
+		if res.MoreData {
+			return limit, nil
+		}
+		return len(res.List), nil
+	})
+}
+
+func (c *Collector) collectC2C(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const rows = 100
+	for _, side := range []binance.SideType{binance.SideTypeBuy, binance.SideTypeSell} {
+		err := windows(ctx, startTime, endTime, 30*day, rows, func(from, to int64) (int, error) {
+			return 0, pages(ctx, rows, func(page int32) (int, error) {
+				res, err := c.spot.NewC2CTradeHistoryService().TradeType(side).StartTimestamp(from).EndTime(to).
+					Page(page).Rows(rows).Do(ctx)
+				if err != nil {
+					return 0, err
+				}
+				for _, r := range res.Data {
+					if r.OrderStatus == "COMPLETED" {
+						l.Add(FromC2C(r))
+					}
+				}
+				return len(res.Data), nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectPay(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 100
+	return windows(ctx, startTime, endTime, 90*day, limit, func(from, to int64) (int, error) {
+		res, err := c.spot.NewPayTradeHistoryService().StartTimestamp(from).EndTimestamp(to).Limit(limit).Do(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range res.Data {
+			l.Add(FromPay(item))
+		}
+		return len(res.Data), nil
+	})
+}
+
+func (c *Collector) collectFiat(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const rows = 500
+	for _, transactionType := range []binance.TransactionType{binance.TransactionTypeDeposit, binance.TransactionTypeWithdraw} {
+		deposit := transactionType == binance.TransactionTypeDeposit
+		err := windows(ctx, startTime, endTime, 90*day, rows, func(from, to int64) (int, error) {
+			return 0, pages(ctx, rows, func(page int32) (int, error) {
+				res, err := c.spot.NewFiatDepositWithdrawHistoryService().TransactionType(transactionType).
+					BeginTime(from).EndTime(to).Page(page).Rows(rows).Do(ctx)
+				if err != nil {
+					return 0, err
+				}
+				for _, item := range res.Data {
+					if item.Status == "Successful" || item.Status == "Finished" {
+						l.Add(FromFiat(item, deposit))
+					}
+				}
+				return len(res.Data), nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectSimpleEarn collect the rewards of the Simple Earn flexible and
+// locked products
+func (c *Collector) collectSimpleEarn(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const size = 100
+	rewardTypes := []binance.SimpleEarnRewardType{
+		binance.SimpleEarnRewardTypeBonus, binance.SimpleEarnRewardTypeRealTime, binance.SimpleEarnRewardTypeRewards,
+	}
+	for _, rewardType := range rewardTypes {
+		err := windows(ctx, startTime, endTime, 90*day, size, func(from, to int64) (int, error) {
+			return 0, pages(ctx, size, func(page int32) (int, error) {
+				res, err := c.spot.NewListSimpleEarnFlexibleRewardsRecordService().Type(rewardType).
+					StartTime(from).EndTime(to).Current(int64(page)).Size(size).Do(ctx)
+				if err != nil {
+					return 0, err
+				}
+				for _, r := range res.Rows {
+					l.Add(FromSimpleEarnFlexibleReward(r))
+				}
+				return len(res.Rows), nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return windows(ctx, startTime, endTime, 90*day, size, func(from, to int64) (int, error) {
+		return 0, pages(ctx, size, func(page int32) (int, error) {
+			res, err := c.spot.NewListSimpleEarnLockedRewardsRecordService().
+				StartTime(from).EndTime(to).Current(int64(page)).Size(size).Do(ctx)
+			if err != nil {
+				return 0, err
+			}
+			for _, r := range res.Rows {
+				l.Add(FromSimpleEarnLockedReward(r))
+			}
+			return len(res.Rows), nil
+		})
+	})
+}
+
+func (c *Collector) collectInterest(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const size = 100
+	for _, lendingType := range []binance.LendingType{binance.LendingTypeFlexible, binance.LendingTypeFixed, binance.LendingTypeActivity} {
+		err := windows(ctx, startTime, endTime, 30*day, size, func(from, to int64) (int, error) {
+			return 0, pages(ctx, size, func(page int32) (int, error) {
+				res, err := c.spot.NewInterestHistoryService().LendingType(lendingType).StartTime(from).EndTime(to).
+					Current(page).Size(size).Do(ctx)
+				if err != nil {
+					return 0, err
+				}
+				for _, i := range *res {
+					l.Add(FromInterest(i))
+				}
+				return len(*res), nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectFuturesIncome(ctx context.Context, l *Ledger, startTime, endTime int64) error {
+	const limit = 1000
+	return windows(ctx, startTime, endTime, 7*day, limit, func(from, to int64) (int, error) {
+		incomes, err := c.futures.NewGetIncomeHistoryService().StartTime(from).EndTime(to).Limit(limit).Do(ctx)
+		for _, i := range incomes {
+			l.Add(FromFuturesIncome(i))
+		}
+		return len(incomes), err
+	})
+}
@@ -0,0 +1,280 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format define a CSV export format
+type Format string
+
+// Format of the CSV export
+const (
+	// FormatLines write one row per line: ID, time, kind, info, account, asset and amount
+	FormatLines Format = "lines"
+	// FormatKoinly write the Koinly universal format
+	FormatKoinly Format = "koinly"
+	// FormatCoinTracking write the CoinTracking CSV import format
+	FormatCoinTracking Format = "cointracking"
+	// FormatCoinTracker write the CoinTracker CSV import format
+	FormatCoinTracker Format = "cointracker"
+)
+
+// Amount define an amount of an asset
+type Amount struct {
+	Asset  string
+	Amount float64
+}
+
+// Summary define a transaction seen from the user: the amounts sent and
+// received by the own accounts, without the fees, and the fees. A transfer
+// between the wallets has neither. A transfer between the bank account and a
+// wallet is seen from the wallet, like a deposit or a withdrawal.
+type Summary struct {
+	Sent     []Amount
+	Received []Amount
+	Fees     []Amount
+}
+
+// Summarize return the summary of the transaction
+func (t *Transaction) Summarize() Summary {
+	wallets := make(map[string]float64)
+	bank := make(map[string]float64)
+	for _, l := range t.Lines {
+		switch {
+		case l.Account == AccountBank:
+			bank[l.Asset] += l.Amount
+		case l.Account.Own():
+			wallets[l.Asset] += l.Amount
+		}
+	}
+	for asset, amount := range bank {
+		if _, ok := wallets[asset]; !ok {
+			wallets[asset] = amount
+		}
+	}
+	fees := t.Fees()
+
+	var s Summary
+	for _, asset := range sortedAssets(wallets, fees) {
+		change, fee := wallets[asset], fees[asset]
+		switch {
+		case change+fee < 0:
+			s.Sent = append(s.Sent, Amount{Asset: asset, Amount: -(change + fee)})
+		case change > 0:
+			s.Received = append(s.Received, Amount{Asset: asset, Amount: change})
+		}
+		if fee > 0 {
+			s.Fees = append(s.Fees, Amount{Asset: asset, Amount: fee})
+		}
+	}
+	return s
+}
+
+func sortedAssets(maps ...map[string]float64) []string {
+	seen := make(map[string]bool)
+	res := make([]string, 0)
+	for _, m := range maps {
+		for asset := range m {
+			if !seen[asset] {
+				seen[asset] = true
+				res = append(res, asset)
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// WriteCSV write the transactions sorted by time in a format. The tax tool
+// formats write one row per transaction, or one per sent or received asset
+// when there are several, and skip the transfers between the wallets.
+func (l *Ledger) WriteCSV(w io.Writer, format Format) error {
+	cw := csv.NewWriter(w)
+	var err error
+	switch format {
+	case FormatLines:
+		err = writeLines(cw, l.Transactions())
+	case FormatKoinly:
+		err = writeRows(cw, l.Transactions(), koinly)
+	case FormatCoinTracking:
+		err = writeRows(cw, l.Transactions(), coinTracking)
+	case FormatCoinTracker:
+		err = writeRows(cw, l.Transactions(), coinTracker)
+	default:
+		return fmt.Errorf("ledger: unknown format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeLines(cw *csv.Writer, transactions []*Transaction) error {
+	if err := cw.Write([]string{"ID", "Time", "Kind", "Info", "Account", "Asset", "Amount"}); err != nil {
+		return err
+	}
+	for _, t := range transactions {
+		for _, l := range t.Lines {
+			record := []string{t.ID, formatTime(t.Time, time.RFC3339), string(t.Kind), t.Info, string(l.Account), l.Asset, formatAmount(l.Amount)}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// taxRow define a row of a tax tool format, any amount may be empty
+type taxRow struct {
+	t        *Transaction
+	sent     Amount
+	received Amount
+	fee      Amount
+}
+
+// taxFormat define the header and the rows of a tax tool format
+type taxFormat struct {
+	header []string
+	record func(r taxRow) []string
+}
+
+func writeRows(cw *csv.Writer, transactions []*Transaction, format taxFormat) error {
+	if err := cw.Write(format.header); err != nil {
+		return err
+	}
+	for _, t := range transactions {
+		s := t.Summarize()
+		n := len(s.Sent)
+		if len(s.Received) > n {
+			n = len(s.Received)
+		}
+		if len(s.Fees) > n {
+			n = len(s.Fees)
+		}
+		for i := 0; i < n; i++ {
+			r := taxRow{t: t}
+			if i < len(s.Sent) {
+				r.sent = s.Sent[i]
+			}
+			if i < len(s.Received) {
+				r.received = s.Received[i]
+			}
+			if i < len(s.Fees) {
+				r.fee = s.Fees[i]
+			}
+			if err := cw.Write(format.record(r)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var koinly = taxFormat{
+	header: []string{"Date", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency",
+		"Fee Amount", "Fee Currency", "Net Worth Amount", "Net Worth Currency", "Label", "Description", "TxHash"},
+	record: func(r taxRow) []string {
+		var label string
+		switch r.t.Kind {
+		case KindDividend:
+			label = "reward"
+		case KindInterest:
+			label = "lending interest"
+		case KindRealizedPnL, KindFundingFee, KindFuturesIncome:
+			label = "realized gain"
+		case KindCommission:
+			label = "cost"
+			if r.received.Amount > 0 {
+				label = "fee refund"
+			}
+		}
+		return []string{formatTime(r.t.Time, "2006-01-02 15:04 UTC"),
+			formatAmount(r.sent.Amount), r.sent.Asset, formatAmount(r.received.Amount), r.received.Asset,
+			formatAmount(r.fee.Amount), r.fee.Asset, "", "", label, description(r.t), r.t.TxHash}
+	},
+}
+
+var coinTracking = taxFormat{
+	header: []string{"Type", "Buy Amount", "Buy Currency", "Sell Amount", "Sell Currency",
+		"Fee", "Fee Currency", "Exchange", "Trade-Group", "Comment", "Date", "Tx-ID"},
+	record: func(r taxRow) []string {
+		var kind string
+		switch {
+		case r.sent.Amount > 0 && r.received.Amount > 0:
+			kind = "Trade"
+		case r.t.Kind == KindDividend:
+			kind = "Reward / Bonus"
+		case r.t.Kind == KindInterest:
+			kind = "Interest Income"
+		case r.t.Kind == KindRealizedPnL || r.t.Kind == KindFundingFee || r.t.Kind == KindFuturesIncome:
+			kind = "Derivatives / Futures Profit"
+			if r.sent.Amount > 0 {
+				kind = "Derivatives / Futures Loss"
+			}
+		case r.received.Amount > 0:
+			kind = "Deposit"
+		case r.sent.Amount > 0:
+			kind = "Withdrawal"
+		default:
+			kind = "Other Fee"
+			r.sent, r.fee = r.fee, Amount{}
+		}
+		return []string{kind, formatAmount(r.received.Amount), r.received.Asset, formatAmount(r.sent.Amount), r.sent.Asset,
+			formatAmount(r.fee.Amount), r.fee.Asset, "Binance", "", description(r.t),
+			formatTime(r.t.Time, "2006-01-02 15:04:05"), r.t.TxHash}
+	},
+}
+
+var coinTracker = taxFormat{
+	header: []string{"Date", "Received Quantity", "Received Currency", "Sent Quantity", "Sent Currency",
+		"Fee Amount", "Fee Currency", "Tag"},
+	record: func(r taxRow) []string {
+		var tag string
+		switch r.t.Kind {
+		case KindDividend, KindRealizedPnL, KindFundingFee, KindFuturesIncome:
+			if r.received.Amount > 0 {
+				tag = "income"
+			}
+		case KindInterest:
+			tag = "interest"
+		case KindPay:
+			if r.sent.Amount > 0 {
+				tag = "payment"
+			}
+		}
+		return []string{formatTime(r.t.Time, "01/02/2006 15:04:05"),
+			formatAmount(r.received.Amount), r.received.Asset, formatAmount(r.sent.Amount), r.sent.Asset,
+			formatAmount(r.fee.Amount), r.fee.Asset, tag}
+	},
+}
+
+func description(t *Transaction) string {
+	if t.Info == "" {
+		return string(t.Kind)
+	}
+	return string(t.Kind) + " " + t.Info
+}
+
+func formatTime(ms int64, layout string) string {
+	return time.UnixMilli(ms).UTC().Format(layout)
+}
+
+// formatAmount format an amount without the float noise, empty when zero
+func formatAmount(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	s := strconv.FormatFloat(f, 'f', 10, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "" || s == "-" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,192 @@
+// Package ledger builds an accounting ledger of a Binance account from the
+// history services of the spot, funding, earn and USDT-M futures wallets.
+//
+// Each deposit, withdrawal, trade, conversion, payment, reward or futures
+// income becomes a Transaction made of double-entry lines: every asset
+// moved out of an account is moved into another one, so the lines of an
+// asset sum to zero. The wallets of the user are the own accounts, the
+// counterparties are AccountExternal, AccountMarket, AccountFees and
+// AccountIncome. The ledger can be exported as CSV for spreadsheets and tax
+// tools, see Format.
+package ledger
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Account define an account of the ledger
+type Account string
+
+// Kind define the kind of a transaction
+type Kind string
+
+// Account of the ledger
+const (
+	AccountSpot    Account = "spot"
+	AccountFunding Account = "funding"
+	AccountEarn    Account = "earn"
+	AccountFutures Account = "futures"
+	// AccountBank is the bank account of the user, which pays for the P2P
+	// purchases and receives the fiat withdrawals
+	AccountBank Account = "bank"
+
+	AccountExternal Account = "external" // other wallets and users
+	AccountMarket   Account = "market"   // counterparties of the trades and conversions
+	AccountFees     Account = "fees"
+	AccountIncome   Account = "income" // rewards, interest, funding and realized PnL
+
+	KindDeposit       Kind = "DEPOSIT"
+	KindWithdraw      Kind = "WITHDRAW"
+	KindTrade         Kind = "TRADE"
+	KindConvert       Kind = "CONVERT"
+	KindDust          Kind = "DUST"
+	KindC2C           Kind = "C2C"
+	KindPay           Kind = "PAY"
+	KindFiatDeposit   Kind = "FIAT_DEPOSIT"
+	KindFiatWithdraw  Kind = "FIAT_WITHDRAW"
+	KindDividend      Kind = "DIVIDEND"
+	KindInterest      Kind = "INTEREST"
+	KindRealizedPnL   Kind = "REALIZED_PNL"
+	KindFundingFee    Kind = "FUNDING_FEE"
+	KindCommission    Kind = "COMMISSION"
+	KindTransfer      Kind = "TRANSFER"
+	KindFuturesIncome Kind = "FUTURES_INCOME" // other futures incomes such as insurance clear or rebates
+)
+
+// Own return whether the account is a wallet or the bank account of the user
+func (a Account) Own() bool {
+	switch a {
+	case AccountExternal, AccountMarket, AccountFees, AccountIncome:
+		return false
+	}
+	return true
+}
+
+// Line define the change of an asset in an account, positive when credited
+type Line struct {
+	Account Account
+	Asset   string
+	Amount  float64
+}
+
+// Transaction define a set of balanced lines. ID is unique across the
+// sources, e.g. "deposit:<txId>".
+type Transaction struct {
+	ID     string
+	Time   int64
+	Kind   Kind
+	Info   string // symbol, network, order type or income info
+	TxHash string // on-chain id of the deposits and withdrawals
+	Lines  []Line
+}
+
+// add append a line and its counterpart
+func (t *Transaction) add(from, to Account, asset string, amount float64) {
+	if amount == 0 || asset == "" {
+		return
+	}
+	t.Lines = append(t.Lines, Line{Account: from, Asset: asset, Amount: -amount}, Line{Account: to, Asset: asset, Amount: amount})
+}
+
+// Balanced return whether the lines of each asset sum to zero
+func (t *Transaction) Balanced() bool {
+	sums := make(map[string]float64)
+	scale := make(map[string]float64)
+	for _, l := range t.Lines {
+		sums[l.Asset] += l.Amount
+		scale[l.Asset] += math.Abs(l.Amount)
+	}
+	for asset, sum := range sums {
+		if math.Abs(sum) > 1e-9*math.Max(1, scale[asset]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Fees return the fees paid by asset
+func (t *Transaction) Fees() map[string]float64 {
+	res := make(map[string]float64)
+	for _, l := range t.Lines {
+		if l.Account == AccountFees {
+			res[l.Asset] += l.Amount
+		}
+	}
+	return res
+}
+
+// Changes return the change of the own accounts by asset, fees included
+func (t *Transaction) Changes() map[string]float64 {
+	res := make(map[string]float64)
+	for _, l := range t.Lines {
+		if l.Account.Own() {
+			res[l.Asset] += l.Amount
+		}
+	}
+	return res
+}
+
+// Ledger hold the transactions by ID, adding a transaction twice keeps one,
+// so overlapping ranges and pages can be added freely
+type Ledger struct {
+	transactions map[string]*Transaction
+}
+
+// New init an empty ledger
+func New() *Ledger {
+	return &Ledger{transactions: make(map[string]*Transaction)}
+}
+
+// Add add transactions, replacing the ones with the same ID
+func (l *Ledger) Add(transactions ...*Transaction) {
+	for _, t := range transactions {
+		l.transactions[t.ID] = t
+	}
+}
+
+// Merge add the transactions of another ledger
+func (l *Ledger) Merge(other *Ledger) {
+	for _, t := range other.transactions {
+		l.Add(t)
+	}
+}
+
+// Len return the number of transactions
+func (l *Ledger) Len() int {
+	return len(l.transactions)
+}
+
+// Transactions return the transactions sorted by time then ID
+func (l *Ledger) Transactions() []*Transaction {
+	res := make([]*Transaction, 0, len(l.transactions))
+	for _, t := range l.transactions {
+		res = append(res, t)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Time != res[j].Time {
+			return res[i].Time < res[j].Time
+		}
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// Balances return the sum of the lines of an account by asset
+func (l *Ledger) Balances(account Account) map[string]float64 {
+	res := make(map[string]float64)
+	for _, t := range l.transactions {
+		for _, line := range t.Lines {
+			if line.Account == account {
+				res[line.Asset] += line.Amount
+			}
+		}
+	}
+	return res
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,225 @@
+package ledger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/gobinance/ledger"
+)
+
+func TestTransactions(t *testing.T) {
+	transactions := []*ledger.Transaction{
+		ledger.FromDeposit(&binance.Deposit{Coin: "USDT", Amount: "1000", TxID: "0xa", InsertTime: 1000}),
+		ledger.FromTrade(&binance.TradeV3{ID: 1, Symbol: "BTCUSDT", Quantity: "0.01", QuoteQuantity: "500",
+			Commission: "0.00001", CommissionAsset: "BTC", IsBuyer: true, Time: 2000}, "BTC", "USDT"),
+		ledger.FromFuturesIncome(&futures.IncomeHistory{Asset: "USDT", Income: "200", IncomeType: "TRANSFER", TranID: 1, Time: 3000}),
+		ledger.FromFuturesIncome(&futures.IncomeHistory{Asset: "USDT", Income: "-0.5", IncomeType: "COMMISSION", Symbol: "ETHUSDT", TranID: 2, Time: 4000}),
+		ledger.FromWithdraw(&binance.Withdraw{ID: "w1", Coin: "USDT", Amount: "100", TransactionFee: "1", ApplyTime: "1970-01-01 00:00:05", TxID: "0xb"}),
+		ledger.FromDust(binance.UserAssetDribbletDetail{TransID: 7, FromAsset: "DOGE", Amount: "3", TransferedAmount: "0.0099", ServiceChargeAmount: "0.0001", OperateTime: 6000}),
+	}
+	l := ledger.New()
+	l.Add(transactions...)
+	l.Add(transactions[0])
+	if l.Len() != len(transactions) {
+		t.Fatalf("unexpected transactions %d", l.Len())
+	}
+	for _, tr := range transactions {
+		if !tr.Balanced() {
+			t.Fatalf("unbalanced transaction %+v", tr)
+		}
+	}
+	spot := l.Balances(ledger.AccountSpot)
+	if spot["USDT"] != 199 || spot["BTC"] != 0.00999 || spot["BNB"] != 0.0099 {
+		t.Fatalf("unexpected spot balances %v", spot)
+	}
+	if fees := l.Balances(ledger.AccountFees); fees["USDT"] != 1.5 {
+		t.Fatalf("unexpected fees %v", fees)
+	}
+
+	var buf bytes.Buffer
+	if err := l.WriteCSV(&buf, ledger.FormatKoinly); err != nil {
+		t.Fatal(err)
+	}
+	rows := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := []string{
+		"Date,Sent Amount,Sent Currency,Received Amount,Received Currency,Fee Amount,Fee Currency,Net Worth Amount,Net Worth Currency,Label,Description,TxHash",
+		"1970-01-01 00:00 UTC,,,1000,USDT,,,,,,DEPOSIT,0xa",
+		"1970-01-01 00:00 UTC,500,USDT,0.00999,BTC,0.00001,BTC,,,,TRADE BTCUSDT,",
+		"1970-01-01 00:00 UTC,,,,,0.5,USDT,,,cost,COMMISSION ETHUSDT,",
+		"1970-01-01 00:00 UTC,100,USDT,,,1,USDT,,,,WITHDRAW,0xb",
+		"1970-01-01 00:00 UTC,3,DOGE,0.0099,BNB,0.0001,BNB,,,,DUST,",
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("unexpected rows\n%s", buf.String())
+	}
+	for i := range rows {
+		if rows[i] != expected[i] {
+			t.Fatalf("unexpected row %d\n%s\n%s", i, rows[i], expected[i])
+		}
+	}
+}
+
+func TestCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sapi/v1/capital/deposit/hisrec":
+			fmt.Fprint(w, `[{"amount":"1","coin":"BTC","status":1,"txId":"0xa","insertTime":1000},
+				{"amount":"2","coin":"BTC","status":0,"txId":"0xb","insertTime":2000}]`)
+		case "/sapi/v1/capital/withdraw/history":
+			fmt.Fprint(w, `[{"id":"w1","amount":"0.5","transactionFee":"0.0005","coin":"BTC","status":6,"applyTime":"1970-01-01 00:00:03"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := binance.NewClient("", "")
+	client.BaseURL = server.URL
+	collector := ledger.NewCollector(client, nil)
+	collector.Sources = []ledger.Source{ledger.SourceDeposits, ledger.SourceWithdraws}
+	// two windows of 90 days
+	l, err := collector.Collect(context.Background(), 0, 100*24*3600*1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Len() != 2 {
+		t.Fatalf("unexpected transactions %d", l.Len())
+	}
+	if spot := l.Balances(ledger.AccountSpot); spot["BTC"] != 0.4995 {
+		t.Fatalf("unexpected spot balances %v", spot)
+	}
+}
+
+func TestCollectorDefaultSources(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/sapi/v1/capital/deposit/hisrec", "/sapi/v1/capital/withdraw/history":
+			fmt.Fprint(w, `[]`)
+		case "/sapi/v1/asset/dribblet", "/sapi/v1/asset/assetDividend", "/sapi/v1/convert/tradeFlow",
+			"/sapi/v1/c2c/orderMatch/listUserOrderHistory", "/sapi/v1/pay/transactions", "/sapi/v1/fiat/orders":
+			fmt.Fprint(w, `{}`)
+		case "/sapi/v1/simple-earn/flexible/history/rewardsRecord":
+			if r.URL.Query().Get("type") == "REALTIME" {
+				fmt.Fprint(w, `{"rows":[{"asset":"USDT","rewards":"0.5","projectId":"USDT001","type":"REALTIME","time":1000}],"total":1}`)
+			} else {
+				fmt.Fprint(w, `{"rows":[],"total":0}`)
+			}
+		case "/sapi/v1/simple-earn/locked/history/rewardsRecord":
+			fmt.Fprint(w, `{"rows":[{"positionId":"7","time":2000,"asset":"BNB","lockPeriod":"30","amount":"0.01"}],"total":1}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := binance.NewClient("", "")
+	client.BaseURL = server.URL
+	// no symbols and no futures client: the trades and futures income are skipped
+	l, err := ledger.NewCollector(client, nil).Collect(context.Background(), 0, 24*3600*1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		if strings.Contains(path, "lending") {
+			t.Fatalf("unexpected request %s", path)
+		}
+	}
+	if l.Len() != 2 {
+		t.Fatalf("unexpected transactions %d", l.Len())
+	}
+	if earn := l.Balances(ledger.AccountEarn); earn["USDT"] != 0.5 || earn["BNB"] != 0.01 {
+		t.Fatalf("unexpected earn balances %v", earn)
+	}
+}
+
+func TestCollectorTrades(t *testing.T) {
+	const day = 24 * 3600 * 1000
+	trades := []binance.TradeV3{
+		{ID: 1, Time: day / 2},
+		{ID: 2, Time: 3*day + day/2},
+		{ID: 3, Time: 3*day + day/2 + 1},
+		{ID: 4, Time: 3*day + 3*day/4},
+		{ID: 5, Time: 5 * day},
+	}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/exchangeInfo":
+			fmt.Fprint(w, `{"symbols":[{"symbol":"BTCUSDT","baseAsset":"BTC","quoteAsset":"USDT"}]}`)
+		case "/api/v3/myTrades":
+			requests++
+			if requests == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"code":-1003,"msg":"Too many requests."}`)
+				return
+			}
+			q := r.URL.Query()
+			param := func(name string) int64 {
+				v, _ := strconv.ParseInt(q.Get(name), 10, 64)
+				return v
+			}
+			var res []binance.TradeV3
+			switch {
+			case q.Has("fromId"):
+				for _, trade := range trades {
+					if trade.ID >= param("fromId") && int64(len(res)) < param("limit") {
+						res = append(res, trade)
+					}
+				}
+			case q.Has("startTime"):
+				if param("endTime")-param("startTime") >= day {
+					t.Errorf("unexpected range %s", r.URL.RawQuery)
+				}
+				for _, trade := range trades {
+					if trade.Time >= param("startTime") && trade.Time <= param("endTime") && int64(len(res)) < param("limit") {
+						res = append(res, trade)
+					}
+				}
+			default:
+				// the most recent trades
+				res = trades[len(trades)-int(param("limit")):]
+			}
+			for i := range res {
+				res[i].Symbol, res[i].Price, res[i].Quantity, res[i].QuoteQuantity = "BTCUSDT", "100", "1", "100"
+				res[i].IsBuyer = true
+			}
+			json.NewEncoder(w).Encode(res)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := binance.NewClient("", "")
+	client.BaseURL = server.URL
+	collector := ledger.NewCollector(client, nil, "BTCUSDT")
+	collector.Sources = []ledger.Source{ledger.SourceTrades}
+	l, err := collector.Collect(context.Background(), 2*day, 4*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Len() != 3 {
+		t.Fatalf("unexpected transactions %d", l.Len())
+	}
+	// a rate limited request, the first day, the last trade, two ids searched
+	// for the first trade, then one page
+	if requests != 6 {
+		t.Fatalf("unexpected requests %d", requests)
+	}
+	if spot := l.Balances(ledger.AccountSpot); spot["BTC"] != 3 || spot["USDT"] != -300 {
+		t.Fatalf("unexpected spot balances %v", spot)
+	}
+}
@@ -0,0 +1,226 @@
+package ledger
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+const withdrawTimeLayout = "2006-01-02 15:04:05"
+
+// FromDeposit return the transaction of a deposit
+func FromDeposit(d *binance.Deposit) *Transaction {
+	t := &Transaction{
+		ID:     "deposit:" + d.Coin + ":" + d.TxID,
+		Time:   d.InsertTime,
+		Kind:   KindDeposit,
+		Info:   d.Network,
+		TxHash: d.TxID,
+	}
+	t.add(AccountExternal, AccountSpot, d.Coin, parseFloat(d.Amount))
+	return t
+}
+
+// FromWithdraw return the transaction of a withdrawal, the fee is paid on
+// top of the amount
+func FromWithdraw(w *binance.Withdraw) *Transaction {
+	applyTime, _ := time.ParseInLocation(withdrawTimeLayout, w.ApplyTime, time.UTC)
+	t := &Transaction{
+		ID:     "withdraw:" + w.ID,
+		Time:   applyTime.UnixMilli(),
+		Kind:   KindWithdraw,
+		Info:   w.Network,
+		TxHash: w.TxID,
+	}
+	t.add(AccountSpot, AccountExternal, w.Coin, parseFloat(w.Amount))
+	t.add(AccountSpot, AccountFees, w.Coin, parseFloat(w.TransactionFee))
+	return t
+}
+
+// FromTrade return the transaction of a spot trade of base against quote
+func FromTrade(trade *binance.TradeV3, base, quote string) *Transaction {
+	t := &Transaction{
+		ID:   "trade:" + trade.Symbol + ":" + strconv.FormatInt(trade.ID, 10),
+		Time: trade.Time,
+		Kind: KindTrade,
+		Info: trade.Symbol,
+	}
+	qty, quoteQty := parseFloat(trade.Quantity), parseFloat(trade.QuoteQuantity)
+	if trade.IsBuyer {
+		t.add(AccountMarket, AccountSpot, base, qty)
+		t.add(AccountSpot, AccountMarket, quote, quoteQty)
+	} else {
+		t.add(AccountSpot, AccountMarket, base, qty)
+		t.add(AccountMarket, AccountSpot, quote, quoteQty)
+	}
+	t.add(AccountSpot, AccountFees, trade.CommissionAsset, parseFloat(trade.Commission))
+	return t
+}
+
+// FromDust return the transaction of an asset converted to BNB as dust
+func FromDust(d binance.UserAssetDribbletDetail) *Transaction {
+	t := &Transaction{
+		ID:   "dust:" + strconv.Itoa(d.TransID) + ":" + d.FromAsset,
+		Time: d.OperateTime,
+		Kind: KindDust,
+	}
+	charge := parseFloat(d.ServiceChargeAmount)
+	t.add(AccountSpot, AccountMarket, d.FromAsset, parseFloat(d.Amount))
+	t.add(AccountMarket, AccountSpot, "BNB", parseFloat(d.TransferedAmount)+charge)
+	t.add(AccountSpot, AccountFees, "BNB", charge)
+	return t
+}
+
+// FromDividend return the transaction of an asset dividend such as an airdrop
+// or a distribution
+func FromDividend(d binance.DividendResponse) *Transaction {
+	t := &Transaction{
+		ID:   "dividend:" + strconv.FormatInt(d.ID, 10),
+		Time: d.Time,
+		Kind: KindDividend,
+		Info: d.Info,
+	}
+	t.add(AccountIncome, AccountSpot, d.Asset, parseFloat(d.Amount))
+	return t
+}
+
+// FromConvert return the transaction of a conversion
+func FromConvert(c binance.ConvertTradeHistoryItem) *Transaction {
+	t := &Transaction{
+		ID:   "convert:" + strconv.FormatInt(c.OrderId, 10),
+		Time: c.CreateTime,
+		Kind: KindConvert,
+	}
+	t.add(AccountSpot, AccountMarket, c.FromAsset, parseFloat(c.FromAmount))
+	t.add(AccountMarket, AccountSpot, c.ToAsset, parseFloat(c.ToAmount))
+	return t
+}
+
+// FromC2C return the transaction of a P2P trade, the fiat is paid from or
+// to the bank account
+func FromC2C(r binance.C2CRecord) *Transaction {
+	t := &Transaction{
+		ID:   "c2c:" + r.OrderNumber,
+		Time: r.CreateTime,
+		Kind: KindC2C,
+		Info: r.Asset + "/" + r.Fiat,
+	}
+	amount, total := parseFloat(r.Amount), parseFloat(r.TotalPrice)
+	if strings.EqualFold(r.TradeType, string(binance.SideTypeBuy)) {
+		t.add(AccountMarket, AccountFunding, r.Asset, amount)
+		t.add(AccountBank, AccountMarket, r.Fiat, total)
+	} else {
+		t.add(AccountFunding, AccountMarket, r.Asset, amount)
+		t.add(AccountMarket, AccountBank, r.Fiat, total)
+	}
+	t.add(AccountFunding, AccountFees, r.Asset, parseFloat(r.Commission))
+	return t
+}
+
+// FromPay return the transaction of a Binance Pay payment, the amount is
+// negative when paid
+func FromPay(p binance.PayTradeItem) *Transaction {
+	t := &Transaction{
+		ID:   "pay:" + p.TransactionID,
+		Time: p.TransactionTime,
+		Kind: KindPay,
+		Info: p.OrderType,
+	}
+	t.add(AccountExternal, AccountFunding, p.Currency, parseFloat(p.Amount))
+	return t
+}
+
+// FromFiat return the transaction of a fiat deposit or withdrawal
+func FromFiat(f binance.FiatDepositWithdrawHistoryItem, deposit bool) *Transaction {
+	t := &Transaction{
+		ID:   "fiat:" + f.OrderNo,
+		Time: f.CreateTime,
+		Kind: KindFiatWithdraw,
+		Info: f.Method,
+	}
+	amount, fee := parseFloat(f.Amount), parseFloat(f.TotalFee)
+	if deposit {
+		t.Kind = KindFiatDeposit
+		t.add(AccountBank, AccountSpot, f.FiatCurrency, amount)
+		t.add(AccountBank, AccountFees, f.FiatCurrency, fee)
+	} else {
+		t.add(AccountSpot, AccountBank, f.FiatCurrency, amount)
+		t.add(AccountSpot, AccountFees, f.FiatCurrency, fee)
+	}
+	return t
+}
+
+// FromSimpleEarnFlexibleReward return the transaction of a Simple Earn
+// flexible reward
+func FromSimpleEarnFlexibleReward(r *binance.SimpleEarnFlexibleRewardsRecord) *Transaction {
+	t := &Transaction{
+		ID:   "earn:flexible:" + r.Type + ":" + r.ProjectId + ":" + r.Asset + ":" + strconv.FormatInt(r.Time, 10),
+		Time: r.Time,
+		Kind: KindInterest,
+		Info: r.ProjectId,
+	}
+	t.add(AccountIncome, AccountEarn, r.Asset, parseFloat(r.Rewards))
+	return t
+}
+
+// FromSimpleEarnLockedReward return the transaction of a Simple Earn locked
+// reward
+func FromSimpleEarnLockedReward(r *binance.SimpleEarnLockedRewardsRecord) *Transaction {
+	t := &Transaction{
+		ID:   "earn:locked:" + r.PositionId + ":" + r.Asset + ":" + strconv.FormatInt(r.Time, 10),
+		Time: r.Time,
+		Kind: KindInterest,
+		Info: r.PositionId,
+	}
+	t.add(AccountIncome, AccountEarn, r.Asset, parseFloat(r.Amount))
+	return t
+}
+
+// FromInterest return the transaction of a savings interest
+func FromInterest(i binance.InterestHistoryElement) *Transaction {
+	t := &Transaction{
+		ID:   "interest:" + string(i.LendingType) + ":" + i.ProductName + ":" + i.Asset + ":" + strconv.FormatInt(i.Time, 10),
+		Time: i.Time,
+		Kind: KindInterest,
+		Info: i.ProductName,
+	}
+	t.add(AccountIncome, AccountEarn, i.Asset, parseFloat(i.Interest))
+	return t
+}
+
+// FromFuturesIncome return the transaction of a USDT-M futures income, the
+// income is negative when paid. Transfers move the asset from or to the
+// spot wallet.
+func FromFuturesIncome(i *futures.IncomeHistory) *Transaction {
+	t := &Transaction{
+		ID:   "futures:" + i.IncomeType + ":" + i.Symbol + ":" + i.Asset + ":" + strconv.FormatInt(i.TranID, 10),
+		Time: i.Time,
+		Kind: KindFuturesIncome,
+		Info: i.Symbol,
+	}
+	if t.Info == "" {
+		t.Info = i.Info
+	}
+	income := parseFloat(i.Income)
+	switch i.IncomeType {
+	case "TRANSFER":
+		t.Kind = KindTransfer
+		t.add(AccountSpot, AccountFutures, i.Asset, income)
+	case "COMMISSION":
+		t.Kind = KindCommission
+		t.add(AccountFees, AccountFutures, i.Asset, income)
+	case "REALIZED_PNL":
+		t.Kind = KindRealizedPnL
+		t.add(AccountIncome, AccountFutures, i.Asset, income)
+	case "FUNDING_FEE":
+		t.Kind = KindFundingFee
+		t.add(AccountIncome, AccountFutures, i.Asset, income)
+	default:
+		t.Info = i.IncomeType
+		t.add(AccountIncome, AccountFutures, i.Asset, income)
+	}
+	return t
+}
@@ -13,9 +13,9 @@ type GetAllLiquidityPoolService struct {
 // Do send request
 func (s *GetAllLiquidityPoolService) Do(ctx context.Context, opts ...RequestOption) ([]*LiquidityPool, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/pools",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/pools",
+		SecType:  secTypeAPIKey,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -63,12 +63,12 @@ type PoolShareInformation struct {
 // Do sends the request.
 func (s *GetLiquidityPoolDetailService) Do(ctx context.Context) ([]*LiquidityPoolDetail, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/liquidity",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/liquidity",
+		SecType:  secTypeSigned,
 	}
 	if s.poolId != nil {
-		r.setParam("poolId", *s.poolId)
+		r.SetParam("poolId", *s.poolId)
 	}
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -129,15 +129,15 @@ type AddLiquidityPreviewResponse struct {
 // Do sends the request.
 func (s *AddLiquidityPreviewService) Do(ctx context.Context) (*AddLiquidityPreviewResponse, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/addLiquidityPreview",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/addLiquidityPreview",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("poolId", *s.poolId)
-	r.setParam("type", *s.operationType)
-	r.setParam("quoteAsset", *s.quoteAsset)
-	r.setParam("quoteQty", *s.quoteQty)
+	r.SetParam("poolId", *s.poolId)
+	r.SetParam("type", *s.operationType)
+	r.SetParam("quoteAsset", *s.quoteAsset)
+	r.SetParam("quoteQty", *s.quoteQty)
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -190,14 +190,14 @@ type GetSwapQuoteResponse struct {
 // Do sends the request.
 func (s *GetSwapQuoteService) Do(ctx context.Context) (*GetSwapQuoteResponse, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/quote",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/quote",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("quoteAsset", *s.quoteAsset)
-	r.setParam("baseAsset", *s.baseAsset)
-	r.setParam("quoteQty", *s.quoteQty)
+	r.SetParam("quoteAsset", *s.quoteAsset)
+	r.SetParam("baseAsset", *s.baseAsset)
+	r.SetParam("quoteQty", *s.quoteQty)
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -244,14 +244,14 @@ type SwapResponse struct {
 // Do sends the request.
 func (s *SwapService) Do(ctx context.Context) (*SwapResponse, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/bswap/swap",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/bswap/swap",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("quoteAsset", *s.quoteAsset)
-	r.setParam("baseAsset", *s.baseAsset)
-	r.setParam("quoteQty", *s.quoteQty)
+	r.SetParam("quoteAsset", *s.quoteAsset)
+	r.SetParam("baseAsset", *s.baseAsset)
+	r.SetParam("quoteQty", *s.quoteQty)
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -333,31 +333,31 @@ func (s *GetUserSwapRecordsService) ResultSize(resultSize int64) *GetUserSwapRec
 // Do sends the request.
 func (s *GetUserSwapRecordsService) Do(ctx context.Context) ([]*SwapRecord, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/swap",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/swap",
+		SecType:  secTypeSigned,
 	}
 
 	if s.swapId != nil {
-		r.setParam("swapId", *s.swapId)
+		r.SetParam("swapId", *s.swapId)
 	}
 	if s.quoteAsset != nil {
-		r.setParam("quoteAsset", *s.quoteAsset)
+		r.SetParam("quoteAsset", *s.quoteAsset)
 	}
 	if s.baseAsset != nil {
-		r.setParam("baseAsset", *s.baseAsset)
+		r.SetParam("baseAsset", *s.baseAsset)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.status != nil {
-		r.setParam("status", *s.status)
+		r.SetParam("status", *s.status)
 	}
 	if s.resultSize != nil {
-		r.setParam("limit", *s.resultSize)
+		r.SetParam("limit", *s.resultSize)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
@@ -412,15 +412,15 @@ type AddLiquidityResponse struct {
 // Do sends the request.
 func (s *AddLiquidityService) Do(ctx context.Context) (*AddLiquidityResponse, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/bswap/liquidityAdd",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/bswap/liquidityAdd",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("poolId", *s.poolId)
-	r.setParam("type", *s.operationType)
-	r.setParam("asset", *s.quoteAsset)
-	r.setParam("quantity", *s.quoteQty)
+	r.SetParam("poolId", *s.poolId)
+	r.SetParam("type", *s.operationType)
+	r.SetParam("asset", *s.quoteAsset)
+	r.SetParam("quantity", *s.quoteQty)
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -474,17 +474,17 @@ type RemoveLiquidityResponse struct {
 // Do sends the request.
 func (s *RemoveLiquidityService) Do(ctx context.Context) (*RemoveLiquidityResponse, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/bswap/liquidityRemove",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/bswap/liquidityRemove",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("poolId", *s.poolId)
-	r.setParam("type", *s.operationType)
+	r.SetParam("poolId", *s.poolId)
+	r.SetParam("type", *s.operationType)
 	if len(s.assets) > 0 {
-		r.setParam("asset", s.assets)
+		r.SetParam("asset", s.assets)
 	}
-	r.setParam("shareAmount", *s.shareAmount)
+	r.SetParam("shareAmount", *s.shareAmount)
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
@@ -517,12 +517,12 @@ type ClaimRewardResponse struct {
 // Do sends the request.
 func (s *ClaimRewardService) Do(ctx context.Context) (*ClaimRewardResponse, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/bswap/claimRewards",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/bswap/claimRewards",
+		SecType:  secTypeSigned,
 	}
 	if s.rewardType != nil {
-		r.setParam("type", *s.rewardType)
+		r.SetParam("type", *s.rewardType)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
@@ -596,27 +596,27 @@ type ClaimedRewardHistory struct {
 // Do sends the request.
 func (s *QueryClaimedRewardHistoryService) Do(ctx context.Context) ([]*ClaimedRewardHistory, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/bswap/claimedHistory",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/bswap/claimedHistory",
+		SecType:  secTypeSigned,
 	}
 	if s.rewardType != nil {
-		r.setParam("type", *s.rewardType)
+		r.SetParam("type", *s.rewardType)
 	}
 	if s.poolId != nil {
-		r.setParam("poolId", *s.poolId)
+		r.SetParam("poolId", *s.poolId)
 	}
 	if s.assetRewards != nil {
-		r.setParam("assetRewards", *s.assetRewards)
+		r.SetParam("assetRewards", *s.assetRewards)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.resultSize != nil {
-		r.setParam("limit", *s.resultSize)
+		r.SetParam("limit", *s.resultSize)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
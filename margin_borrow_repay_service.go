@@ -0,0 +1,200 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarginBorrowRepayService borrow or repay in the cross or isolated margin account
+type MarginBorrowRepayService struct {
+	c               *Client
+	asset           string
+	amount          string
+	isIsolated      bool
+	symbol          *string
+	borrowRepayType MarginBorrowRepayType
+}
+
+// Asset set asset
+func (s *MarginBorrowRepayService) Asset(asset string) *MarginBorrowRepayService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *MarginBorrowRepayService) Amount(amount string) *MarginBorrowRepayService {
+	s.amount = amount
+	return s
+}
+
+// IsIsolated set whether the isolated margin account is used, default false
+func (s *MarginBorrowRepayService) IsIsolated(isIsolated bool) *MarginBorrowRepayService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Symbol set isolated symbol
+func (s *MarginBorrowRepayService) Symbol(symbol string) *MarginBorrowRepayService {
+	s.symbol = &symbol
+	return s
+}
+
+// Type set type, BORROW or REPAY
+func (s *MarginBorrowRepayService) Type(borrowRepayType MarginBorrowRepayType) *MarginBorrowRepayService {
+	s.borrowRepayType = borrowRepayType
+	return s
+}
+
+// Do send request
+func (s *MarginBorrowRepayService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/borrow-repay",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"asset":  s.asset,
+		"amount": s.amount,
+		"type":   s.borrowRepayType,
+	})
+	if s.isIsolated {
+		r.SetFormParam("isIsolated", "TRUE")
+	} else {
+		r.SetFormParam("isIsolated", "FALSE")
+	}
+	if s.symbol != nil {
+		r.SetFormParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginBorrowRepayService list borrow or repay records
+type ListMarginBorrowRepayService struct {
+	c               *Client
+	borrowRepayType MarginBorrowRepayType
+	asset           *string
+	isolatedSymbol  *string
+	txID            *int64
+	startTime       *int64
+	endTime         *int64
+	current         *int64
+	size            *int64
+}
+
+// Type set type, BORROW or REPAY
+func (s *ListMarginBorrowRepayService) Type(borrowRepayType MarginBorrowRepayType) *ListMarginBorrowRepayService {
+	s.borrowRepayType = borrowRepayType
+	return s
+}
+
+// Asset set asset
+func (s *ListMarginBorrowRepayService) Asset(asset string) *ListMarginBorrowRepayService {
+	s.asset = &asset
+	return s
+}
+
+// IsolatedSymbol set isolatedSymbol
+func (s *ListMarginBorrowRepayService) IsolatedSymbol(isolatedSymbol string) *ListMarginBorrowRepayService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// TxID set txID
+func (s *ListMarginBorrowRepayService) TxID(txID int64) *ListMarginBorrowRepayService {
+	s.txID = &txID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginBorrowRepayService) StartTime(startTime int64) *ListMarginBorrowRepayService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginBorrowRepayService) EndTime(endTime int64) *ListMarginBorrowRepayService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListMarginBorrowRepayService) Current(current int64) *ListMarginBorrowRepayService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *ListMarginBorrowRepayService) Size(size int64) *ListMarginBorrowRepayService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListMarginBorrowRepayService) Do(ctx context.Context, opts ...RequestOption) (res *MarginBorrowRepayResponse, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/borrow-repay",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("type", s.borrowRepayType)
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.isolatedSymbol != nil {
+		r.SetParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.txID != nil {
+		r.SetParam("txId", *s.txID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginBorrowRepayResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginBorrowRepayResponse define borrow or repay records response
+type MarginBorrowRepayResponse struct {
+	Rows  []MarginBorrowRepay `json:"rows"`
+	Total int64               `json:"total"`
+}
+
+// MarginBorrowRepay define a borrow or repay record, Amount and Interest are
+// only set for repays
+type MarginBorrowRepay struct {
+	Type           MarginBorrowRepayType `json:"type"`
+	IsolatedSymbol string                `json:"isolatedSymbol"`
+	Amount         string                `json:"amount"`
+	Asset          string                `json:"asset"`
+	Interest       string                `json:"interest"`
+	Principal      string                `json:"principal"`
+	Status         string                `json:"status"`
+	Timestamp      int64                 `json:"timestamp"`
+	TxID           int64                 `json:"txId"`
+}
@@ -0,0 +1,163 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// IsolatedMarginTransferService transfer between the spot and an isolated margin account
+type IsolatedMarginTransferService struct {
+	c         *Client
+	asset     string
+	symbol    string
+	transFrom IsolatedMarginAccountType
+	transTo   IsolatedMarginAccountType
+	amount    string
+}
+
+// Asset set asset
+func (s *IsolatedMarginTransferService) Asset(asset string) *IsolatedMarginTransferService {
+	s.asset = asset
+	return s
+}
+
+// Symbol set isolated symbol
+func (s *IsolatedMarginTransferService) Symbol(symbol string) *IsolatedMarginTransferService {
+	s.symbol = symbol
+	return s
+}
+
+// TransFrom set transFrom
+func (s *IsolatedMarginTransferService) TransFrom(transFrom IsolatedMarginAccountType) *IsolatedMarginTransferService {
+	s.transFrom = transFrom
+	return s
+}
+
+// TransTo set transTo
+func (s *IsolatedMarginTransferService) TransTo(transTo IsolatedMarginAccountType) *IsolatedMarginTransferService {
+	s.transTo = transTo
+	return s
+}
+
+// Amount set amount
+func (s *IsolatedMarginTransferService) Amount(amount string) *IsolatedMarginTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *IsolatedMarginTransferService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/isolated/transfer",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"asset":     s.asset,
+		"symbol":    s.symbol,
+		"transFrom": s.transFrom,
+		"transTo":   s.transTo,
+		"amount":    s.amount,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// EnableIsolatedMarginAccountService enable an isolated margin account
+type EnableIsolatedMarginAccountService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *EnableIsolatedMarginAccountService) Symbol(symbol string) *EnableIsolatedMarginAccountService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *EnableIsolatedMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *IsolatedMarginAccountSwitchResponse, err error) {
+	return switchIsolatedMarginAccount(ctx, s.c, http.MethodPost, s.symbol, opts...)
+}
+
+// DisableIsolatedMarginAccountService disable an isolated margin account, it
+// can be enabled again after 24 hours
+type DisableIsolatedMarginAccountService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *DisableIsolatedMarginAccountService) Symbol(symbol string) *DisableIsolatedMarginAccountService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *DisableIsolatedMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *IsolatedMarginAccountSwitchResponse, err error) {
+	return switchIsolatedMarginAccount(ctx, s.c, http.MethodDelete, s.symbol, opts...)
+}
+
+// IsolatedMarginAccountSwitchResponse define enable or disable isolated margin account response
+type IsolatedMarginAccountSwitchResponse struct {
+	Success bool   `json:"success"`
+	Symbol  string `json:"symbol"`
+}
+
+func switchIsolatedMarginAccount(ctx context.Context, c *Client, method string, symbol string, opts ...RequestOption) (res *IsolatedMarginAccountSwitchResponse, err error) {
+	r := &request{
+		Method:   method,
+		Endpoint: "/sapi/v1/margin/isolated/account",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", symbol)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(IsolatedMarginAccountSwitchResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIsolatedMarginAccountLimitService get the number of enabled isolated
+// margin accounts and its limit
+type GetIsolatedMarginAccountLimitService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetIsolatedMarginAccountLimitService) Do(ctx context.Context, opts ...RequestOption) (res *IsolatedMarginAccountLimit, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/isolated/accountLimit",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(IsolatedMarginAccountLimit)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsolatedMarginAccountLimit define isolated margin account limit
+type IsolatedMarginAccountLimit struct {
+	EnabledAccount int `json:"enabledAccount"`
+	MaxAccount     int `json:"maxAccount"`
+}
@@ -0,0 +1,412 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarginOrderList define margin order list info
+type MarginOrderList struct {
+	OrderListID       int64             `json:"orderListId"`
+	ContingencyType   string            `json:"contingencyType"`
+	ListStatusType    string            `json:"listStatusType"`
+	ListOrderStatus   string            `json:"listOrderStatus"`
+	ListClientOrderID string            `json:"listClientOrderId"`
+	TransactionTime   int64             `json:"transactionTime"`
+	Symbol            string            `json:"symbol"`
+	IsIsolated        bool              `json:"isIsolated"`
+	Orders            []*MarginOCOOrder `json:"orders"`
+}
+
+// CreateMarginOrderListResponse define create margin order list response
+type CreateMarginOrderListResponse struct {
+	MarginOrderList
+	MarginBuyBorrowAmount string                  `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string                  `json:"marginBuyBorrowAsset"`
+	OrderReports          []*MarginOCOOrderReport `json:"orderReports"`
+}
+
+// marginOrderListParams set the parameters shared by the margin order lists
+func marginOrderListParams(m params, isIsolated *bool, listClientOrderID *string, newOrderRespType *NewOrderRespType, sideEffectType *SideEffectType) {
+	if isIsolated != nil {
+		if *isIsolated {
+			m["isIsolated"] = "TRUE"
+		} else {
+			m["isIsolated"] = "FALSE"
+		}
+	}
+	if listClientOrderID != nil {
+		m["listClientOrderId"] = *listClientOrderID
+	}
+	if newOrderRespType != nil {
+		m["newOrderRespType"] = *newOrderRespType
+	}
+	if sideEffectType != nil {
+		m["sideEffectType"] = *sideEffectType
+	}
+}
+
+func createMarginOrderList(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateMarginOrderListResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateMarginOrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateMarginOTOService create an OTO for a margin account
+type CreateMarginOTOService struct {
+	c                 *Client
+	symbol            string
+	isIsolated        *bool
+	listClientOrderID *string
+	working           *OrderListLeg
+	pending           *OrderListLeg
+	newOrderRespType  *NewOrderRespType
+	sideEffectType    *SideEffectType
+}
+
+// Symbol set symbol
+func (s *CreateMarginOTOService) Symbol(symbol string) *CreateMarginOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *CreateMarginOTOService) IsIsolated(isIsolated bool) *CreateMarginOTOService {
+	s.isIsolated = &isIsolated
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateMarginOTOService) ListClientOrderID(listClientOrderID string) *CreateMarginOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *CreateMarginOTOService) Working(leg *OrderListLeg) *CreateMarginOTOService {
+	s.working = leg
+	return s
+}
+
+// Pending set the pending leg
+func (s *CreateMarginOTOService) Pending(leg *OrderListLeg) *CreateMarginOTOService {
+	s.pending = leg
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateMarginOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *CreateMarginOTOService) SideEffectType(sideEffectType SideEffectType) *CreateMarginOTOService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// Do send request
+func (s *CreateMarginOTOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateMarginOrderListResponse, err error) {
+	m := params{
+		"symbol": s.symbol,
+	}
+	marginOrderListParams(m, s.isIsolated, s.listClientOrderID, s.newOrderRespType, s.sideEffectType)
+	s.working.setParams("working", m)
+	s.pending.setParams("pending", m)
+	return createMarginOrderList(ctx, s.c, "/sapi/v1/margin/order/oto", m, opts...)
+}
+
+// CreateMarginOTOCOService create an OTOCO for a margin account
+type CreateMarginOTOCOService struct {
+	c                 *Client
+	symbol            string
+	isIsolated        *bool
+	listClientOrderID *string
+	working           *OrderListLeg
+	pendingSide       SideType
+	pendingQuantity   string
+	pendingAbove      *OrderListLeg
+	pendingBelow      *OrderListLeg
+	newOrderRespType  *NewOrderRespType
+	sideEffectType    *SideEffectType
+}
+
+// Symbol set symbol
+func (s *CreateMarginOTOCOService) Symbol(symbol string) *CreateMarginOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *CreateMarginOTOCOService) IsIsolated(isIsolated bool) *CreateMarginOTOCOService {
+	s.isIsolated = &isIsolated
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateMarginOTOCOService) ListClientOrderID(listClientOrderID string) *CreateMarginOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *CreateMarginOTOCOService) Working(leg *OrderListLeg) *CreateMarginOTOCOService {
+	s.working = leg
+	return s
+}
+
+// PendingSide set the side of the pending OCO
+func (s *CreateMarginOTOCOService) PendingSide(side SideType) *CreateMarginOTOCOService {
+	s.pendingSide = side
+	return s
+}
+
+// PendingQuantity set the quantity of the pending OCO
+func (s *CreateMarginOTOCOService) PendingQuantity(quantity string) *CreateMarginOTOCOService {
+	s.pendingQuantity = quantity
+	return s
+}
+
+// PendingAbove set the above leg of the pending OCO
+func (s *CreateMarginOTOCOService) PendingAbove(leg *OrderListLeg) *CreateMarginOTOCOService {
+	s.pendingAbove = leg
+	return s
+}
+
+// PendingBelow set the below leg of the pending OCO
+func (s *CreateMarginOTOCOService) PendingBelow(leg *OrderListLeg) *CreateMarginOTOCOService {
+	s.pendingBelow = leg
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateMarginOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *CreateMarginOTOCOService) SideEffectType(sideEffectType SideEffectType) *CreateMarginOTOCOService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// Do send request
+func (s *CreateMarginOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateMarginOrderListResponse, err error) {
+	m := params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	marginOrderListParams(m, s.isIsolated, s.listClientOrderID, s.newOrderRespType, s.sideEffectType)
+	s.working.setParams("working", m)
+	s.pendingAbove.setParams("pendingAbove", m)
+	s.pendingBelow.setParams("pendingBelow", m)
+	return createMarginOrderList(ctx, s.c, "/sapi/v1/margin/order/otoco", m, opts...)
+}
+
+// GetMarginOrderListService get an order list of a margin account
+type GetMarginOrderListService struct {
+	c                 *Client
+	symbol            *string
+	isIsolated        bool
+	orderListID       *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *GetMarginOrderListService) Symbol(symbol string) *GetMarginOrderListService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *GetMarginOrderListService) IsIsolated(isIsolated bool) *GetMarginOrderListService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// OrderListID set orderListID
+func (s *GetMarginOrderListService) OrderListID(orderListID int64) *GetMarginOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetMarginOrderListService) OrigClientOrderID(origClientOrderID string) *GetMarginOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetMarginOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *MarginOrderList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/orderList",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetParam("isIsolated", "TRUE")
+	}
+	if s.orderListID != nil {
+		r.SetParam("orderListId", *s.orderListID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginOrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginOrderListsService list all order lists of a margin account
+type ListMarginOrderListsService struct {
+	c          *Client
+	symbol     *string
+	isIsolated bool
+	fromID     *int64
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *ListMarginOrderListsService) Symbol(symbol string) *ListMarginOrderListsService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *ListMarginOrderListsService) IsIsolated(isIsolated bool) *ListMarginOrderListsService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// FromID set fromID
+func (s *ListMarginOrderListsService) FromID(fromID int64) *ListMarginOrderListsService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginOrderListsService) StartTime(startTime int64) *ListMarginOrderListsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginOrderListsService) EndTime(endTime int64) *ListMarginOrderListsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListMarginOrderListsService) Limit(limit int) *ListMarginOrderListsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListMarginOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginOrderList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/allOrderList",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetParam("isIsolated", "TRUE")
+	}
+	if s.fromID != nil {
+		r.SetParam("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarginOrderList{}, err
+	}
+	res = make([]*MarginOrderList, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarginOrderList{}, err
+	}
+	return res, nil
+}
+
+// ListMarginOpenOrderListsService list open order lists of a margin account
+type ListMarginOpenOrderListsService struct {
+	c          *Client
+	symbol     *string
+	isIsolated bool
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *ListMarginOpenOrderListsService) Symbol(symbol string) *ListMarginOpenOrderListsService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *ListMarginOpenOrderListsService) IsIsolated(isIsolated bool) *ListMarginOpenOrderListsService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Do send request
+func (s *ListMarginOpenOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginOrderList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/openOrderList",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetParam("isIsolated", "TRUE")
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarginOrderList{}, err
+	}
+	res = make([]*MarginOrderList, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarginOrderList{}, err
+	}
+	return res, nil
+}
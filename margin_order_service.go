@@ -104,9 +104,9 @@ func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType)
 // Do send request
 func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/margin/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/order",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol": s.symbol,
@@ -147,7 +147,7 @@ func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption
 	if s.sideEffectType != nil {
 		m["sideEffectType"] = *s.sideEffectType
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	res = new(CreateOrderResponse)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -203,25 +203,25 @@ func (s *CancelMarginOrderService) NewClientOrderID(newClientOrderID string) *Ca
 // Do send request
 func (s *CancelMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMarginOrderResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/sapi/v1/margin/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/sapi/v1/margin/order",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setFormParam("orderId", *s.orderID)
+		r.SetFormParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setFormParam("origClientOrderId", *s.origClientOrderID)
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
 	}
 	if s.newClientOrderID != nil {
-		r.setFormParam("newClientOrderId", *s.newClientOrderID)
+		r.SetFormParam("newClientOrderId", *s.newClientOrderID)
 	}
 	if s.isIsolated != nil {
 		if *s.isIsolated {
-			r.setFormParam("isIsolated", "TRUE")
+			r.SetFormParam("isIsolated", "TRUE")
 		} else {
-			r.setFormParam("isIsolated", "FALSE")
+			r.SetFormParam("isIsolated", "FALSE")
 		}
 	}
 
@@ -273,19 +273,19 @@ func (s *GetMarginOrderService) OrigClientOrderID(origClientOrderID string) *Get
 // Do send request
 func (s *GetMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/order",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
 	}
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -322,15 +322,15 @@ func (s *ListMarginOpenOrdersService) IsIsolated(isIsolated bool) *ListMarginOpe
 // Do send request
 func (s *ListMarginOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/openOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/openOrders",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -395,25 +395,25 @@ func (s *ListMarginOrdersService) Limit(limit int) *ListMarginOrdersService {
 // Do send request
 func (s *ListMarginOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/allOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/allOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -557,9 +557,9 @@ func (s *CreateMarginOCOService) SideEffectType(sideEffectType SideEffectType) *
 
 func (s *CreateMarginOCOService) createOrder(ctx context.Context, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/margin/order/oco",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/order/oco",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol":    s.symbol,
@@ -603,7 +603,7 @@ func (s *CreateMarginOCOService) createOrder(ctx context.Context, opts ...Reques
 	if s.sideEffectType != nil {
 		m["sideEffectType"] = *s.sideEffectType
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -709,22 +709,22 @@ func (s *CancelMarginOCOService) NewClientOrderID(newClientOrderID string) *Canc
 // Do send request
 func (s *CancelMarginOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMarginOCOResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/sapi/v1/margin/orderList",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/sapi/v1/margin/orderList",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.listClientOrderID != "" {
-		r.setFormParam("listClientOrderId", s.listClientOrderID)
+		r.SetFormParam("listClientOrderId", s.listClientOrderID)
 	}
 	if s.isIsolated != nil {
-		r.setFormParam("isIsolated", *s.isIsolated)
+		r.SetFormParam("isIsolated", *s.isIsolated)
 	}
 	if s.orderListID != 0 {
-		r.setFormParam("orderListId", s.orderListID)
+		r.SetFormParam("orderListId", s.orderListID)
 	}
 	if s.newClientOrderID != "" {
-		r.setFormParam("newClientOrderId", s.newClientOrderID)
+		r.SetFormParam("newClientOrderId", s.newClientOrderID)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
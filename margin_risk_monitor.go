@@ -0,0 +1,450 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	marginRiskDefaultQuote                  = "USDT"
+	marginRiskDefaultPollInterval           = time.Minute
+	marginRiskDefaultIsolatedLiquidationBar = 1.1
+	isolatedMarginAccountMaxSymbols         = 5
+)
+
+// MarginBalance define the balance and liability of a margin asset
+type MarginBalance struct {
+	Asset    string
+	Free     float64
+	Locked   float64
+	Borrowed float64
+	Interest float64
+}
+
+// Total return the free and locked balance
+func (b *MarginBalance) Total() float64 {
+	return b.Free + b.Locked
+}
+
+// Liability return the borrowed amount and its interest
+func (b *MarginBalance) Liability() float64 {
+	return b.Borrowed + b.Interest
+}
+
+// MarginRisk define the risk of the cross margin account or of an isolated pair
+type MarginRisk struct {
+	Symbol         string  // isolated symbol, empty for the cross margin account
+	TotalAsset     float64 // in Quote for cross margin, in the quote asset of the pair for isolated margin
+	TotalLiability float64 // same unit as TotalAsset
+	MarginLevel    float64 // TotalAsset / TotalLiability, +Inf without liability
+	LiquidationBar float64 // margin level under which the account is liquidated
+	LevelDistance  float64 // (MarginLevel - LiquidationBar) / MarginLevel, 1 without liability
+
+	// isolated margin only
+	IndexPrice       float64 // index price of the pair
+	LiquidationPrice float64 // index price at which MarginLevel reaches LiquidationBar, 0 when none
+	PriceDistance    float64 // |IndexPrice - LiquidationPrice| / IndexPrice, 1 when there is no liquidation price
+
+	UpdateTime time.Time
+}
+
+// MarginAlertRule define when an alert is fired, a zero threshold is disabled.
+// The alert state is kept by rule value: changing any field of a rule makes
+// it a new rule, which fires again when breached.
+type MarginAlertRule struct {
+	Name          string
+	Symbol        string  // isolated symbol, the cross margin account when empty
+	MarginLevel   float64 // fire when the margin level falls under
+	PriceDistance float64 // fire when the distance to the liquidation price falls under, isolated margin only
+}
+
+func (r *MarginAlertRule) breached(risk *MarginRisk) bool {
+	if r.MarginLevel > 0 && risk.MarginLevel < r.MarginLevel {
+		return true
+	}
+	return r.PriceDistance > 0 && risk.Symbol != "" && risk.PriceDistance < r.PriceDistance
+}
+
+// MarginRiskAlert define an alert, Recovered is set when the risk is back
+// above the thresholds of the rule
+type MarginRiskAlert struct {
+	Rule      MarginAlertRule
+	Risk      MarginRisk
+	Recovered bool
+}
+
+// MarginRiskMonitor keep the balances, liabilities and margin levels of the
+// cross margin account and of isolated pairs up to date and fire alerts when
+// the thresholds of its rules are crossed.
+//
+// Run polls the accounts, the liquidation bars and the index prices. Between
+// polls the balances are updated from the user data streams: feed
+// HandleUserData with the events of the cross margin stream and
+// HandleIsolatedUserData with the events of each isolated margin stream.
+// Borrows and repays change liabilities which are not carried by the events,
+// they are picked up by the next poll.
+type MarginRiskMonitor struct {
+	Quote        string        // asset the cross margin account is valued in, USDT when empty
+	PollInterval time.Duration // poll interval of Run, a minute when zero
+	// IsolatedLiquidationBar is used when the bar of a pair cannot be derived
+	// from its liquidation price, 1.1 when zero
+	IsolatedLiquidationBar float64
+	// Rules must not be changed once the monitor is polled or fed with
+	// events, use SetRules then
+	Rules        []MarginAlertRule
+	AlertHandler func(alert *MarginRiskAlert)
+	ErrHandler   ErrHandler
+
+	c               *Client
+	mu              sync.Mutex
+	isolatedSymbols []string
+	cross           map[string]*MarginBalance
+	crossBar        float64
+	prices          map[string]float64 // asset price in Quote
+	isolated        map[string]*isolatedMarginPair
+	risks           map[string]*MarginRisk
+	breached        map[MarginAlertRule]bool
+}
+
+type isolatedMarginPair struct {
+	base       MarginBalance
+	quote      MarginBalance
+	bar        float64
+	indexPrice float64
+}
+
+// Poll refresh the balances, liabilities, liquidation bars and index prices
+// then evaluate the rules. The errors of the price indexes are joined and
+// returned after the evaluation, the assets without a price are left out.
+func (m *MarginRiskMonitor) Poll(ctx context.Context, opts ...RequestOption) error {
+	coeff, err := m.c.NewGetMarginTradeCoeffService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	account, err := m.c.NewGetMarginAccountService().Do(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	var assets []IsolatedMarginAsset
+	for i := 0; i < len(m.isolatedSymbols); i += isolatedMarginAccountMaxSymbols {
+		end := i + isolatedMarginAccountMaxSymbols
+		if end > len(m.isolatedSymbols) {
+			end = len(m.isolatedSymbols)
+		}
+		res, err := m.c.NewGetIsolatedMarginAccountService().Symbols(m.isolatedSymbols[i:end]...).Do(ctx, opts...)
+		if err != nil {
+			return err
+		}
+		assets = append(assets, res.Assets...)
+	}
+
+	cross := make(map[string]*MarginBalance, len(account.UserAssets))
+	for _, a := range account.UserAssets {
+		b := &MarginBalance{
+			Asset:    a.Asset,
+			Free:     parseFloat(a.Free),
+			Locked:   parseFloat(a.Locked),
+			Borrowed: parseFloat(a.Borrowed),
+			Interest: parseFloat(a.Interest),
+		}
+		if b.Total() != 0 || b.Liability() != 0 {
+			cross[a.Asset] = b
+		}
+	}
+	var errs []error
+	prices := make(map[string]float64, len(cross))
+	for asset := range cross {
+		if asset == m.quote() {
+			prices[asset] = 1
+			continue
+		}
+		res, err := m.c.NewGetMarginPriceIndexService().Symbol(asset+m.quote()).Do(ctx, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("price index %s%s: %w", asset, m.quote(), err))
+			continue
+		}
+		prices[asset] = parseFloat(res.Price)
+	}
+	isolated := make(map[string]*isolatedMarginPair, len(assets))
+	for _, a := range assets {
+		p := &isolatedMarginPair{
+			base:       isolatedMarginBalance(a.BaseAsset),
+			quote:      isolatedMarginBalance(a.QuoteAsset),
+			indexPrice: parseFloat(a.IndexPrice),
+		}
+		p.bar = p.impliedBar(parseFloat(a.LiquidatePrice))
+		isolated[a.Symbol] = p
+	}
+
+	m.mu.Lock()
+	m.cross = cross
+	m.crossBar = parseFloat(coeff.ForceLiquidationBar)
+	m.prices = prices
+	for symbol, p := range isolated {
+		if p.bar == 0 {
+			if old, ok := m.isolated[symbol]; ok {
+				p.bar = old.bar
+			}
+		}
+		m.isolated[symbol] = p
+	}
+	alerts := m.evaluate(time.Now())
+	m.mu.Unlock()
+	m.fire(alerts)
+	return errors.Join(errs...)
+}
+
+// Run poll on PollInterval until ctx is done, the errors are sent to
+// ErrHandler. The first poll is sent at once.
+func (m *MarginRiskMonitor) Run(ctx context.Context, opts ...RequestOption) error {
+	interval := m.PollInterval
+	if interval <= 0 {
+		interval = marginRiskDefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Poll(ctx, opts...); err != nil && ctx.Err() == nil && m.ErrHandler != nil {
+			m.ErrHandler(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// HandleUserData update the cross margin balances with an
+// outboundAccountPosition or balanceUpdate event of the cross margin stream
+func (m *MarginRiskMonitor) HandleUserData(event *WsUserDataEvent) {
+	m.mu.Lock()
+	switch event.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		for _, u := range event.AccountUpdate {
+			b, ok := m.cross[u.Asset]
+			if !ok {
+				b = &MarginBalance{Asset: u.Asset}
+				m.cross[u.Asset] = b
+			}
+			b.Free, b.Locked = parseFloat(u.Free), parseFloat(u.Locked)
+		}
+	case UserDataEventTypeBalanceUpdate:
+		b, ok := m.cross[event.BalanceUpdate.Asset]
+		if !ok {
+			b = &MarginBalance{Asset: event.BalanceUpdate.Asset}
+			m.cross[event.BalanceUpdate.Asset] = b
+		}
+		b.Free += parseFloat(event.BalanceUpdate.Change)
+	default:
+		m.mu.Unlock()
+		return
+	}
+	alerts := m.evaluate(time.Now())
+	m.mu.Unlock()
+	m.fire(alerts)
+}
+
+// HandleIsolatedUserData update the balances of an isolated pair with an
+// outboundAccountPosition or balanceUpdate event of its isolated margin stream
+func (m *MarginRiskMonitor) HandleIsolatedUserData(symbol string, event *WsUserDataEvent) {
+	m.mu.Lock()
+	p, ok := m.isolated[symbol]
+	if !ok {
+		// the assets of the pair are only known after a poll
+		m.mu.Unlock()
+		return
+	}
+	switch event.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		for _, u := range event.AccountUpdate {
+			if b := p.balance(u.Asset); b != nil {
+				b.Free, b.Locked = parseFloat(u.Free), parseFloat(u.Locked)
+			}
+		}
+	case UserDataEventTypeBalanceUpdate:
+		if b := p.balance(event.BalanceUpdate.Asset); b != nil {
+			b.Free += parseFloat(event.BalanceUpdate.Change)
+		}
+	default:
+		m.mu.Unlock()
+		return
+	}
+	alerts := m.evaluate(time.Now())
+	m.mu.Unlock()
+	m.fire(alerts)
+}
+
+// SetRules replace the rules, the rules which are kept keep their alert
+// state and are evaluated on the next poll or event
+func (m *MarginRiskMonitor) SetRules(rules ...MarginAlertRule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Rules = append([]MarginAlertRule(nil), rules...)
+}
+
+// Risk return the last risk of an isolated symbol, or of the cross margin
+// account when symbol is empty
+func (m *MarginRiskMonitor) Risk(symbol string) (MarginRisk, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	risk, ok := m.risks[symbol]
+	if !ok {
+		return MarginRisk{}, false
+	}
+	return *risk, true
+}
+
+// Balances return the balances of an isolated symbol, or of the cross margin
+// account when symbol is empty
+func (m *MarginRiskMonitor) Balances(symbol string) []MarginBalance {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if symbol != "" {
+		p, ok := m.isolated[symbol]
+		if !ok {
+			return nil
+		}
+		return []MarginBalance{p.base, p.quote}
+	}
+	res := make([]MarginBalance, 0, len(m.cross))
+	for _, b := range m.cross {
+		res = append(res, *b)
+	}
+	return res
+}
+
+func (m *MarginRiskMonitor) quote() string {
+	if m.Quote != "" {
+		return m.Quote
+	}
+	return marginRiskDefaultQuote
+}
+
+// evaluate compute the risks and return the alerts to fire, m.mu is held
+func (m *MarginRiskMonitor) evaluate(now time.Time) []*MarginRiskAlert {
+	cross := &MarginRisk{LiquidationBar: m.crossBar, UpdateTime: now}
+	for asset, b := range m.cross {
+		price, ok := m.prices[asset]
+		if !ok {
+			continue
+		}
+		cross.TotalAsset += b.Total() * price
+		cross.TotalLiability += b.Liability() * price
+	}
+	cross.setLevel()
+	m.risks[""] = cross
+
+	for symbol, p := range m.isolated {
+		bar := p.bar
+		if bar == 0 {
+			bar = m.IsolatedLiquidationBar
+		}
+		if bar == 0 {
+			bar = marginRiskDefaultIsolatedLiquidationBar
+		}
+		risk := &MarginRisk{
+			Symbol:         symbol,
+			TotalAsset:     p.base.Total()*p.indexPrice + p.quote.Total(),
+			TotalLiability: p.base.Liability()*p.indexPrice + p.quote.Liability(),
+			LiquidationBar: bar,
+			IndexPrice:     p.indexPrice,
+			PriceDistance:  1,
+			UpdateTime:     now,
+		}
+		risk.setLevel()
+		// solve (Ba*x + Qa) / (Bl*x + Ql) = bar for the index price x
+		den := p.base.Total() - bar*p.base.Liability()
+		if den != 0 && p.indexPrice > 0 && risk.TotalLiability > 0 {
+			x := (bar*p.quote.Liability() - p.quote.Total()) / den
+			if x > 0 {
+				risk.LiquidationPrice = x
+				risk.PriceDistance = math.Abs(p.indexPrice-x) / p.indexPrice
+			}
+		}
+		m.risks[symbol] = risk
+	}
+
+	var alerts []*MarginRiskAlert
+	states := make(map[MarginAlertRule]bool, len(m.Rules))
+	for _, rule := range m.Rules {
+		if _, ok := states[rule]; ok {
+			continue
+		}
+		risk, ok := m.risks[rule.Symbol]
+		if !ok {
+			states[rule] = m.breached[rule]
+			continue
+		}
+		breached := rule.breached(risk)
+		states[rule] = breached
+		if breached != m.breached[rule] {
+			alerts = append(alerts, &MarginRiskAlert{Rule: rule, Risk: *risk, Recovered: !breached})
+		}
+	}
+	// the state of the removed rules is dropped
+	m.breached = states
+	return alerts
+}
+
+func (m *MarginRiskMonitor) fire(alerts []*MarginRiskAlert) {
+	if m.AlertHandler == nil {
+		return
+	}
+	for _, alert := range alerts {
+		m.AlertHandler(alert)
+	}
+}
+
+func (r *MarginRisk) setLevel() {
+	if r.TotalLiability <= 0 {
+		r.MarginLevel = math.Inf(1)
+		r.LevelDistance = 1
+		return
+	}
+	r.MarginLevel = r.TotalAsset / r.TotalLiability
+	r.LevelDistance = (r.MarginLevel - r.LiquidationBar) / r.MarginLevel
+}
+
+func (p *isolatedMarginPair) balance(asset string) *MarginBalance {
+	switch asset {
+	case p.base.Asset:
+		return &p.base
+	case p.quote.Asset:
+		return &p.quote
+	}
+	return nil
+}
+
+// impliedBar return the margin level of the pair at its liquidation price, 0
+// when unknown
+func (p *isolatedMarginPair) impliedBar(liquidatePrice float64) float64 {
+	if liquidatePrice <= 0 {
+		return 0
+	}
+	liability := p.base.Liability()*liquidatePrice + p.quote.Liability()
+	if liability <= 0 {
+		return 0
+	}
+	return (p.base.Total()*liquidatePrice + p.quote.Total()) / liability
+}
+
+func isolatedMarginBalance(a IsolatedUserAsset) MarginBalance {
+	return MarginBalance{
+		Asset:    a.Asset,
+		Free:     parseFloat(a.Free),
+		Locked:   parseFloat(a.Locked),
+		Borrowed: parseFloat(a.Borrowed),
+		Interest: parseFloat(a.Interest),
+	}
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,114 @@
+package binance_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	binance "github.com/uncle-gua/gobinance"
+)
+
+func newMarginServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sapi/v1/margin/tradeCoeff":
+			w.Write([]byte(`{"normalBar":"1.5","marginCallBar":"1.3","forceLiquidationBar":"1.1"}`))
+		case "/sapi/v1/margin/account":
+			w.Write([]byte(`{"userAssets":[
+				{"asset":"USDT","free":"1000","locked":"0","borrowed":"0","interest":"0"},
+				{"asset":"ETH","free":"0","locked":"0","borrowed":"0.2","interest":"0"}]}`))
+		case "/sapi/v1/margin/isolated/account":
+			w.Write([]byte(`{"assets":[{"symbol":"BTCUSDT","indexPrice":"100000","liquidatePrice":"88000",
+				"baseAsset":{"asset":"BTC","free":"1","locked":"0","borrowed":"0","interest":"0"},
+				"quoteAsset":{"asset":"USDT","free":"0","locked":"0","borrowed":"79990","interest":"10"}}]}`))
+		case "/sapi/v1/margin/priceIndex":
+			if r.URL.Query().Get("symbol") != "ETHUSDT" {
+				t.Errorf("unexpected price index %s", r.URL.Query().Get("symbol"))
+			}
+			w.Write([]byte(`{"symbol":"ETHUSDT","price":"2500"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func balanceUpdate(asset, change string) *binance.WsUserDataEvent {
+	return &binance.WsUserDataEvent{
+		Event:         binance.UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: binance.WsBalanceUpdate{Asset: asset, Change: change},
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestMarginRiskMonitor(t *testing.T) {
+	srv := newMarginServer(t)
+	defer srv.Close()
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = srv.URL
+
+	cross := binance.MarginAlertRule{Name: "cross", MarginLevel: 1.5}
+	btc := binance.MarginAlertRule{Name: "btc", Symbol: "BTCUSDT", PriceDistance: 0.1}
+	m := c.NewMarginRiskMonitor("BTCUSDT")
+	m.IsolatedLiquidationBar = 1.3
+	m.Rules = []binance.MarginAlertRule{cross, btc}
+	var alerts []*binance.MarginRiskAlert
+	m.AlertHandler = func(alert *binance.MarginRiskAlert) {
+		alerts = append(alerts, alert)
+	}
+	if err := m.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 0 {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+
+	risk, _ := m.Risk("")
+	if !near(risk.TotalAsset, 1000) || !near(risk.TotalLiability, 500) || !near(risk.MarginLevel, 2) || risk.LiquidationBar != 1.1 {
+		t.Fatalf("unexpected cross risk %+v", risk)
+	}
+	// the bar is implied by the liquidation price, not IsolatedLiquidationBar
+	risk, _ = m.Risk("BTCUSDT")
+	if !near(risk.LiquidationBar, 1.1) || !near(risk.MarginLevel, 1.25) ||
+		!near(risk.LiquidationPrice, 88000) || !near(risk.PriceDistance, 0.12) {
+		t.Fatalf("unexpected isolated risk %+v", risk)
+	}
+
+	m.HandleIsolatedUserData("BTCUSDT", balanceUpdate("BTC", "-0.05"))
+	risk, _ = m.Risk("BTCUSDT")
+	if !near(risk.LiquidationPrice, 88000/0.95) || !near(risk.PriceDistance, 1-88000/0.95/100000) {
+		t.Fatalf("unexpected isolated risk %+v", risk)
+	}
+	if len(alerts) != 1 || alerts[0].Rule != btc || alerts[0].Recovered {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+
+	m.HandleUserData(balanceUpdate("USDT", "-300"))
+	m.HandleUserData(balanceUpdate("USDT", "-1"))
+	if len(alerts) != 2 || alerts[1].Rule != cross || alerts[1].Recovered || !near(alerts[1].Risk.MarginLevel, 1.4) {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+	m.HandleUserData(balanceUpdate("USDT", "501"))
+	if len(alerts) != 3 || alerts[2].Rule != cross || !alerts[2].Recovered {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+
+	// reordering keeps the state, a changed threshold is a new rule
+	m.SetRules(btc, cross)
+	m.HandleUserData(balanceUpdate("USDT", "0"))
+	if len(alerts) != 3 {
+		t.Fatalf("unexpected alerts %+v", alerts[3:])
+	}
+	tighter := btc
+	tighter.PriceDistance = 0.08
+	m.SetRules(cross, tighter)
+	m.HandleUserData(balanceUpdate("USDT", "0"))
+	if len(alerts) != 4 || alerts[3].Rule != tighter || alerts[3].Recovered {
+		t.Fatalf("unexpected alerts %+v", alerts[3:])
+	}
+}
@@ -0,0 +1,378 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListMarginInterestRateHistoryService list the daily interest rate history of a margin asset
+type ListMarginInterestRateHistoryService struct {
+	c         *Client
+	asset     string
+	vipLevel  *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Asset set asset
+func (s *ListMarginInterestRateHistoryService) Asset(asset string) *ListMarginInterestRateHistoryService {
+	s.asset = asset
+	return s
+}
+
+// VipLevel set vipLevel, default the level of the user
+func (s *ListMarginInterestRateHistoryService) VipLevel(vipLevel int) *ListMarginInterestRateHistoryService {
+	s.vipLevel = &vipLevel
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginInterestRateHistoryService) StartTime(startTime int64) *ListMarginInterestRateHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginInterestRateHistoryService) EndTime(endTime int64) *ListMarginInterestRateHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListMarginInterestRateHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginInterestRate, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/interestRateHistory",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("asset", s.asset)
+	if s.vipLevel != nil {
+		r.SetParam("vipLevel", *s.vipLevel)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarginInterestRate{}, err
+	}
+	res = make([]*MarginInterestRate, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarginInterestRate{}, err
+	}
+	return res, nil
+}
+
+// MarginInterestRate define the daily interest rate of a margin asset
+type MarginInterestRate struct {
+	Asset             string `json:"asset"`
+	DailyInterestRate string `json:"dailyInterestRate"`
+	Timestamp         int64  `json:"timestamp"`
+	VipLevel          int    `json:"vipLevel"`
+}
+
+// ListMarginForceLiquidationService list the force liquidation records
+type ListMarginForceLiquidationService struct {
+	c              *Client
+	startTime      *int64
+	endTime        *int64
+	isolatedSymbol *string
+	current        *int64
+	size           *int64
+}
+
+// StartTime set startTime
+func (s *ListMarginForceLiquidationService) StartTime(startTime int64) *ListMarginForceLiquidationService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginForceLiquidationService) EndTime(endTime int64) *ListMarginForceLiquidationService {
+	s.endTime = &endTime
+	return s
+}
+
+// IsolatedSymbol set isolatedSymbol
+func (s *ListMarginForceLiquidationService) IsolatedSymbol(isolatedSymbol string) *ListMarginForceLiquidationService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListMarginForceLiquidationService) Current(current int64) *ListMarginForceLiquidationService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *ListMarginForceLiquidationService) Size(size int64) *ListMarginForceLiquidationService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListMarginForceLiquidationService) Do(ctx context.Context, opts ...RequestOption) (res *MarginForceLiquidationResponse, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/forceLiquidationRec",
+		SecType:  secTypeSigned,
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.isolatedSymbol != nil {
+		r.SetParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginForceLiquidationResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginForceLiquidationResponse define force liquidation records response
+type MarginForceLiquidationResponse struct {
+	Rows  []MarginForceLiquidation `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+// MarginForceLiquidation define a force liquidation order
+type MarginForceLiquidation struct {
+	AvgPrice    string          `json:"avgPrice"`
+	ExecutedQty string          `json:"executedQty"`
+	OrderID     int64           `json:"orderId"`
+	Price       string          `json:"price"`
+	Qty         string          `json:"qty"`
+	Side        SideType        `json:"side"`
+	Symbol      string          `json:"symbol"`
+	TimeInForce TimeInForceType `json:"timeInForce"`
+	IsIsolated  bool            `json:"isIsolated"`
+	UpdatedTime int64           `json:"updatedTime"`
+}
+
+// GetCrossMarginCollateralRatioService get the collateral discount rates of the cross margin assets
+type GetCrossMarginCollateralRatioService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetCrossMarginCollateralRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*CrossMarginCollateralRatio, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/crossMarginCollateralRatio",
+		SecType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*CrossMarginCollateralRatio{}, err
+	}
+	res = make([]*CrossMarginCollateralRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*CrossMarginCollateralRatio{}, err
+	}
+	return res, nil
+}
+
+// CrossMarginCollateralRatio define the discount rate tiers shared by a group of assets
+type CrossMarginCollateralRatio struct {
+	Collaterals []struct {
+		MinUsdValue  string `json:"minUsdValue"`
+		MaxUsdValue  string `json:"maxUsdValue"`
+		DiscountRate string `json:"discountRate"`
+	} `json:"collaterals"`
+	AssetNames []string `json:"assetNames"`
+}
+
+// GetMarginTradeCoeffService get the margin levels of the cross margin account
+type GetMarginTradeCoeffService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetMarginTradeCoeffService) Do(ctx context.Context, opts ...RequestOption) (res *MarginTradeCoeff, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/tradeCoeff",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginTradeCoeff)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginTradeCoeff define the margin level bars, the account gets a margin
+// call under MarginCallBar and is liquidated under ForceLiquidationBar
+type MarginTradeCoeff struct {
+	NormalBar           string `json:"normalBar"`
+	MarginCallBar       string `json:"marginCallBar"`
+	ForceLiquidationBar string `json:"forceLiquidationBar"`
+}
+
+// SetMarginMaxLeverageService set the max leverage of the cross margin account
+type SetMarginMaxLeverageService struct {
+	c           *Client
+	maxLeverage int
+}
+
+// MaxLeverage set maxLeverage, 3, 5 or 10 (Pro mode)
+func (s *SetMarginMaxLeverageService) MaxLeverage(maxLeverage int) *SetMarginMaxLeverageService {
+	s.maxLeverage = maxLeverage
+	return s
+}
+
+// Do send request
+func (s *SetMarginMaxLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *MarginMaxLeverageResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/max-leverage",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("maxLeverage", s.maxLeverage)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginMaxLeverageResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginMaxLeverageResponse define set max leverage response
+type MarginMaxLeverageResponse struct {
+	Success bool `json:"success"`
+}
+
+// ListMarginCapitalFlowService list the capital flow of the cross or an isolated margin account
+type ListMarginCapitalFlowService struct {
+	c         *Client
+	asset     *string
+	symbol    *string
+	flowType  *MarginCapitalFlowType
+	startTime *int64
+	endTime   *int64
+	fromID    *int64
+	limit     *int
+}
+
+// Asset set asset
+func (s *ListMarginCapitalFlowService) Asset(asset string) *ListMarginCapitalFlowService {
+	s.asset = &asset
+	return s
+}
+
+// Symbol set isolated symbol, the cross margin account is queried when not set
+func (s *ListMarginCapitalFlowService) Symbol(symbol string) *ListMarginCapitalFlowService {
+	s.symbol = &symbol
+	return s
+}
+
+// Type set flow type
+func (s *ListMarginCapitalFlowService) Type(flowType MarginCapitalFlowType) *ListMarginCapitalFlowService {
+	s.flowType = &flowType
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginCapitalFlowService) StartTime(startTime int64) *ListMarginCapitalFlowService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginCapitalFlowService) EndTime(endTime int64) *ListMarginCapitalFlowService {
+	s.endTime = &endTime
+	return s
+}
+
+// FromID set fromID, the records are returned from this ID in ascending order
+func (s *ListMarginCapitalFlowService) FromID(fromID int64) *ListMarginCapitalFlowService {
+	s.fromID = &fromID
+	return s
+}
+
+// Limit set limit, default 500, max 1000
+func (s *ListMarginCapitalFlowService) Limit(limit int) *ListMarginCapitalFlowService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListMarginCapitalFlowService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginCapitalFlow, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/capital-flow",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.flowType != nil {
+		r.SetParam("type", *s.flowType)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.fromID != nil {
+		r.SetParam("fromId", *s.fromID)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarginCapitalFlow{}, err
+	}
+	res = make([]*MarginCapitalFlow, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarginCapitalFlow{}, err
+	}
+	return res, nil
+}
+
+// MarginCapitalFlow define a capital flow record
+type MarginCapitalFlow struct {
+	ID        int64                 `json:"id"`
+	TranID    int64                 `json:"tranId"`
+	Timestamp int64                 `json:"timestamp"`
+	Asset     string                `json:"asset"`
+	Symbol    string                `json:"symbol"`
+	Type      MarginCapitalFlowType `json:"type"`
+	Amount    string                `json:"amount"`
+}
@@ -35,16 +35,16 @@ func (s *MarginTransferService) Type(transferType MarginTransferType) *MarginTra
 // Do send request
 func (s *MarginTransferService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/margin/transfer",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/transfer",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"asset":  s.asset,
 		"amount": s.amount,
 		"type":   s.transferType,
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	res = new(TransactionResponse)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -63,6 +63,8 @@ type TransactionResponse struct {
 }
 
 // MarginLoanService apply for a loan
+//
+// Deprecated: /sapi/v1/margin/loan and /sapi/v1/margin/repay are replaced by /sapi/v1/margin/borrow-repay, use MarginBorrowRepayService
 type MarginLoanService struct {
 	c          *Client
 	asset      string
@@ -98,20 +100,20 @@ func (s *MarginLoanService) Symbol(symbol string) *MarginLoanService {
 // Do send request
 func (s *MarginLoanService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/margin/loan",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/loan",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"asset":  s.asset,
 		"amount": s.amount,
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 
 	res = new(TransactionResponse)
@@ -127,6 +129,8 @@ func (s *MarginLoanService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // MarginRepayService repay loan for margin account
+//
+// Deprecated: /sapi/v1/margin/loan and /sapi/v1/margin/repay are replaced by /sapi/v1/margin/borrow-repay, use MarginBorrowRepayService
 type MarginRepayService struct {
 	c          *Client
 	asset      string
@@ -162,20 +166,20 @@ func (s *MarginRepayService) Symbol(symbol string) *MarginRepayService {
 // Do send request
 func (s *MarginRepayService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/margin/repay",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/margin/repay",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"asset":  s.asset,
 		"amount": s.amount,
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 
 	res = new(TransactionResponse)
@@ -191,6 +195,8 @@ func (s *MarginRepayService) Do(ctx context.Context, opts ...RequestOption) (res
 }
 
 // ListMarginLoansService list loan record
+//
+// Deprecated: /sapi/v1/margin/loan and /sapi/v1/margin/repay are replaced by /sapi/v1/margin/borrow-repay, use ListMarginBorrowRepayService
 type ListMarginLoansService struct {
 	c         *Client
 	asset     string
@@ -240,25 +246,25 @@ func (s *ListMarginLoansService) Size(size int64) *ListMarginLoansService {
 // Do send request
 func (s *ListMarginLoansService) Do(ctx context.Context, opts ...RequestOption) (res *MarginLoanResponse, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/loan",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/loan",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("asset", s.asset)
+	r.SetParam("asset", s.asset)
 	if s.txID != nil {
-		r.setParam("txId", *s.txID)
+		r.SetParam("txId", *s.txID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.current != nil {
-		r.setParam("current", *s.current)
+		r.SetParam("current", *s.current)
 	}
 	if s.size != nil {
-		r.setParam("size", *s.size)
+		r.SetParam("size", *s.size)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -287,6 +293,8 @@ type MarginLoan struct {
 }
 
 // ListMarginRepaysService list repay record
+//
+// Deprecated: /sapi/v1/margin/loan and /sapi/v1/margin/repay are replaced by /sapi/v1/margin/borrow-repay, use ListMarginBorrowRepayService
 type ListMarginRepaysService struct {
 	c         *Client
 	asset     string
@@ -336,25 +344,25 @@ func (s *ListMarginRepaysService) Size(size int64) *ListMarginRepaysService {
 // Do send request
 func (s *ListMarginRepaysService) Do(ctx context.Context, opts ...RequestOption) (res *MarginRepayResponse, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/repay",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/repay",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("asset", s.asset)
+	r.SetParam("asset", s.asset)
 	if s.txID != nil {
-		r.setParam("txId", *s.txID)
+		r.SetParam("txId", *s.txID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.current != nil {
-		r.setParam("current", *s.current)
+		r.SetParam("current", *s.current)
 	}
 	if s.size != nil {
-		r.setParam("size", *s.size)
+		r.SetParam("size", *s.size)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -401,13 +409,13 @@ func (s *GetIsolatedMarginAccountService) Symbols(symbols ...string) *GetIsolate
 // Do send request
 func (s *GetIsolatedMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *IsolatedMarginAccount, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/isolated/account",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/isolated/account",
+		SecType:  secTypeSigned,
 	}
 
 	if len(s.symbols) > 0 {
-		r.setParam("symbols", strings.Join(s.symbols, ","))
+		r.SetParam("symbols", strings.Join(s.symbols, ","))
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -469,9 +477,9 @@ type GetMarginAccountService struct {
 // Do send request
 func (s *GetMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccount, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/account",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/account",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -522,11 +530,11 @@ func (s *GetMarginAssetService) Asset(asset string) *GetMarginAssetService {
 // Do send request
 func (s *GetMarginAssetService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAsset, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/asset",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/asset",
+		SecType:  secTypeAPIKey,
 	}
-	r.setParam("asset", s.asset)
+	r.SetParam("asset", s.asset)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -564,11 +572,11 @@ func (s *GetMarginPairService) Symbol(symbol string) *GetMarginPairService {
 // Do send request
 func (s *GetMarginPairService) Do(ctx context.Context, opts ...RequestOption) (res *MarginPair, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/pair",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/pair",
+		SecType:  secTypeAPIKey,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -600,9 +608,9 @@ type GetMarginAllPairsService struct {
 // Do send request
 func (s *GetMarginAllPairsService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginAllPair, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/allPairs",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/allPairs",
+		SecType:  secTypeAPIKey,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -642,11 +650,11 @@ func (s *GetMarginPriceIndexService) Symbol(symbol string) *GetMarginPriceIndexS
 // Do send request
 func (s *GetMarginPriceIndexService) Do(ctx context.Context, opts ...RequestOption) (res *MarginPriceIndex, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/priceIndex",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/priceIndex",
+		SecType:  secTypeAPIKey,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -716,25 +724,25 @@ func (s *ListMarginTradesService) FromID(fromID int64) *ListMarginTradesService
 // Do send request
 func (s *ListMarginTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*TradeV3, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/myTrades",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/myTrades",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromId", *s.fromID)
+		r.SetParam("fromId", *s.fromID)
 	}
 	if s.isIsolated {
-		r.setParam("isIsolated", "TRUE")
+		r.SetParam("isIsolated", "TRUE")
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -770,13 +778,13 @@ func (s *GetMaxBorrowableService) IsolatedSymbol(isolatedSymbol string) *GetMaxB
 // Do send request
 func (s *GetMaxBorrowableService) Do(ctx context.Context, opts ...RequestOption) (res *MaxBorrowable, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/maxBorrowable",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/maxBorrowable",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("asset", s.asset)
+	r.SetParam("asset", s.asset)
 	if s.isolatedSymbol != "" {
-		r.setParam("isolatedSymbol", s.isolatedSymbol)
+		r.SetParam("isolatedSymbol", s.isolatedSymbol)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -810,11 +818,11 @@ func (s *GetMaxTransferableService) Asset(asset string) *GetMaxTransferableServi
 // Do send request
 func (s *GetMaxTransferableService) Do(ctx context.Context, opts ...RequestOption) (res *MaxTransferable, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/maxTransferable",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/maxTransferable",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("asset", s.asset)
+	r.SetParam("asset", s.asset)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -847,12 +855,12 @@ func (s *StartIsolatedMarginUserStreamService) Symbol(symbol string) *StartIsola
 // Do send request
 func (s *StartIsolatedMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/userDataStream/isolated",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/userDataStream/isolated",
+		SecType:  secTypeAPIKey,
 	}
 
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -885,12 +893,12 @@ func (s *KeepaliveIsolatedMarginUserStreamService) ListenKey(listenKey string) *
 // Do send request
 func (s *KeepaliveIsolatedMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPut,
-		endpoint: "/sapi/v1/userDataStream/isolated",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodPut,
+		Endpoint: "/sapi/v1/userDataStream/isolated",
+		SecType:  secTypeAPIKey,
 	}
-	r.setFormParam("listenKey", s.listenKey)
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("listenKey", s.listenKey)
+	r.SetFormParam("symbol", s.symbol)
 
 	_, err = s.c.callAPI(ctx, r, opts...)
 	return err
@@ -919,13 +927,13 @@ func (s *CloseIsolatedMarginUserStreamService) Symbol(symbol string) *CloseIsola
 // Do send request
 func (s *CloseIsolatedMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/sapi/v1/userDataStream/isolated",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodDelete,
+		Endpoint: "/sapi/v1/userDataStream/isolated",
+		SecType:  secTypeAPIKey,
 	}
 
-	r.setFormParam("listenKey", s.listenKey)
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("listenKey", s.listenKey)
+	r.SetFormParam("symbol", s.symbol)
 
 	_, err = s.c.callAPI(ctx, r, opts...)
 	return err
@@ -939,9 +947,9 @@ type StartMarginUserStreamService struct {
 // Do send request
 func (s *StartMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/userDataStream",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/userDataStream",
+		SecType:  secTypeAPIKey,
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -968,11 +976,11 @@ func (s *KeepaliveMarginUserStreamService) ListenKey(listenKey string) *Keepaliv
 // Do send request
 func (s *KeepaliveMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodPut,
-		endpoint: "/sapi/v1/userDataStream",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodPut,
+		Endpoint: "/sapi/v1/userDataStream",
+		SecType:  secTypeAPIKey,
 	}
-	r.setFormParam("listenKey", s.listenKey)
+	r.SetFormParam("listenKey", s.listenKey)
 	_, err = s.c.callAPI(ctx, r, opts...)
 	return err
 }
@@ -992,12 +1000,12 @@ func (s *CloseMarginUserStreamService) ListenKey(listenKey string) *CloseMarginU
 // Do send request
 func (s *CloseMarginUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/sapi/v1/userDataStream",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodDelete,
+		Endpoint: "/sapi/v1/userDataStream",
+		SecType:  secTypeAPIKey,
 	}
 
-	r.setFormParam("listenKey", s.listenKey)
+	r.SetFormParam("listenKey", s.listenKey)
 
 	_, err = s.c.callAPI(ctx, r, opts...)
 	return err
@@ -1011,9 +1019,9 @@ type GetAllMarginAssetsService struct {
 // Do send request
 func (s *GetAllMarginAssetsService) Do(ctx context.Context, opts ...RequestOption) (res []*MarginAsset, err error) {
 	r := &request{
-		method:   "GET",
-		endpoint: "/sapi/v1/margin/allAssets",
-		secType:  secTypeAPIKey,
+		Method:   "GET",
+		Endpoint: "/sapi/v1/margin/allAssets",
+		SecType:  secTypeAPIKey,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -1035,9 +1043,9 @@ type GetIsolatedMarginAllPairsService struct {
 // Do send request
 func (s *GetIsolatedMarginAllPairsService) Do(ctx context.Context, opts ...RequestOption) (res []*IsolatedMarginAllPair, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/margin/isolated/allPairs",
-		secType:  secTypeAPIKey,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/margin/isolated/allPairs",
+		SecType:  secTypeAPIKey,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
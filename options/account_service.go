@@ -0,0 +1,198 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetAccountService get account info
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/marginAccount",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Account)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Account define account info
+type Account struct {
+	Assets      []*AccountAsset `json:"asset"`
+	Greeks      []*AccountGreek `json:"greek"`
+	Time        int64           `json:"time"`
+	CanTrade    bool            `json:"canTrade"`
+	CanDeposit  bool            `json:"canDeposit"`
+	CanWithdraw bool            `json:"canWithdraw"`
+	ReduceOnly  bool            `json:"reduceOnly"`
+}
+
+// AccountAsset define account asset
+type AccountAsset struct {
+	Asset         string  `json:"asset"`
+	MarginBalance float64 `json:"marginBalance,string"`
+	Equity        float64 `json:"equity,string"`
+	Available     float64 `json:"available,string"`
+	InitialMargin float64 `json:"initialMargin,string"`
+	MaintMargin   float64 `json:"maintMargin,string"`
+	UnrealizedPNL float64 `json:"unrealizedPNL,string"`
+	LpProfit      float64 `json:"lpProfit,string"`
+}
+
+// AccountGreek define the greeks of the account on an underlying
+type AccountGreek struct {
+	Underlying string  `json:"underlying"`
+	Delta      float64 `json:"delta,string"`
+	Gamma      float64 `json:"gamma,string"`
+	Theta      float64 `json:"theta,string"`
+	Vega       float64 `json:"vega,string"`
+}
+
+// GetPositionService get positions
+type GetPositionService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetPositionService) Symbol(symbol string) *GetPositionService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetPositionService) Do(ctx context.Context, opts ...RequestOption) (res []*Position, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/position",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Position{}, err
+	}
+	res = make([]*Position, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Position{}, err
+	}
+	return res, nil
+}
+
+// Position define position info
+type Position struct {
+	EntryPrice    float64          `json:"entryPrice,string"`
+	Symbol        string           `json:"symbol"`
+	Side          PositionSideType `json:"side"`
+	Quantity      float64          `json:"quantity,string"`
+	ReducibleQty  float64          `json:"reducibleQty,string"`
+	MarkValue     float64          `json:"markValue,string"`
+	Ror           float64          `json:"ror,string"`
+	UnrealizedPNL float64          `json:"unrealizedPNL,string"`
+	MarkPrice     float64          `json:"markPrice,string"`
+	StrikePrice   float64          `json:"strikePrice,string"`
+	PositionCost  float64          `json:"positionCost,string"`
+	ExpiryDate    int64            `json:"expiryDate"`
+	PriceScale    int              `json:"priceScale"`
+	QuantityScale int              `json:"quantityScale"`
+	OptionSide    OptionSideType   `json:"optionSide"`
+	QuoteAsset    string           `json:"quoteAsset"`
+}
+
+// ListExerciseRecordsService list the exercise records of the account
+type ListExerciseRecordsService struct {
+	c         *Client
+	symbol    *string
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListExerciseRecordsService) Symbol(symbol string) *ListExerciseRecordsService {
+	s.symbol = &symbol
+	return s
+}
+
+// StartTime set startTime
+func (s *ListExerciseRecordsService) StartTime(startTime int64) *ListExerciseRecordsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListExerciseRecordsService) EndTime(endTime int64) *ListExerciseRecordsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListExerciseRecordsService) Limit(limit int) *ListExerciseRecordsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListExerciseRecordsService) Do(ctx context.Context, opts ...RequestOption) (res []*ExerciseRecord, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/exerciseRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ExerciseRecord{}, err
+	}
+	res = make([]*ExerciseRecord, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ExerciseRecord{}, err
+	}
+	return res, nil
+}
+
+// ExerciseRecord define an exercise record of the account
+type ExerciseRecord struct {
+	ID            string           `json:"id"`
+	Currency      string           `json:"currency"`
+	Symbol        string           `json:"symbol"`
+	ExercisePrice float64          `json:"exercisePrice,string"`
+	MarkPrice     float64          `json:"markPrice,string"`
+	Quantity      float64          `json:"quantity,string"`
+	Amount        float64          `json:"amount,string"`
+	Fee           float64          `json:"fee,string"`
+	CreateDate    int64            `json:"createDate"`
+	PriceScale    int              `json:"priceScale"`
+	QuantityScale int              `json:"quantityScale"`
+	OptionSide    OptionSideType   `json:"optionSide"`
+	PositionSide  PositionSideType `json:"positionSide"`
+	QuoteAsset    string           `json:"quoteAsset"`
+}
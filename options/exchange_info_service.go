@@ -0,0 +1,153 @@
+package options
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// ExchangeInfoService exchange info service
+type ExchangeInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *ExchangeInfo, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/exchangeInfo",
+		SecType:  secTypeNone,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ExchangeInfo)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExchangeInfo exchange info
+type ExchangeInfo struct {
+	Timezone        string           `json:"timezone"`
+	ServerTime      int64            `json:"serverTime"`
+	OptionContracts []OptionContract `json:"optionContracts"`
+	OptionAssets    []OptionAsset    `json:"optionAssets"`
+	OptionSymbols   []Symbol         `json:"optionSymbols"`
+	RateLimits      []RateLimit      `json:"rateLimits"`
+}
+
+// OptionContract define an underlying of the options
+type OptionContract struct {
+	BaseAsset   string `json:"baseAsset"`
+	QuoteAsset  string `json:"quoteAsset"`
+	Underlying  string `json:"underlying"`
+	SettleAsset string `json:"settleAsset"`
+}
+
+// OptionAsset define an asset of the options
+type OptionAsset struct {
+	Name string `json:"name"`
+}
+
+// RateLimit struct
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// Symbol option symbol
+type Symbol struct {
+	Symbol               string                   `json:"symbol"`
+	Side                 OptionSideType           `json:"side"`
+	StrikePrice          float64                  `json:"strikePrice,string"`
+	Underlying           string                   `json:"underlying"`
+	ExpiryDate           int64                    `json:"expiryDate"`
+	Unit                 int64                    `json:"unit"`
+	MakerFeeRate         float64                  `json:"makerFeeRate,string"`
+	TakerFeeRate         float64                  `json:"takerFeeRate,string"`
+	MinQty               float64                  `json:"minQty,string"`
+	MaxQty               float64                  `json:"maxQty,string"`
+	InitialMargin        float64                  `json:"initialMargin,string"`
+	MaintenanceMargin    float64                  `json:"maintenanceMargin,string"`
+	MinInitialMargin     float64                  `json:"minInitialMargin,string"`
+	MinMaintenanceMargin float64                  `json:"minMaintenanceMargin,string"`
+	PriceScale           int                      `json:"priceScale"`
+	QuantityScale        int                      `json:"quantityScale"`
+	QuoteAsset           string                   `json:"quoteAsset"`
+	Filters              []map[string]interface{} `json:"filters"`
+}
+
+// LotSizeFilter define lot size filter of symbol
+type LotSizeFilter struct {
+	MaxQuantity float64 `json:"maxQty,string"`
+	MinQuantity float64 `json:"minQty,string"`
+	StepSize    float64 `json:"stepSize,string"`
+}
+
+// PriceFilter define price filter of symbol
+type PriceFilter struct {
+	MaxPrice float64 `json:"maxPrice,string"`
+	MinPrice float64 `json:"minPrice,string"`
+	TickSize float64 `json:"tickSize,string"`
+}
+
+// parseFilterFloat parse the string value of key in filter
+func parseFilterFloat(filter map[string]interface{}, key string) (float64, bool) {
+	i, ok := filter[key]
+	if !ok {
+		return 0, true
+	}
+	v, err := strconv.ParseFloat(i.(string), 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// LotSizeFilter return lot size filter of symbol
+func (s *Symbol) LotSizeFilter() *LotSizeFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeLotSize) {
+			f := &LotSizeFilter{}
+			var ok bool
+			if f.MaxQuantity, ok = parseFilterFloat(filter, "maxQty"); !ok {
+				return nil
+			}
+			if f.MinQuantity, ok = parseFilterFloat(filter, "minQty"); !ok {
+				return nil
+			}
+			if f.StepSize, ok = parseFilterFloat(filter, "stepSize"); !ok {
+				return nil
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// PriceFilter return price filter of symbol
+func (s *Symbol) PriceFilter() *PriceFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypePrice) {
+			f := &PriceFilter{}
+			var ok bool
+			if f.MaxPrice, ok = parseFilterFloat(filter, "maxPrice"); !ok {
+				return nil
+			}
+			if f.MinPrice, ok = parseFilterFloat(filter, "minPrice"); !ok {
+				return nil
+			}
+			if f.TickSize, ok = parseFilterFloat(filter, "tickSize"); !ok {
+				return nil
+			}
+			return f
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// KlinesService list klines
+type KlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *KlinesService) Symbol(symbol string) *KlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesService) Interval(interval string) *KlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *KlinesService) Limit(limit int) *KlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesService) StartTime(startTime int64) *KlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesService) EndTime(endTime int64) *KlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/klines",
+	}
+	r.SetParam("symbol", s.symbol)
+	r.SetParam("interval", s.interval)
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	res = make([]*Kline, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return res, nil
+}
+
+// Kline define kline info
+type Kline struct {
+	OpenTime    int64   `json:"openTime"`
+	CloseTime   int64   `json:"closeTime"`
+	Interval    string  `json:"interval"`
+	Open        float64 `json:"open,string"`
+	High        float64 `json:"high,string"`
+	Low         float64 `json:"low,string"`
+	Close       float64 `json:"close,string"`
+	Volume      float64 `json:"volume,string"`
+	Amount      float64 `json:"amount,string"`
+	TakerVolume float64 `json:"takerVolume,string"`
+	TakerAmount float64 `json:"takerAmount,string"`
+	TradeCount  int64   `json:"tradeCount"`
+}
@@ -0,0 +1,212 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarkPriceService get the mark price and greeks of options
+type MarkPriceService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, all symbols when not set
+func (s *MarkPriceService) Symbol(symbol string) *MarkPriceService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *MarkPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*MarkPrice, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/mark",
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*MarkPrice{}, err
+	}
+	res = make([]*MarkPrice, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*MarkPrice{}, err
+	}
+	return res, nil
+}
+
+// MarkPrice define mark price and greeks of an option
+type MarkPrice struct {
+	Symbol           string  `json:"symbol"`
+	MarkPrice        float64 `json:"markPrice,string"`
+	BidIV            float64 `json:"bidIV,string"`
+	AskIV            float64 `json:"askIV,string"`
+	MarkIV           float64 `json:"markIV,string"`
+	Delta            float64 `json:"delta,string"`
+	Theta            float64 `json:"theta,string"`
+	Gamma            float64 `json:"gamma,string"`
+	Vega             float64 `json:"vega,string"`
+	HighPriceLimit   float64 `json:"highPriceLimit,string"`
+	LowPriceLimit    float64 `json:"lowPriceLimit,string"`
+	RiskFreeInterest float64 `json:"riskFreeInterest,string"`
+}
+
+// IndexPriceService get the spot index price of an underlying
+type IndexPriceService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *IndexPriceService) Underlying(underlying string) *IndexPriceService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *IndexPriceService) Do(ctx context.Context, opts ...RequestOption) (res *IndexPrice, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/index",
+	}
+	r.SetParam("underlying", s.underlying)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(IndexPrice)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IndexPrice define index price of an underlying
+type IndexPrice struct {
+	Time       int64   `json:"time"`
+	IndexPrice float64 `json:"indexPrice,string"`
+}
+
+// OpenInterestService get the open interest of the options of an
+// underlying asset and expiration
+type OpenInterestService struct {
+	c               *Client
+	underlyingAsset string
+	expiration      string
+}
+
+// UnderlyingAsset set underlying asset, e.g. ETH
+func (s *OpenInterestService) UnderlyingAsset(underlyingAsset string) *OpenInterestService {
+	s.underlyingAsset = underlyingAsset
+	return s
+}
+
+// Expiration set expiration, e.g. 221225
+func (s *OpenInterestService) Expiration(expiration string) *OpenInterestService {
+	s.expiration = expiration
+	return s
+}
+
+// Do send request
+func (s *OpenInterestService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterest, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/openInterest",
+	}
+	r.SetParam("underlyingAsset", s.underlyingAsset)
+	r.SetParam("expiration", s.expiration)
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OpenInterest{}, err
+	}
+	res = make([]*OpenInterest, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OpenInterest{}, err
+	}
+	return res, nil
+}
+
+// OpenInterest define open interest of an option
+type OpenInterest struct {
+	Symbol             string  `json:"symbol"`
+	SumOpenInterest    float64 `json:"sumOpenInterest,string"`
+	SumOpenInterestUsd float64 `json:"sumOpenInterestUsd,string"`
+	Timestamp          int64   `json:"timestamp,string"`
+}
+
+// ExerciseHistoryService get the historical exercise prices of expired options
+type ExerciseHistoryService struct {
+	c          *Client
+	underlying *string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ExerciseHistoryService) Underlying(underlying string) *ExerciseHistoryService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ExerciseHistoryService) StartTime(startTime int64) *ExerciseHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ExerciseHistoryService) EndTime(endTime int64) *ExerciseHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ExerciseHistoryService) Limit(limit int) *ExerciseHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ExerciseHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*ExerciseHistory, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/exerciseHistory",
+	}
+	if s.underlying != nil {
+		r.SetParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ExerciseHistory{}, err
+	}
+	res = make([]*ExerciseHistory, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ExerciseHistory{}, err
+	}
+	return res, nil
+}
+
+// ExerciseHistory define the exercise price of an expired option
+type ExerciseHistory struct {
+	Symbol          string  `json:"symbol"`
+	StrikePrice     float64 `json:"strikePrice,string"`
+	RealStrikePrice float64 `json:"realStrikePrice,string"`
+	ExpiryDate      int64   `json:"expiryDate"`
+	StrikeResult    string  `json:"strikeResult"`
+}
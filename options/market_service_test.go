@@ -0,0 +1,18 @@
+package options_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/options"
+)
+
+func TestMarkPrice(t *testing.T) {
+	client := options.NewClient("", "")
+	resp, err := client.NewMarkPriceService().Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	t.Log(resp)
+}
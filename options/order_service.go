@@ -0,0 +1,499 @@
+package options
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+// CreateOrderService create order
+type CreateOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	orderType        OrderType
+	quantity         string
+	price            *string
+	timeInForce      *TimeInForceType
+	reduceOnly       *bool
+	postOnly         *bool
+	newOrderRespType *NewOrderRespType
+	clientOrderID    *string
+	isMmp            *bool
+}
+
+// Symbol set symbol
+func (s *CreateOrderService) Symbol(symbol string) *CreateOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderService) Side(side SideType) *CreateOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateOrderService) Type(orderType OrderType) *CreateOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *CreateOrderService) Price(price string) *CreateOrderService {
+	s.price = &price
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateOrderService) TimeInForce(timeInForce TimeInForceType) *CreateOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// PostOnly set postOnly
+func (s *CreateOrderService) PostOnly(postOnly bool) *CreateOrderService {
+	s.postOnly = &postOnly
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateOrderService) NewOrderResponseType(newOrderResponseType NewOrderRespType) *CreateOrderService {
+	s.newOrderRespType = &newOrderResponseType
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CreateOrderService) ClientOrderID(clientOrderID string) *CreateOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// IsMmp set isMmp, whether the order is a market maker protection order
+func (s *CreateOrderService) IsMmp(isMmp bool) *CreateOrderService {
+	s.isMmp = &isMmp
+	return s
+}
+
+// params return the parameters of the order
+func (s *CreateOrderService) params() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.postOnly != nil {
+		m["postOnly"] = *s.postOnly
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.clientOrderID != nil {
+		m["clientOrderId"] = *s.clientOrderID
+	}
+	if s.isMmp != nil {
+		m["isMmp"] = *s.isMmp
+	}
+	return m
+}
+
+// Do send request
+func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(s.params())
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Order define order info
+type Order struct {
+	OrderID          int64           `json:"orderId"`
+	Symbol           string          `json:"symbol"`
+	Price            float64         `json:"price,string"`
+	Quantity         float64         `json:"quantity,string"`
+	ExecutedQuantity float64         `json:"executedQty,string"`
+	Fee              float64         `json:"fee,string"`
+	Side             SideType        `json:"side"`
+	Type             OrderType       `json:"type"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	ReduceOnly       bool            `json:"reduceOnly"`
+	PostOnly         bool            `json:"postOnly"`
+	CreateTime       int64           `json:"createTime"`
+	UpdateTime       int64           `json:"updateTime"`
+	Status           OrderStatusType `json:"status"`
+	AvgPrice         float64         `json:"avgPrice,string"`
+	Source           string          `json:"source"`
+	ClientOrderID    string          `json:"clientOrderId"`
+	PriceScale       int             `json:"priceScale"`
+	QuantityScale    int             `json:"quantityScale"`
+	OptionSide       OptionSideType  `json:"optionSide"`
+	QuoteAsset       string          `json:"quoteAsset"`
+	Mmp              bool            `json:"mmp"`
+}
+
+// BatchOrdersResponse define the result of a batch of orders, in the order
+// of the request. Orders[i] is nil when Errors[i] is set and vice versa.
+type BatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
+func parseBatchOrders(data []byte) (res *BatchOrdersResponse, err error) {
+	rawMessages := make([]*stdjson.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = &BatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: make([]*common.APIError, len(rawMessages)),
+	}
+	for i, j := range rawMessages {
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return nil, err
+		}
+		if e.Code != 0 {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
+// CreateBatchOrdersService create up to 10 orders at once
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// OrderList set the orders
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *BatchOrdersResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/eapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.SetFormParam("orders", string(b))
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatchOrders(data)
+}
+
+// GetOrderService get an order
+type GetOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetOrderService) Symbol(symbol string) *GetOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderService) OrderID(orderID int64) *GetOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *GetOrderService) ClientOrderID(clientOrderID string) *GetOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetParam("clientOrderId", *s.clientOrderID)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelOrderService cancel an order
+type CancelOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelOrderService) Symbol(symbol string) *CancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelOrderService) OrderID(orderID int64) *CancelOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CancelOrderService) ClientOrderID(clientOrderID string) *CancelOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetFormParam("clientOrderId", *s.clientOrderID)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelMultipleOrdersService cancel up to 10 orders of a symbol at once
+type CancelMultipleOrdersService struct {
+	c              *Client
+	symbol         string
+	orderIDList    []int64
+	clientOrderIDs []string
+}
+
+// Symbol set symbol
+func (s *CancelMultipleOrdersService) Symbol(symbol string) *CancelMultipleOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIDList
+func (s *CancelMultipleOrdersService) OrderIDList(orderIDList []int64) *CancelMultipleOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// ClientOrderIDList set clientOrderIDList
+func (s *CancelMultipleOrdersService) ClientOrderIDList(clientOrderIDList []string) *CancelMultipleOrdersService {
+	s.clientOrderIDs = clientOrderIDList
+	return s
+}
+
+// Do send request
+func (s *CancelMultipleOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *BatchOrdersResponse, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/batchOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		b, err := json.Marshal(s.orderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.SetFormParam("orderIds", string(b))
+	}
+	if s.clientOrderIDs != nil {
+		b, err := json.Marshal(s.clientOrderIDs)
+		if err != nil {
+			return nil, err
+		}
+		r.SetFormParam("clientOrderIds", string(b))
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatchOrders(data)
+}
+
+// CancelAllOpenOrdersService cancel all open orders of a symbol or of an underlying
+type CancelAllOpenOrdersService struct {
+	c          *Client
+	symbol     string
+	underlying string
+}
+
+// Symbol set symbol
+func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Underlying set underlying, cancel the open orders of all the options of
+// the underlying instead of a symbol
+func (s *CancelAllOpenOrdersService) Underlying(underlying string) *CancelAllOpenOrdersService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/allOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.underlying != "" {
+		r.Endpoint = "/eapi/v1/allOpenOrdersByUnderlying"
+		r.SetFormParam("underlying", s.underlying)
+	} else {
+		r.SetFormParam("symbol", s.symbol)
+	}
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListOpenOrdersService list opened orders
+type ListOpenOrdersService struct {
+	c         *Client
+	symbol    *string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// OrderID set orderID, return the orders from this order ID
+func (s *ListOpenOrdersService) OrderID(orderID int64) *ListOpenOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOpenOrdersService) StartTime(startTime int64) *ListOpenOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOpenOrdersService) EndTime(endTime int64) *ListOpenOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/eapi/v1/openOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	}
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,38 @@
+package options
+
+import (
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/internal/transport"
+)
+
+type secType = transport.SecType
+
+const (
+	secTypeNone   = transport.SecTypeNone
+	secTypeAPIKey = transport.SecTypeAPIKey
+	secTypeSigned = transport.SecTypeSigned
+)
+
+type params = transport.Params
+
+// request define an API request
+type request = transport.Request
+
+// RequestOption define option type for request
+type RequestOption = transport.RequestOption
+
+// WithRecvWindow set recvWindow param for the request
+func WithRecvWindow(recvWindow int64) RequestOption {
+	return transport.WithRecvWindow(recvWindow)
+}
+
+// WithHeader set or add a header value to the request
+func WithHeader(key, value string, replace bool) RequestOption {
+	return transport.WithHeader(key, value, replace)
+}
+
+// WithHeaders set or replace the headers of the request
+func WithHeaders(header http.Header) RequestOption {
+	return transport.WithHeaders(header)
+}
@@ -0,0 +1,79 @@
+package options
+
+import (
+	"context"
+	"net/http"
+)
+
+// StartUserStreamService create listen key for user stream service
+type StartUserStreamService struct {
+	c *Client
+}
+
+// Do send request
+func (s *StartUserStreamService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/eapi/v1/listenKey",
+		SecType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return "", err
+	}
+	var res ListenKey
+	err = json.Unmarshal(data, &res)
+	return res.ListenKey, err
+}
+
+type ListenKey struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// KeepaliveUserStreamService update listen key
+type KeepaliveUserStreamService struct {
+	c         *Client
+	listenKey string
+}
+
+// ListenKey set listen key
+func (s *KeepaliveUserStreamService) ListenKey(listenKey string) *KeepaliveUserStreamService {
+	s.listenKey = listenKey
+	return s
+}
+
+// Do send request
+func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodPut,
+		Endpoint: "/eapi/v1/listenKey",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("listenKey", s.listenKey)
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// CloseUserStreamService delete listen key
+type CloseUserStreamService struct {
+	c         *Client
+	listenKey string
+}
+
+// ListenKey set listen key
+func (s *CloseUserStreamService) ListenKey(listenKey string) *CloseUserStreamService {
+	s.listenKey = listenKey
+	return s
+}
+
+// Do send request
+func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/eapi/v1/listenKey",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("listenKey", s.listenKey)
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
@@ -0,0 +1,28 @@
+package options
+
+import (
+	"github.com/uncle-gua/gobinance/internal/transport"
+	"github.com/uncle-gua/wsc"
+)
+
+// WsHandler handle raw websocket message
+type WsHandler func(message []byte)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// WsConfig webservice configuration
+type WsConfig struct {
+	Endpoint string
+}
+
+func newWsConfig(endpoint string) *WsConfig {
+	return &WsConfig{
+		Endpoint: endpoint,
+	}
+}
+
+var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	ws = transport.NewWs(cfg.Endpoint, handler, errHandler)
+	return ws, transport.Serve(ws, false), nil
+}
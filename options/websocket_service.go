@@ -0,0 +1,263 @@
+package options
+
+import (
+	"fmt"
+
+	"github.com/uncle-gua/wsc"
+)
+
+// Endpoints
+const (
+	baseWsMainUrl = "wss://nbstream.binance.com/eoptions/ws"
+)
+
+// WsTickerEvent define websocket 24hr ticker event of an option
+type WsTickerEvent struct {
+	Event              string  `json:"e"`
+	Time               int64   `json:"E"`
+	TransactionTime    int64   `json:"T"`
+	Symbol             string  `json:"s"`
+	OpenPrice          float64 `json:"o,string"`
+	HighPrice          float64 `json:"h,string"`
+	LowPrice           float64 `json:"l,string"`
+	LastPrice          float64 `json:"c,string"`
+	Volume             float64 `json:"V,string"`
+	Amount             float64 `json:"A,string"`
+	PriceChangePercent float64 `json:"P,string"`
+	PriceChange        float64 `json:"p,string"`
+	LastQty            float64 `json:"Q,string"`
+	FirstTradeID       int64   `json:"F,string"`
+	LastTradeID        int64   `json:"L,string"`
+	TradeCount         int64   `json:"n"`
+	BidPrice           float64 `json:"bo,string"`
+	AskPrice           float64 `json:"ao,string"`
+	BidQty             float64 `json:"bq,string"`
+	AskQty             float64 `json:"aq,string"`
+	BidIV              float64 `json:"b,string"`
+	AskIV              float64 `json:"a,string"`
+	Delta              float64 `json:"d,string"`
+	Theta              float64 `json:"t,string"`
+	Gamma              float64 `json:"g,string"`
+	Vega               float64 `json:"v,string"`
+	ImpliedVolatility  float64 `json:"vo,string"`
+	MarkPrice          float64 `json:"mp,string"`
+	HighPriceLimit     float64 `json:"hl,string"`
+	LowPriceLimit      float64 `json:"ll,string"`
+	ExerciseEstimate   float64 `json:"eep,string"`
+}
+
+// WsTickerHandler handle websocket ticker event
+type WsTickerHandler func(event *WsTickerEvent)
+
+// WsTickerServe serve websocket that pushes the 24hr ticker of an option
+func WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", baseWsMainUrl, symbol)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsTickerEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsUnderlyingTickerHandler handle websocket ticker events of an underlying
+type WsUnderlyingTickerHandler func(event []*WsTickerEvent)
+
+// WsUnderlyingTickerServe serve websocket that pushes the 24hr tickers of all
+// the options of an underlying asset (e.g. ETH) and expiration (e.g. 220930)
+func WsUnderlyingTickerServe(underlyingAsset, expiration string, handler WsUnderlyingTickerHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker@%s", baseWsMainUrl, underlyingAsset, expiration)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		var event []*WsTickerEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsMarkPriceEvent define websocket mark price event of an option
+type WsMarkPriceEvent struct {
+	Event     string  `json:"e"`
+	Time      int64   `json:"E"`
+	Symbol    string  `json:"s"`
+	MarkPrice float64 `json:"mp,string"`
+}
+
+// WsMarkPriceHandler handle websocket mark price events of an underlying
+type WsMarkPriceHandler func(event []*WsMarkPriceEvent)
+
+// WsMarkPriceServe serve websocket that pushes the mark price of all the
+// options of an underlying asset, e.g. ETH
+func WsMarkPriceServe(underlyingAsset string, handler WsMarkPriceHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", baseWsMainUrl, underlyingAsset)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		var event []*WsMarkPriceEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsIndexPriceEvent define websocket index price event of an underlying
+type WsIndexPriceEvent struct {
+	Event  string  `json:"e"`
+	Time   int64   `json:"E"`
+	Symbol string  `json:"s"`
+	Price  float64 `json:"p,string"`
+}
+
+// WsIndexPriceHandler handle websocket index price event
+type WsIndexPriceHandler func(event *WsIndexPriceEvent)
+
+// WsIndexPriceServe serve websocket that pushes the index price of an
+// underlying, e.g. ETHUSDT
+func WsIndexPriceServe(underlying string, handler WsIndexPriceHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@index", baseWsMainUrl, underlying)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsIndexPriceEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsOpenInterestEvent define websocket open interest event of an option
+type WsOpenInterestEvent struct {
+	Event                string  `json:"e"`
+	Time                 int64   `json:"E"`
+	Symbol               string  `json:"s"`
+	OpenInterest         float64 `json:"o,string"`
+	OpenInterestNotional float64 `json:"h,string"`
+}
+
+// WsOpenInterestHandler handle websocket open interest events of an underlying
+type WsOpenInterestHandler func(event []*WsOpenInterestEvent)
+
+// WsOpenInterestServe serve websocket that pushes the open interest of all
+// the options of an underlying asset (e.g. ETH) and expiration (e.g. 221125)
+func WsOpenInterestServe(underlyingAsset, expiration string, handler WsOpenInterestHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@openInterest@%s", baseWsMainUrl, underlyingAsset, expiration)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		var event []*WsOpenInterestEvent
+		err := json.Unmarshal(message, &event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// WsUserDataEvent define user data event
+type WsUserDataEvent struct {
+	Event         UserDataEventType `json:"e"`
+	Time          int64             `json:"E"`
+	UID           int64             `json:"uid"`
+	Balances      []WsBalance       `json:"B"`
+	Greeks        []WsGreek         `json:"G"`
+	Positions     []WsPosition      `json:"P"`
+	Orders        []WsOrderUpdate   `json:"o"`
+	RiskLevel     string            `json:"s"`
+	MarginBalance float64           `json:"mb,string"`
+	MaintMargin   float64           `json:"mm,string"`
+}
+
+// WsBalance define balance
+type WsBalance struct {
+	Asset         string  `json:"a"`
+	Balance       float64 `json:"b,string"`
+	PositionValue float64 `json:"m,string"`
+	UnrealizedPnL float64 `json:"u,string"`
+	LongPnL       float64 `json:"U"`
+	MaintMargin   float64 `json:"M,string"`
+	InitialMargin float64 `json:"i,string"`
+}
+
+// WsGreek define the greeks of an underlying
+type WsGreek struct {
+	Underlying string  `json:"ui"`
+	Delta      float64 `json:"d"`
+	Theta      float64 `json:"t"`
+	Gamma      float64 `json:"g"`
+	Vega       float64 `json:"v"`
+}
+
+// WsPosition define position
+type WsPosition struct {
+	Symbol        string  `json:"s"`
+	Quantity      float64 `json:"c,string"`
+	ReducibleQty  float64 `json:"r,string"`
+	PositionValue float64 `json:"p,string"`
+	EntryPrice    float64 `json:"a,string"`
+}
+
+// WsOrderUpdate define order update
+type WsOrderUpdate struct {
+	CreateTime       int64           `json:"T"`
+	UpdateTime       int64           `json:"t"`
+	Symbol           string          `json:"s"`
+	ClientOrderID    string          `json:"c"`
+	OrderID          string          `json:"oid"`
+	Price            float64         `json:"p,string"`
+	Quantity         float64         `json:"q,string"`
+	ReduceOnly       bool            `json:"r"`
+	PostOnly         bool            `json:"po"`
+	Status           OrderStatusType `json:"S"`
+	ExecutedQuantity float64         `json:"e,string"`
+	ExecutedCost     float64         `json:"ec,string"`
+	Fee              float64         `json:"f,string"`
+	TimeInForce      TimeInForceType `json:"tif"`
+	Type             OrderType       `json:"oty"`
+	Fills            []WsFill        `json:"fi"`
+}
+
+// WsFill define a fill of an order update
+type WsFill struct {
+	TradeID   string  `json:"t"`
+	Price     float64 `json:"p,string"`
+	Quantity  float64 `json:"q,string"`
+	TradeTime int64   `json:"T"`
+	Liquidity string  `json:"m"`
+	Fee       float64 `json:"f,string"`
+}
+
+// WsUserDataHandler handle WsUserDataEvent
+type WsUserDataHandler func(event *WsUserDataEvent)
+
+// WsUserDataServe serve user data handler with listen key
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", baseWsMainUrl, listenKey)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsUserDataEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
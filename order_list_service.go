@@ -0,0 +1,419 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// OrderListLeg define an order of an order list: the above/below legs of an
+// OCO, the working/pending legs of an OTO or the pending above/below legs of
+// an OTOCO. Empty fields are not sent.
+type OrderListLeg struct {
+	Type          OrderType
+	Side          SideType // working and pending legs of OTO only
+	ClientOrderID string
+	Quantity      string // working and pending legs of OTO only
+	Price         string
+	StopPrice     string
+	TrailingDelta string
+	IcebergQty    string
+	TimeInForce   TimeInForceType
+	StrategyID    int64
+	StrategyType  int64
+}
+
+// setParams set the parameters of the leg, named after prefix
+func (l *OrderListLeg) setParams(prefix string, m params) {
+	if l == nil {
+		return
+	}
+	m[prefix+"Type"] = l.Type
+	if l.Side != "" {
+		m[prefix+"Side"] = l.Side
+	}
+	if l.ClientOrderID != "" {
+		m[prefix+"ClientOrderId"] = l.ClientOrderID
+	}
+	if l.Quantity != "" {
+		m[prefix+"Quantity"] = l.Quantity
+	}
+	if l.Price != "" {
+		m[prefix+"Price"] = l.Price
+	}
+	if l.StopPrice != "" {
+		m[prefix+"StopPrice"] = l.StopPrice
+	}
+	if l.TrailingDelta != "" {
+		m[prefix+"TrailingDelta"] = l.TrailingDelta
+	}
+	if l.IcebergQty != "" {
+		m[prefix+"IcebergQty"] = l.IcebergQty
+	}
+	if l.TimeInForce != "" {
+		m[prefix+"TimeInForce"] = l.TimeInForce
+	}
+	if l.StrategyID != 0 {
+		m[prefix+"StrategyId"] = l.StrategyID
+	}
+	if l.StrategyType != 0 {
+		m[prefix+"StrategyType"] = l.StrategyType
+	}
+}
+
+// OrderList define order list info
+type OrderList struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListStatusType    string      `json:"listStatusType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	TransactionTime   int64       `json:"transactionTime"`
+	Symbol            string      `json:"symbol"`
+	Orders            []*OCOOrder `json:"orders"`
+}
+
+// CreateOrderListResponse define create order list response
+type CreateOrderListResponse struct {
+	OrderList
+	OrderReports []*OCOOrderReport `json:"orderReports"`
+}
+
+func createOrderList(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOrderListOCOService create an OCO, one order above and one order
+// below the market, when one is filled or triggered the other is canceled
+type CreateOrderListOCOService struct {
+	c                 *Client
+	symbol            string
+	side              SideType
+	quantity          string
+	listClientOrderID *string
+	above             *OrderListLeg
+	below             *OrderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOCOService) Symbol(symbol string) *CreateOrderListOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderListOCOService) Side(side SideType) *CreateOrderListOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateOrderListOCOService) Quantity(quantity string) *CreateOrderListOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOrderListOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Above set the above leg, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER,
+// TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) Above(leg *OrderListLeg) *CreateOrderListOCOService {
+	s.above = leg
+	return s
+}
+
+// Below set the below leg, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER,
+// TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) Below(leg *OrderListLeg) *CreateOrderListOCOService {
+	s.below = leg
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	s.above.setParams("above", m)
+	s.below.setParams("below", m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oco", m, opts...)
+}
+
+// CreateOrderListOTOService create an OTO, the pending order is placed
+// when the working order is fully filled
+type CreateOrderListOTOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	working           *OrderListLeg
+	pending           *OrderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOService) Symbol(symbol string) *CreateOrderListOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOrderListOTOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOService) Working(leg *OrderListLeg) *CreateOrderListOTOService {
+	s.working = leg
+	return s
+}
+
+// Pending set the pending leg
+func (s *CreateOrderListOTOService) Pending(leg *OrderListLeg) *CreateOrderListOTOService {
+	s.pending = leg
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	s.working.setParams("working", m)
+	s.pending.setParams("pending", m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oto", m, opts...)
+}
+
+// CreateOrderListOTOCOService create an OTOCO, the pending OCO is placed
+// when the working order is fully filled
+type CreateOrderListOTOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	working           *OrderListLeg
+	pendingSide       SideType
+	pendingQuantity   string
+	pendingAbove      *OrderListLeg
+	pendingBelow      *OrderListLeg
+	newOrderRespType  *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOCOService) Symbol(symbol string) *CreateOrderListOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOrderListOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOCOService) Working(leg *OrderListLeg) *CreateOrderListOTOCOService {
+	s.working = leg
+	return s
+}
+
+// PendingSide set the side of the pending OCO
+func (s *CreateOrderListOTOCOService) PendingSide(side SideType) *CreateOrderListOTOCOService {
+	s.pendingSide = side
+	return s
+}
+
+// PendingQuantity set the quantity of the pending OCO
+func (s *CreateOrderListOTOCOService) PendingQuantity(quantity string) *CreateOrderListOTOCOService {
+	s.pendingQuantity = quantity
+	return s
+}
+
+// PendingAbove set the above leg of the pending OCO
+func (s *CreateOrderListOTOCOService) PendingAbove(leg *OrderListLeg) *CreateOrderListOTOCOService {
+	s.pendingAbove = leg
+	return s
+}
+
+// PendingBelow set the below leg of the pending OCO
+func (s *CreateOrderListOTOCOService) PendingBelow(leg *OrderListLeg) *CreateOrderListOTOCOService {
+	s.pendingBelow = leg
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	s.working.setParams("working", m)
+	s.pendingAbove.setParams("pendingAbove", m)
+	s.pendingBelow.setParams("pendingBelow", m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/otoco", m, opts...)
+}
+
+// GetOrderListService get an order list
+type GetOrderListService struct {
+	c                 *Client
+	orderListID       *int64
+	origClientOrderID *string
+}
+
+// OrderListID set orderListID
+func (s *GetOrderListService) OrderListID(orderListID int64) *GetOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetOrderListService) OrigClientOrderID(origClientOrderID string) *GetOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *OrderList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/orderList",
+		SecType:  secTypeSigned,
+	}
+	if s.orderListID != nil {
+		r.SetParam("orderListId", *s.orderListID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOrderListsService list all order lists
+type ListOrderListsService struct {
+	c         *Client
+	fromID    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// FromID set fromID
+func (s *ListOrderListsService) FromID(fromID int64) *ListOrderListsService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrderListsService) StartTime(startTime int64) *ListOrderListsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrderListsService) EndTime(endTime int64) *ListOrderListsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListOrderListsService) Limit(limit int) *ListOrderListsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/allOrderList",
+		SecType:  secTypeSigned,
+	}
+	if s.fromID != nil {
+		r.SetParam("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderList{}, err
+	}
+	res = make([]*OrderList, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderList{}, err
+	}
+	return res, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	stdjson "encoding/json"
 	"net/http"
+
+	"github.com/uncle-gua/gobinance/common"
 )
 
 // CreateOrderService create order
@@ -21,6 +23,8 @@ type CreateOrderService struct {
 	stopPrice        *string
 	trailingDelta    *string
 	icebergQuantity  *string
+
+	computeCommissionRates *bool
 }
 
 // Symbol set symbol
@@ -97,9 +101,9 @@ func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType)
 
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol": s.symbol,
@@ -133,7 +137,10 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
-	r.setFormParams(m)
+	if s.computeCommissionRates != nil {
+		m["computeCommissionRates"] = *s.computeCommissionRates
+	}
+	r.SetFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -161,6 +168,46 @@ func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (e
 	return err
 }
 
+// TestWithCommission send test api with computeCommissionRates, returning
+// the commission rates the order would pay
+func (s *CreateOrderService) TestWithCommission(ctx context.Context, opts ...RequestOption) (res *TestOrderResponse, err error) {
+	computeCommissionRates := true
+	s.computeCommissionRates = &computeCommissionRates
+	defer func() { s.computeCommissionRates = nil }()
+	data, err := s.createOrder(ctx, "/api/v3/order/test", opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TestOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TestOrderResponse define the commission rates returned by a test order
+type TestOrderResponse struct {
+	StandardCommissionForOrder CommissionRates    `json:"standardCommissionForOrder"`
+	SpecialCommissionForOrder  CommissionRates    `json:"specialCommissionForOrder"`
+	TaxCommissionForOrder      CommissionRates    `json:"taxCommissionForOrder"`
+	Discount                   CommissionDiscount `json:"discount"`
+}
+
+// CommissionRates define maker and taker commission rates
+type CommissionRates struct {
+	Maker string `json:"maker"`
+	Taker string `json:"taker"`
+}
+
+// CommissionDiscount define the commission discount paid with an asset, e.g. BNB
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"`
+}
+
 // CreateOrderResponse define create order response
 type CreateOrderResponse struct {
 	Symbol                   string `json:"symbol"`
@@ -194,6 +241,8 @@ type Fill struct {
 }
 
 // CreateOCOService create order
+//
+// Deprecated: /api/v3/order/oco is deprecated, use CreateOrderListOCOService
 type CreateOCOService struct {
 	c                    *Client
 	symbol               string
@@ -291,9 +340,9 @@ func (s *CreateOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *
 
 func (s *CreateOCOService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"symbol":    s.symbol,
@@ -327,7 +376,7 @@ func (s *CreateOCOService) createOrder(ctx context.Context, endpoint string, opt
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
-	r.setFormParams(m)
+	r.SetFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -409,9 +458,9 @@ type Oco struct {
 // Do send request
 func (s *ListOpenOcoService) Do(ctx context.Context, opts ...RequestOption) (res []*Oco, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/openOrderList ",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/openOrderList ",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -440,12 +489,12 @@ func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
 // Do send request
 func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/openOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/openOrders",
+		SecType:  secTypeSigned,
 	}
 	if s.symbol != "" {
-		r.setParam("symbol", s.symbol)
+		r.SetParam("symbol", s.symbol)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -488,16 +537,16 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 // Do send request
 func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/order",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -577,22 +626,22 @@ func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
 // Do send request
 func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/allOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/allOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
+		r.SetParam("orderId", *s.orderID)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -642,19 +691,19 @@ func (s *CancelOrderService) NewClientOrderID(newClientOrderID string) *CancelOr
 // Do send request
 func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/api/v3/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/api/v3/order",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setFormParam("orderId", *s.orderID)
+		r.SetFormParam("orderId", *s.orderID)
 	}
 	if s.origClientOrderID != nil {
-		r.setFormParam("origClientOrderId", *s.origClientOrderID)
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
 	}
 	if s.newClientOrderID != nil {
-		r.setFormParam("newClientOrderId", *s.newClientOrderID)
+		r.SetFormParam("newClientOrderId", *s.newClientOrderID)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -704,19 +753,19 @@ func (s *CancelOCOService) NewClientOrderID(newClientOrderID string) *CancelOCOS
 // Do send request
 func (s *CancelOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOCOResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/api/v3/orderList",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/api/v3/orderList",
+		SecType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
+	r.SetFormParam("symbol", s.symbol)
 	if s.listClientOrderID != "" {
-		r.setFormParam("listClientOrderId", s.listClientOrderID)
+		r.SetFormParam("listClientOrderId", s.listClientOrderID)
 	}
 	if s.orderListID != 0 {
-		r.setFormParam("orderListId", s.orderListID)
+		r.SetFormParam("orderListId", s.orderListID)
 	}
 	if s.newClientOrderID != "" {
-		r.setFormParam("newClientOrderId", s.newClientOrderID)
+		r.SetFormParam("newClientOrderId", s.newClientOrderID)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -745,11 +794,11 @@ func (s *CancelOpenOrdersService) Symbol(symbol string) *CancelOpenOrdersService
 // Do send request
 func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOpenOrdersResponse, err error) {
 	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/api/v3/openOrders",
-		secType:  secTypeSigned,
+		Method:   http.MethodDelete,
+		Endpoint: "/api/v3/openOrders",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
@@ -815,3 +864,334 @@ type CancelOCOResponse struct {
 	Orders            []*OCOOrder       `json:"orders"`
 	OrderReports      []*OCOOrderReport `json:"orderReports"`
 }
+
+// CancelReplaceService cancel an existing order and place a new order on the same symbol
+type CancelReplaceService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	cancelReplaceMode       CancelReplaceModeType
+	timeInForce             *TimeInForceType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	cancelNewClientOrderID  *string
+	cancelOrigClientOrderID *string
+	cancelOrderID           *int64
+	newClientOrderID        *string
+	stopPrice               *string
+	trailingDelta           *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	cancelRestrictions      *string
+}
+
+// Symbol set symbol
+func (s *CancelReplaceService) Symbol(symbol string) *CancelReplaceService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CancelReplaceService) Side(side SideType) *CancelReplaceService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CancelReplaceService) Type(orderType OrderType) *CancelReplaceService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *CancelReplaceService) CancelReplaceMode(cancelReplaceMode CancelReplaceModeType) *CancelReplaceService {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceService) Quantity(quantity string) *CancelReplaceService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceService) Price(price string) *CancelReplaceService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId
+func (s *CancelReplaceService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId
+func (s *CancelReplaceService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId
+func (s *CancelReplaceService) CancelOrderID(cancelOrderID int64) *CancelReplaceService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId
+func (s *CancelReplaceService) NewClientOrderID(newClientOrderID string) *CancelReplaceService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceService) StopPrice(stopPrice string) *CancelReplaceService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *CancelReplaceService) TrailingDelta(trailingDelta string) *CancelReplaceService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CancelReplaceService) IcebergQuantity(icebergQuantity string) *CancelReplaceService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions, ONLY_NEW or ONLY_PARTIALLY_FILLED
+func (s *CancelReplaceService) CancelRestrictions(cancelRestrictions string) *CancelReplaceService {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// Do send request. When one of the steps fails, the returned error is a
+// *common.APIError and res holds the result of both steps.
+func (s *CancelReplaceService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/api/v3/order/cancelReplace",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	r.SetFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		apiErr, ok := err.(*common.APIError)
+		if !ok || len(apiErr.Data) == 0 {
+			return nil, err
+		}
+		res = new(CancelReplaceResponse)
+		if e := json.Unmarshal(apiErr.Data, res); e != nil {
+			return nil, err
+		}
+		return res, err
+	}
+	res = new(CancelReplaceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceResponse define cancel replace response
+type CancelReplaceResponse struct {
+	CancelResult     CancelReplaceResultType      `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType      `json:"newOrderResult"`
+	CancelResponse   *CancelReplaceCancelResult   `json:"cancelResponse"`
+	NewOrderResponse *CancelReplaceNewOrderResult `json:"newOrderResponse"`
+}
+
+// CancelReplaceCancelResult define the cancel step of cancel replace,
+// Code and Message are set when it failed
+type CancelReplaceCancelResult struct {
+	CancelOrderResponse
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+// CancelReplaceNewOrderResult define the new order step of cancel replace,
+// Code and Message are set when it failed or was not attempted
+type CancelReplaceNewOrderResult struct {
+	CreateOrderResponse
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+// AmendKeepPriorityService reduce the quantity of an open order keeping its priority in the book
+type AmendKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	newQty            string
+}
+
+// Symbol set symbol
+func (s *AmendKeepPriorityService) Symbol(symbol string) *AmendKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderId
+func (s *AmendKeepPriorityService) OrderID(orderID int64) *AmendKeepPriorityService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderId
+func (s *AmendKeepPriorityService) OrigClientOrderID(origClientOrderID string) *AmendKeepPriorityService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId
+func (s *AmendKeepPriorityService) NewClientOrderID(newClientOrderID string) *AmendKeepPriorityService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewQty set newQty, must be less than the current quantity
+func (s *AmendKeepPriorityService) NewQty(newQty string) *AmendKeepPriorityService {
+	s.newQty = newQty
+	return s
+}
+
+// Do send request
+func (s *AmendKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendKeepPriorityResponse, err error) {
+	r := &request{
+		Method:   http.MethodPut,
+		Endpoint: "/api/v3/order/amend/keepPriority",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	r.SetFormParam("newQty", s.newQty)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.SetFormParam("newClientOrderId", *s.newClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendKeepPriorityResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AmendKeepPriorityResponse define amend keep priority response
+type AmendKeepPriorityResponse struct {
+	TransactTime int64             `json:"transactTime"`
+	ExecutionID  int64             `json:"executionId"`
+	AmendedOrder AmendedOrder      `json:"amendedOrder"`
+	ListStatus   *AmendedOrderList `json:"listStatus,omitempty"` // for orders of an order list
+}
+
+// AmendedOrder define the order after an amendment
+type AmendedOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderID                 int64           `json:"orderId"`
+	OrderListID             int64           `json:"orderListId"`
+	OrigClientOrderID       string          `json:"origClientOrderId"`
+	ClientOrderID           string          `json:"clientOrderId"`
+	Price                   string          `json:"price"`
+	Quantity                string          `json:"qty"`
+	ExecutedQuantity        string          `json:"executedQty"`
+	PreventedQuantity       string          `json:"preventedQty"`
+	QuoteOrderQuantity      string          `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity string          `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType `json:"status"`
+	TimeInForce             TimeInForceType `json:"timeInForce"`
+	Type                    OrderType       `json:"type"`
+	Side                    SideType        `json:"side"`
+	WorkingTime             int64           `json:"workingTime"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+}
+
+// AmendedOrderList define the order list of an amended order
+type AmendedOrderList struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	Symbol            string      `json:"symbol"`
+	Orders            []*OCOOrder `json:"orders"`
+}
@@ -0,0 +1,164 @@
+package paper
+
+import (
+	"math"
+	"sort"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+const epsilon = 1e-12
+
+// book define the order book snapshot of a simulated symbol
+type book struct {
+	bids []common.PriceLevel // best (highest) price first
+	asks []common.PriceLevel // best (lowest) price first
+}
+
+// fill define a simulated execution against the book
+type fill struct {
+	price float64
+	qty   float64
+	maker bool
+}
+
+func newBook(bids, asks []common.PriceLevel) *book {
+	b := &book{
+		bids: make([]common.PriceLevel, 0, len(bids)),
+		asks: make([]common.PriceLevel, 0, len(asks)),
+	}
+	for _, l := range bids {
+		if l.Quantity > 0 {
+			b.bids = append(b.bids, l)
+		}
+	}
+	for _, l := range asks {
+		if l.Quantity > 0 {
+			b.asks = append(b.asks, l)
+		}
+	}
+	sort.Slice(b.bids, func(i, j int) bool { return b.bids[i].Price > b.bids[j].Price })
+	sort.Slice(b.asks, func(i, j int) bool { return b.asks[i].Price < b.asks[j].Price })
+	return b
+}
+
+// mid return the middle of the best bid and ask, or the only side available
+func (b *book) mid() float64 {
+	switch {
+	case b == nil:
+		return 0
+	case len(b.bids) > 0 && len(b.asks) > 0:
+		return (b.bids[0].Price + b.asks[0].Price) / 2
+	case len(b.bids) > 0:
+		return b.bids[0].Price
+	case len(b.asks) > 0:
+		return b.asks[0].Price
+	}
+	return 0
+}
+
+// opposite return the levels an order of the given side trades against
+func (b *book) opposite(side string) *[]common.PriceLevel {
+	if side == sideBuy {
+		return &b.asks
+	}
+	return &b.bids
+}
+
+// crosses check if price is marketable for an order of the given side
+func crosses(side string, price, limit float64) bool {
+	if limit == 0 {
+		return true
+	}
+	if side == sideBuy {
+		return price <= limit+epsilon
+	}
+	return price >= limit-epsilon
+}
+
+// peek simulate the fills of an order without touching the book.
+// A positive quote spends a quote amount instead of a base quantity, and a
+// zero limit means no price limit.
+func (b *book) peek(side string, qty, quote, limit, step float64) (fills []fill) {
+	if b == nil {
+		return nil
+	}
+	for _, l := range *b.opposite(side) {
+		if !crosses(side, l.Price, limit) {
+			break
+		}
+		q := l.Quantity
+		if quote > 0 {
+			q = math.Min(q, roundStep(quote/l.Price, step))
+			quote -= q * l.Price
+		} else {
+			q = math.Min(q, qty)
+			qty -= q
+		}
+		if q <= epsilon {
+			break
+		}
+		fills = append(fills, fill{price: l.Price, qty: q})
+		if (quote <= epsilon && qty <= epsilon) || (quote > 0 && quote < l.Price*step) {
+			break
+		}
+	}
+	return fills
+}
+
+// consume remove the liquidity taken by fills from the book
+func (b *book) consume(side string, fills []fill) {
+	if b == nil {
+		return
+	}
+	levels := b.opposite(side)
+	for _, f := range fills {
+		for i := range *levels {
+			l := &(*levels)[i]
+			if math.Abs(l.Price-f.price) > epsilon && !f.maker {
+				continue
+			}
+			if f.maker && !crosses(side, l.Price, f.price) {
+				break
+			}
+			take := math.Min(l.Quantity, f.qty)
+			l.Quantity -= take
+			f.qty -= take
+			if f.qty <= epsilon {
+				break
+			}
+		}
+		kept := (*levels)[:0]
+		for _, l := range *levels {
+			if l.Quantity > epsilon {
+				kept = append(kept, l)
+			}
+		}
+		*levels = kept
+	}
+}
+
+func total(fills []fill) (qty, quote float64) {
+	for _, f := range fills {
+		qty += f.qty
+		quote += f.qty * f.price
+	}
+	return qty, quote
+}
+
+// roundStep round v down to a multiple of step
+func roundStep(v, step float64) float64 {
+	if step <= 0 {
+		return v
+	}
+	return math.Floor(v/step+1e-9) * step
+}
+
+// onStep check if v is a multiple of step
+func onStep(v, step float64) bool {
+	if step <= 0 {
+		return true
+	}
+	n := v / step
+	return math.Abs(n-math.Round(n)) < 1e-6
+}
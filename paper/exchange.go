@@ -0,0 +1,291 @@
+// Package paper implements a simulated exchange to dry-run order logic.
+//
+// An Exchange serves the order endpoints of the spot and futures REST APIs
+// from memory and can be plugged into any client through its HTTPClient:
+//
+//	ex := paper.NewExchange()
+//	client := binance.NewClient("", "")
+//	client.HTTPClient = ex.HTTPClient()
+//
+// Orders are matched against the book snapshots fed by UpdateBook, which may
+// come from live depth streams or replayed data. Requests to endpoints the
+// exchange does not simulate are forwarded to Next.
+package paper
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Symbol define trading rules and commission rates of a simulated symbol
+type Symbol struct {
+	Symbol          string
+	BaseAsset       string
+	QuoteAsset      string
+	TickSize        float64
+	StepSize        float64
+	MinQuantity     float64
+	MaxQuantity     float64
+	MinNotional     float64
+	MakerCommission float64 // rate, e.g. 0.001 for 0.1%
+	TakerCommission float64
+}
+
+// SymbolFromSpot build a simulated symbol from spot exchange info
+func SymbolFromSpot(s *binance.Symbol, maker, taker float64) *Symbol {
+	res := &Symbol{
+		Symbol:          s.Symbol,
+		BaseAsset:       s.BaseAsset,
+		QuoteAsset:      s.QuoteAsset,
+		MakerCommission: maker,
+		TakerCommission: taker,
+	}
+	if f := s.LotSizeFilter(); f != nil {
+		res.MinQuantity, _ = strconv.ParseFloat(f.MinQuantity, 64)
+		res.MaxQuantity, _ = strconv.ParseFloat(f.MaxQuantity, 64)
+		res.StepSize, _ = strconv.ParseFloat(f.StepSize, 64)
+	}
+	if f := s.PriceFilter(); f != nil {
+		res.TickSize, _ = strconv.ParseFloat(f.TickSize, 64)
+	}
+	if f := s.MinNotionalFilter(); f != nil {
+		res.MinNotional, _ = strconv.ParseFloat(f.MinNotional, 64)
+	}
+	for _, filter := range s.Filters {
+		if filter["filterType"] == "NOTIONAL" {
+			if v, ok := filter["minNotional"].(string); ok {
+				res.MinNotional, _ = strconv.ParseFloat(v, 64)
+			}
+		}
+	}
+	return res
+}
+
+// SymbolFromFutures build a simulated symbol from futures exchange info
+func SymbolFromFutures(s *futures.Symbol, maker, taker float64) *Symbol {
+	res := &Symbol{
+		Symbol:          s.Symbol,
+		BaseAsset:       s.BaseAsset,
+		QuoteAsset:      s.MarginAsset,
+		MakerCommission: maker,
+		TakerCommission: taker,
+	}
+	if f := s.LotSizeFilter(); f != nil {
+		res.MinQuantity = f.MinQuantity
+		res.MaxQuantity = f.MaxQuantity
+		res.StepSize = f.StepSize
+	}
+	if f := s.PriceFilter(); f != nil {
+		res.TickSize = f.TickSize
+	}
+	if f := s.MinNotionalFilter(); f != nil {
+		res.MinNotional, _ = strconv.ParseFloat(f.Notional, 64)
+	}
+	return res
+}
+
+// Exchange define a simulated spot and futures exchange
+type Exchange struct {
+	// Next handles the requests the exchange does not simulate,
+	// http.DefaultTransport when nil
+	Next http.RoundTripper
+	// Now returns the exchange time, time.Now when nil
+	Now func() time.Time
+	// DualSide enables the futures hedge mode
+	DualSide bool
+	// Leverage is the futures leverage used for margin checks, 1 when zero
+	Leverage float64
+	// SpotHandler receives the simulated spot user data events
+	SpotHandler binance.WsUserDataHandler
+	// FuturesHandler receives the simulated futures user data events
+	FuturesHandler futures.WsUserDataHandler
+
+	mu          sync.Mutex
+	symbols     map[string]*Symbol
+	books       map[string]*book
+	orders      map[int64]*order
+	nextID      int64
+	nextTradeID int64
+	balances    map[string]*spotBalance
+	wallet      map[string]float64
+	positions   map[string]*position
+	spotEvents  []*binance.WsUserDataEvent
+	futEvents   []*futures.WsUserDataEvent
+}
+
+// NewExchange init a simulated exchange without symbols nor funds
+func NewExchange() *Exchange {
+	return &Exchange{
+		symbols:   make(map[string]*Symbol),
+		books:     make(map[string]*book),
+		orders:    make(map[int64]*order),
+		balances:  make(map[string]*spotBalance),
+		wallet:    make(map[string]float64),
+		positions: make(map[string]*position),
+	}
+}
+
+// HTTPClient return a http client served by the exchange
+func (e *Exchange) HTTPClient() *http.Client {
+	return &http.Client{Transport: e}
+}
+
+// AddSymbol register a tradable symbol
+func (e *Exchange) AddSymbol(s *Symbol) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.symbols[s.Symbol] = s
+}
+
+// SetSpotBalance set the free spot balance of an asset
+func (e *Exchange) SetSpotBalance(asset string, free float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spotBalance(asset).free = free
+}
+
+// SetFuturesBalance set the futures wallet balance of an asset
+func (e *Exchange) SetFuturesBalance(asset string, balance float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.wallet[asset] = balance
+}
+
+// UpdateBook replace the book of a symbol and match the open orders against it
+func (e *Exchange) UpdateBook(symbol string, bids, asks []common.PriceLevel) {
+	e.mu.Lock()
+	e.books[symbol] = newBook(bids, asks)
+	e.match(symbol)
+	e.mu.Unlock()
+	e.dispatch()
+}
+
+// FeedSpotDepth update the book from a spot partial depth event
+func (e *Exchange) FeedSpotDepth(event *binance.WsPartialDepthEvent) {
+	e.UpdateBook(event.Symbol, event.Bids, event.Asks)
+}
+
+// FeedFuturesDepth update the book from a futures partial depth event
+func (e *Exchange) FeedFuturesDepth(event *futures.WsDepthEvent) {
+	e.UpdateBook(event.Symbol, event.Bids, event.Asks)
+}
+
+type handlerFunc func(e *Exchange, v url.Values) (interface{}, error)
+
+type route struct {
+	method   string
+	endpoint string
+}
+
+var routes = map[route]handlerFunc{
+	{http.MethodPost, "/api/v3/order"}:            (*Exchange).spotCreateOrder,
+	{http.MethodPost, "/api/v3/order/test"}:       (*Exchange).spotTestOrder,
+	{http.MethodGet, "/api/v3/order"}:             (*Exchange).spotGetOrder,
+	{http.MethodDelete, "/api/v3/order"}:          (*Exchange).spotCancelOrder,
+	{http.MethodGet, "/api/v3/openOrders"}:        (*Exchange).spotListOpenOrders,
+	{http.MethodDelete, "/api/v3/openOrders"}:     (*Exchange).spotCancelOpenOrders,
+	{http.MethodGet, "/api/v3/account"}:           (*Exchange).spotAccount,
+	{http.MethodPost, "/fapi/v1/order"}:           (*Exchange).futuresCreateOrder,
+	{http.MethodPut, "/fapi/v1/order"}:            (*Exchange).futuresAmendOrder,
+	{http.MethodGet, "/fapi/v1/order"}:            (*Exchange).futuresGetOrder,
+	{http.MethodDelete, "/fapi/v1/order"}:         (*Exchange).futuresCancelOrder,
+	{http.MethodGet, "/fapi/v1/openOrder"}:        (*Exchange).futuresGetOrder,
+	{http.MethodGet, "/fapi/v1/openOrders"}:       (*Exchange).futuresListOpenOrders,
+	{http.MethodPost, "/fapi/v1/batchOrders"}:     (*Exchange).futuresCreateBatchOrders,
+	{http.MethodDelete, "/fapi/v1/batchOrders"}:   (*Exchange).futuresCancelBatchOrders,
+	{http.MethodDelete, "/fapi/v1/allOpenOrders"}: (*Exchange).futuresCancelAllOpenOrders,
+	{http.MethodGet, "/fapi/v2/positionRisk"}:     (*Exchange).futuresPositionRisk,
+	{http.MethodGet, "/fapi/v2/balance"}:          (*Exchange).futuresBalance,
+	{http.MethodGet, "/fapi/v2/account"}:          (*Exchange).futuresAccount,
+}
+
+// RoundTrip implement http.RoundTripper
+func (e *Exchange) RoundTrip(req *http.Request) (*http.Response, error) {
+	h, ok := routes[route{req.Method, req.URL.Path}]
+	if !ok {
+		next := e.Next
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return next.RoundTrip(req)
+	}
+	v := req.URL.Query()
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range form {
+			v[key] = values
+		}
+	}
+	e.mu.Lock()
+	res, err := h(e, v)
+	e.mu.Unlock()
+	e.dispatch()
+
+	status := http.StatusOK
+	if err != nil {
+		status = http.StatusBadRequest
+		res = err
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
+
+// dispatch deliver the queued user data events outside of the lock, so
+// handlers may call back into the exchange
+func (e *Exchange) dispatch() {
+	e.mu.Lock()
+	spot, fut := e.spotEvents, e.futEvents
+	e.spotEvents, e.futEvents = nil, nil
+	spotHandler, futHandler := e.SpotHandler, e.FuturesHandler
+	e.mu.Unlock()
+	for _, event := range spot {
+		if spotHandler != nil {
+			spotHandler(event)
+		}
+	}
+	for _, event := range fut {
+		if futHandler != nil {
+			futHandler(event)
+		}
+	}
+}
+
+func (e *Exchange) timestamp() int64 {
+	if e.Now != nil {
+		return e.Now().UnixNano() / int64(time.Millisecond)
+	}
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
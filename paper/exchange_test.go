@@ -0,0 +1,232 @@
+package paper_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/gobinance/paper"
+)
+
+func newExchange() *paper.Exchange {
+	ex := paper.NewExchange()
+	ex.AddSymbol(&paper.Symbol{
+		Symbol:          "BTCUSDT",
+		BaseAsset:       "BTC",
+		QuoteAsset:      "USDT",
+		TickSize:        0.1,
+		StepSize:        0.001,
+		MinQuantity:     0.001,
+		MinNotional:     5,
+		MakerCommission: 0.0002,
+		TakerCommission: 0.0004,
+	})
+	ex.UpdateBook("BTCUSDT",
+		[]common.PriceLevel{{Price: 99, Quantity: 1}, {Price: 98, Quantity: 2}},
+		[]common.PriceLevel{{Price: 101, Quantity: 1}, {Price: 102, Quantity: 2}},
+	)
+	return ex
+}
+
+func TestPaperSpot(t *testing.T) {
+	ex := newExchange()
+	ex.SetSpotBalance("USDT", 1000)
+	var events []*binance.WsUserDataEvent
+	ex.SpotHandler = func(event *binance.WsUserDataEvent) {
+		events = append(events, event)
+	}
+
+	client := binance.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+
+	res, err := client.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeMarket).
+		Quantity("1.5").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != binance.OrderStatusTypeFilled || res.CummulativeQuoteQuantity != "152" || len(res.Fills) != 2 {
+		t.Fatalf("unexpected response %+v", res)
+	}
+
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("1").Price("100").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != binance.OrderStatusTypeNew {
+		t.Fatalf("unexpected status %s", order.Status)
+	}
+
+	ex.UpdateBook("BTCUSDT",
+		[]common.PriceLevel{{Price: 98, Quantity: 1}},
+		[]common.PriceLevel{{Price: 99.5, Quantity: 0.4}},
+	)
+	got, err := client.NewGetOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != binance.OrderStatusTypePartiallyFilled || got.ExecutedQuantity != "0.4" {
+		t.Fatalf("unexpected order %+v", got)
+	}
+
+	if _, err = client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(context.Background()); err == nil || !common.IsAPIError(err) {
+		t.Fatalf("expected api error, got %v", err)
+	}
+
+	account, err := client.NewGetAccountService().Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range account.Balances {
+		switch b.Asset {
+		case "USDT":
+			if math.Abs(b.Free-(1000-152-40)) > 1e-9 || b.Locked != 0 {
+				t.Fatalf("unexpected balance %+v", b)
+			}
+		case "BTC":
+			if math.Abs(b.Free-(1.5*(1-0.0004)+0.4*(1-0.0002))) > 1e-9 {
+				t.Fatalf("unexpected balance %+v", b)
+			}
+		}
+	}
+
+	var trades int
+	for _, event := range events {
+		if event.Event == binance.UserDataEventTypeExecutionReport && event.OrderUpdate.ExecutionType == "TRADE" {
+			trades++
+		}
+	}
+	if trades != 3 {
+		t.Fatalf("expected 3 trades, got %d", trades)
+	}
+}
+
+func TestPaperFutures(t *testing.T) {
+	ex := newExchange()
+	ex.SetFuturesBalance("USDT", 1000)
+	var events []*futures.WsUserDataEvent
+	ex.FuturesHandler = func(event *futures.WsUserDataEvent) {
+		events = append(events, event)
+	}
+
+	client := futures.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+
+	_, err := client.NewCreateOrderService().Symbol("BTCUSDT").NewClientOrderID("entry").
+		Side(futures.SideTypeBuy).Type(futures.OrderTypeMarket).
+		Quantity("2").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch, err := client.NewCreateBatchOrdersService().OrderList([]*futures.CreateOrderService{
+		client.NewCreateOrderService().Symbol("BTCUSDT").NewClientOrderID("tp").
+			Side(futures.SideTypeSell).Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+			Quantity("1").Price("110").ReduceOnly(true),
+		client.NewCreateOrderService().Symbol("BTCUSDT").NewClientOrderID("sl").
+			Side(futures.SideTypeSell).Type(futures.OrderTypeStopMarket).
+			StopPrice("90").ClosePosition(true),
+	}).Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batch.Orders) != 2 {
+		t.Fatalf("expected 2 orders, got %d", len(batch.Orders))
+	}
+
+	amended, err := client.NewAmendOrderService().Symbol("BTCUSDT").OrigClientOrderID("tp").
+		Side(futures.SideTypeSell).Quantity("1").Price("105").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amended.Price != 105 {
+		t.Fatalf("unexpected price %v", amended.Price)
+	}
+
+	ex.UpdateBook("BTCUSDT",
+		[]common.PriceLevel{{Price: 106, Quantity: 5}},
+		[]common.PriceLevel{{Price: 107, Quantity: 5}},
+	)
+	positions, err := client.NewGetPositionRiskService().Symbol("BTCUSDT").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 1 || positions[0].PositionAmt != 1 || positions[0].EntryPrice != 101.5 {
+		t.Fatalf("unexpected positions %+v", positions[0])
+	}
+
+	ex.UpdateBook("BTCUSDT",
+		[]common.PriceLevel{{Price: 88, Quantity: 5}},
+		[]common.PriceLevel{{Price: 89, Quantity: 5}},
+	)
+	sl, err := client.NewGetOrderService().Symbol("BTCUSDT").OrigClientOrderID("sl").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sl.Status != futures.OrderStatusTypeFilled || sl.ExecutedQuantity != 1 {
+		t.Fatalf("unexpected order %+v", sl)
+	}
+
+	balances, err := client.NewGetBalanceService().Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pnl := (105 - 101.5) + (88 - 101.5)
+	fees := 203*0.0004 + 105*0.0002 + 88*0.0004
+	if len(balances) != 1 || math.Abs(balances[0].Balance-(1000+pnl-fees)) > 1e-9 {
+		t.Fatalf("unexpected balances %+v", balances)
+	}
+
+	var updates int
+	for _, event := range events {
+		if event.Event == futures.UserDataEventTypeOrderTradeUpdate {
+			updates++
+		}
+	}
+	if updates == 0 {
+		t.Fatal("expected order trade updates")
+	}
+}
+
+func TestPaperNoBook(t *testing.T) {
+	ex := newExchange()
+	ex.AddSymbol(&paper.Symbol{
+		Symbol:     "ETHUSDT",
+		BaseAsset:  "ETH",
+		QuoteAsset: "USDT",
+		TickSize:   0.01,
+		StepSize:   0.001,
+	})
+	ex.SetSpotBalance("USDT", 1000)
+
+	client := binance.NewClient("", "")
+	client.HTTPClient = ex.HTTPClient()
+
+	res, err := client.NewCreateOrderService().Symbol("ETHUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeMarket).
+		Quantity("1").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != binance.OrderStatusTypeExpired || res.ExecutedQuantity != "0" {
+		t.Fatalf("unexpected response %+v", res)
+	}
+
+	order, err := client.NewCreateOrderService().Symbol("ETHUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("1").Price("100").Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Status != binance.OrderStatusTypeNew {
+		t.Fatalf("unexpected status %s", order.Status)
+	}
+}
@@ -0,0 +1,594 @@
+package paper
+
+import (
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// position define a simulated futures position, negative amounts are short
+type position struct {
+	symbol      string
+	side        string
+	amt         float64
+	entryPrice  float64
+	accumulated float64
+}
+
+func (e *Exchange) position(symbol, side string) *position {
+	key := symbol + "/" + side
+	p, ok := e.positions[key]
+	if !ok {
+		p = &position{symbol: symbol, side: side}
+		e.positions[key] = p
+	}
+	return p
+}
+
+func (e *Exchange) leverage() float64 {
+	if e.Leverage <= 0 {
+		return 1
+	}
+	return e.Leverage
+}
+
+// reducing check if a futures order can only decrease a position
+func (e *Exchange) reducing(o *order) bool {
+	switch {
+	case o.positionSide == positionSideLong:
+		return o.side == sideSell
+	case o.positionSide == positionSideShort:
+		return o.side == sideBuy
+	}
+	return o.reduceOnly || o.closePosition
+}
+
+// closeable return the position size a futures order may close
+func (e *Exchange) closeable(o *order) float64 {
+	amt := e.position(o.symbol, o.positionSide).amt
+	if o.side == sideBuy {
+		amt = -amt
+	}
+	return math.Max(amt, 0)
+}
+
+// futuresFillable cap reduce only and close position orders by the position
+func (e *Exchange) futuresFillable(o *order) float64 {
+	if o.closePosition {
+		qty := e.closeable(o)
+		o.origQty = o.executedQty + qty
+		return qty
+	}
+	if e.reducing(o) {
+		return math.Min(o.remaining(), e.closeable(o))
+	}
+	return o.remaining()
+}
+
+// unrealizedPnL return the unrealized profit of a position at the book mid
+func (e *Exchange) unrealizedPnL(p *position) float64 {
+	mark := e.books[p.symbol].mid()
+	if mark == 0 {
+		return 0
+	}
+	return p.amt * (mark - p.entryPrice)
+}
+
+// availableMargin return the margin left for new positions in an asset
+func (e *Exchange) availableMargin(asset string) float64 {
+	avail := e.wallet[asset]
+	lev := e.leverage()
+	for _, p := range e.positions {
+		if e.symbols[p.symbol].QuoteAsset != asset {
+			continue
+		}
+		avail += e.unrealizedPnL(p)
+		avail -= math.Abs(p.amt) * p.entryPrice / lev
+	}
+	for _, o := range e.openOrders(marketFutures, "") {
+		if e.symbols[o.symbol].QuoteAsset == asset && !e.reducing(o) {
+			avail -= o.remaining() * e.orderPrice(o) / lev
+		}
+	}
+	return avail
+}
+
+// orderPrice estimate the execution price of an order
+func (e *Exchange) orderPrice(o *order) float64 {
+	if p := o.limitPrice(); p > 0 {
+		return p
+	}
+	if o.stopPrice > 0 {
+		return o.stopPrice
+	}
+	return e.books[o.symbol].mid()
+}
+
+// futuresSettle update the position and wallet with a futures fill
+func (e *Exchange) futuresSettle(o *order, f fill) *trade {
+	s := e.symbols[o.symbol]
+	p := e.position(o.symbol, o.positionSide)
+	d := f.qty
+	if o.side == sideSell {
+		d = -d
+	}
+	var pnl float64
+	if p.amt == 0 || (p.amt > 0) == (d > 0) {
+		size := math.Abs(p.amt) + f.qty
+		p.entryPrice = (math.Abs(p.amt)*p.entryPrice + f.qty*f.price) / size
+		p.amt += d
+	} else {
+		closed := math.Min(f.qty, math.Abs(p.amt))
+		if p.amt > 0 {
+			pnl = closed * (f.price - p.entryPrice)
+		} else {
+			pnl = closed * (p.entryPrice - f.price)
+		}
+		p.amt += d
+		switch {
+		case math.Abs(p.amt) <= epsilon:
+			p.amt, p.entryPrice = 0, 0
+		case closed < f.qty:
+			p.entryPrice = f.price
+		}
+	}
+	rate := s.TakerCommission
+	if f.maker {
+		rate = s.MakerCommission
+	}
+	t := &trade{
+		fill:            f,
+		commission:      f.qty * f.price * rate,
+		commissionAsset: s.QuoteAsset,
+		realizedPnL:     pnl,
+	}
+	p.accumulated += pnl
+	e.wallet[s.QuoteAsset] += pnl - t.commission
+	return t
+}
+
+// futuresEmit queue an ORDER_TRADE_UPDATE, followed by an ACCOUNT_UPDATE on fills
+func (e *Exchange) futuresEmit(o *order, executionType string, t *trade) {
+	now := e.timestamp()
+	u := futures.WsOrderTradeUpdate{
+		Symbol:               o.symbol,
+		ClientOrderID:        o.clientOrderID,
+		Side:                 futures.SideType(o.side),
+		Type:                 futures.OrderType(o.orderType),
+		TimeInForce:          futures.TimeInForceType(o.timeInForce),
+		OriginalQty:          o.origQty,
+		OriginalPrice:        o.price,
+		AveragePrice:         o.avgPrice(),
+		StopPrice:            o.stopPrice,
+		ExecutionType:        futures.OrderExecutionType(executionType),
+		Status:               futures.OrderStatusType(o.status),
+		ID:                   o.id,
+		AccumulatedFilledQty: o.executedQty,
+		TradeTime:            now,
+		IsReduceOnly:         o.reduceOnly,
+		WorkingType:          futures.WorkingType(o.workingType),
+		OriginalType:         futures.OrderType(o.origType),
+		PositionSide:         futures.PositionSideType(o.positionSide),
+		IsClosingPosition:    o.closePosition,
+	}
+	if t != nil {
+		u.LastFilledQty = t.fill.qty
+		u.LastFilledPrice = t.fill.price
+		u.CommissionAsset = t.commissionAsset
+		u.Commission = t.commission
+		u.TradeID = t.id
+		u.IsMaker = t.fill.maker
+		u.RealizedPnL = t.realizedPnL
+	}
+	e.futEvents = append(e.futEvents, &futures.WsUserDataEvent{
+		Event:            futures.UserDataEventTypeOrderTradeUpdate,
+		Time:             now,
+		TransactionTime:  now,
+		OrderTradeUpdate: u,
+	})
+	if t == nil {
+		return
+	}
+
+	asset := e.symbols[o.symbol].QuoteAsset
+	p := e.position(o.symbol, o.positionSide)
+	e.futEvents = append(e.futEvents, &futures.WsUserDataEvent{
+		Event:           futures.UserDataEventTypeAccountUpdate,
+		Time:            now,
+		TransactionTime: now,
+		AccountUpdate: futures.WsAccountUpdate{
+			Reason: futures.UserDataEventReasonTypeOrder,
+			Balances: []futures.WsBalance{{
+				Asset:              asset,
+				Balance:            e.wallet[asset],
+				CrossWalletBalance: e.wallet[asset],
+				ChangeBalance:      0,
+			}},
+			Positions: []futures.WsPosition{{
+				Symbol:              p.symbol,
+				PositionSide:        futures.PositionSideType(p.side),
+				PositionAmt:         p.amt,
+				MarginType:          futures.MarginTypeCrossed,
+				EntryPrice:          p.entryPrice,
+				BreakEvenPrice:      p.entryPrice,
+				UnrealizedPnL:       e.unrealizedPnL(p),
+				AccumulatedRealized: p.accumulated,
+			}},
+		},
+	})
+}
+
+func (e *Exchange) futuresNewOrder(v url.Values) (*order, error) {
+	o, err := e.parseOrder(marketFutures, v)
+	if err != nil {
+		return nil, err
+	}
+	if o.positionSide == "" {
+		o.positionSide = positionSideBoth
+	}
+	if e.DualSide == (o.positionSide == positionSideBoth) {
+		return nil, errPositionSide
+	}
+	if o.reduceOnly && e.DualSide {
+		return nil, apiError(-1106, "Parameter 'reduceonly' sent when not required.")
+	}
+	if o.orderType == "LIMIT_MAKER" {
+		return nil, apiError(-1116, "Invalid orderType.")
+	}
+	if o.workingType == "" && o.conditional() {
+		o.workingType = "CONTRACT_PRICE"
+	}
+	if o.timeInForce == "" {
+		o.timeInForce = "GTC"
+	}
+	if err = e.validate(o); err != nil {
+		return nil, err
+	}
+	if o.conditional() && o.shouldTrigger(e.books[o.symbol].mid()) {
+		return nil, errImmediateTrigger
+	}
+	if e.reducing(o) && !o.conditional() && e.closeable(o) <= epsilon {
+		return nil, errReduceOnly
+	}
+	if !e.reducing(o) {
+		required := o.origQty * e.orderPrice(o) / e.leverage()
+		if required > e.availableMargin(e.symbols[o.symbol].QuoteAsset)+epsilon {
+			return nil, errMarginInsufficent
+		}
+	}
+	return o, nil
+}
+
+func (e *Exchange) futuresPlace(v url.Values) (*order, error) {
+	o, err := e.futuresNewOrder(v)
+	if err != nil {
+		return nil, err
+	}
+	e.accept(o)
+	e.process(o)
+	return o, nil
+}
+
+func futuresOrder(o *order) *futures.Order {
+	return &futures.Order{
+		Symbol:           o.symbol,
+		OrderID:          o.id,
+		ClientOrderID:    o.clientOrderID,
+		Price:            o.price,
+		ReduceOnly:       o.reduceOnly,
+		OrigQuantity:     o.origQty,
+		ExecutedQuantity: o.executedQty,
+		CumQuantity:      o.executedQty,
+		CumQuote:         o.cumQuote,
+		Status:           futures.OrderStatusType(o.status),
+		TimeInForce:      futures.TimeInForceType(o.timeInForce),
+		Type:             futures.OrderType(o.orderType),
+		OrigType:         futures.OrderType(o.origType),
+		Side:             futures.SideType(o.side),
+		StopPrice:        o.stopPrice,
+		Time:             o.time,
+		UpdateTime:       o.updateTime,
+		WorkingType:      futures.WorkingType(o.workingType),
+		AvgPrice:         o.avgPrice(),
+		PositionSide:     futures.PositionSideType(o.positionSide),
+		ClosePosition:    o.closePosition,
+	}
+}
+
+func futuresCancelResponse(o *order) *futures.CancelOrderResponse {
+	return &futures.CancelOrderResponse{
+		ClientOrderID:    o.clientOrderID,
+		CumQuantity:      o.executedQty,
+		CumQuote:         o.cumQuote,
+		ExecutedQuantity: o.executedQty,
+		OrderID:          o.id,
+		OrigQuantity:     o.origQty,
+		Price:            o.price,
+		ReduceOnly:       o.reduceOnly,
+		Side:             futures.SideType(o.side),
+		Status:           futures.OrderStatusType(o.status),
+		StopPrice:        o.stopPrice,
+		Symbol:           o.symbol,
+		TimeInForce:      futures.TimeInForceType(o.timeInForce),
+		Type:             futures.OrderType(o.orderType),
+		OrigType:         futures.OrderType(o.origType),
+		UpdateTime:       o.updateTime,
+		WorkingType:      futures.WorkingType(o.workingType),
+		PositionSide:     futures.PositionSideType(o.positionSide),
+	}
+}
+
+func (e *Exchange) futuresCreateOrder(v url.Values) (interface{}, error) {
+	o, err := e.futuresPlace(v)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.CreateOrderResponse{
+		Symbol:           o.symbol,
+		OrderID:          o.id,
+		ClientOrderID:    o.clientOrderID,
+		Price:            o.price,
+		OrigQuantity:     o.origQty,
+		ExecutedQuantity: o.executedQty,
+		CumQuote:         o.cumQuote,
+		ReduceOnly:       o.reduceOnly,
+		Status:           futures.OrderStatusType(o.status),
+		StopPrice:        o.stopPrice,
+		TimeInForce:      futures.TimeInForceType(o.timeInForce),
+		Type:             futures.OrderType(o.orderType),
+		OrigType:         futures.OrderType(o.origType),
+		Side:             futures.SideType(o.side),
+		UpdateTime:       o.updateTime,
+		WorkingType:      futures.WorkingType(o.workingType),
+		AvgPrice:         o.avgPrice(),
+		PositionSide:     futures.PositionSideType(o.positionSide),
+		ClosePosition:    o.closePosition,
+	}, nil
+}
+
+func (e *Exchange) futuresCreateBatchOrders(v url.Values) (interface{}, error) {
+	var batch []map[string]interface{}
+	if err := json.Unmarshal([]byte(v.Get("batchOrders")), &batch); err != nil {
+		return nil, errMandatory("batchOrders")
+	}
+	if len(batch) > 5 {
+		return nil, apiError(-4035, "Order list should be less than or equal to 5.")
+	}
+	res := make([]interface{}, 0, len(batch))
+	for _, m := range batch {
+		params := url.Values{}
+		for key, value := range m {
+			params.Set(key, strings.TrimSpace(toString(value)))
+		}
+		o, err := e.futuresPlace(params)
+		if err != nil {
+			res = append(res, err)
+			continue
+		}
+		res = append(res, futuresOrder(o))
+	}
+	return res, nil
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func (e *Exchange) futuresAmendOrder(v url.Values) (interface{}, error) {
+	o, err := e.lookup(marketFutures, v)
+	if err != nil {
+		return nil, err
+	}
+	if o.done() {
+		return nil, apiError(-5027, "No need to modify the order.")
+	}
+	if o.origType != "LIMIT" {
+		return nil, apiError(-4171, "Modification is only supported for limit orders.")
+	}
+	if side := v.Get("side"); side != o.side {
+		return nil, apiError(-4179, "Side is not matched.")
+	}
+	qty, err := parseFloat(v, "quantity")
+	if err != nil {
+		return nil, err
+	}
+	price, err := parseFloat(v, "price")
+	if err != nil {
+		return nil, err
+	}
+	s := e.symbols[o.symbol]
+	if qty <= o.executedQty+epsilon || !onStep(qty, s.StepSize) {
+		return nil, errFilter("LOT_SIZE")
+	}
+	if price <= 0 || !onStep(price, s.TickSize) {
+		return nil, errFilter("PRICE_FILTER")
+	}
+	o.origQty, o.price = qty, price
+	o.updateTime = e.timestamp()
+	e.emit(o, executionAmended, nil)
+	e.matchOrder(e.books[o.symbol], o)
+	return &futures.AmendOrderResponse{
+		OrderID:          o.id,
+		Symbol:           o.symbol,
+		Status:           futures.OrderStatusType(o.status),
+		ClientOrderID:    o.clientOrderID,
+		Price:            o.price,
+		AvgPrice:         o.avgPrice(),
+		OrigQuantity:     o.origQty,
+		ExecutedQuantity: o.executedQty,
+		CumQty:           o.executedQty,
+		CumBase:          o.cumQuote,
+		TimeInForce:      futures.TimeInForceType(o.timeInForce),
+		Type:             futures.OrderType(o.orderType),
+		OrigType:         futures.OrderType(o.origType),
+		ReduceOnly:       o.reduceOnly,
+		ClosePosition:    o.closePosition,
+		Side:             futures.SideType(o.side),
+		PositionSide:     futures.PositionSideType(o.positionSide),
+		StopPrice:        o.stopPrice,
+		WorkingType:      futures.WorkingType(o.workingType),
+		UpdateTime:       o.updateTime,
+	}, nil
+}
+
+func (e *Exchange) futuresGetOrder(v url.Values) (interface{}, error) {
+	o, err := e.lookup(marketFutures, v)
+	if err != nil {
+		return nil, err
+	}
+	return futuresOrder(o), nil
+}
+
+func (e *Exchange) futuresCancel(v url.Values) (*order, error) {
+	o, err := e.lookup(marketFutures, v)
+	if err == errOrderNotExist || (err == nil && o.done()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.finish(o, statusCanceled)
+	return o, nil
+}
+
+func (e *Exchange) futuresCancelOrder(v url.Values) (interface{}, error) {
+	o, err := e.futuresCancel(v)
+	if err != nil {
+		return nil, err
+	}
+	return futuresCancelResponse(o), nil
+}
+
+// splitList parse an id list sent as "[1,2]", `["a","b"]` or "[a b]"
+func splitList(s string) []string {
+	s = strings.Trim(strings.TrimSpace(s), "[]")
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+	for i := range fields {
+		fields[i] = strings.Trim(fields[i], `"`)
+	}
+	return fields
+}
+
+func (e *Exchange) futuresCancelBatchOrders(v url.Values) (interface{}, error) {
+	key, ids := "orderId", splitList(v.Get("orderIdList"))
+	if len(ids) == 0 {
+		key, ids = "origClientOrderId", splitList(v.Get("origClientOrderIdList"))
+	}
+	if len(ids) == 0 {
+		return nil, errMandatory("orderIdList")
+	}
+	res := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		o, err := e.futuresCancel(url.Values{"symbol": {v.Get("symbol")}, key: {id}})
+		if err != nil {
+			res = append(res, err)
+			continue
+		}
+		res = append(res, futuresCancelResponse(o))
+	}
+	return res, nil
+}
+
+func (e *Exchange) futuresCancelAllOpenOrders(v url.Values) (interface{}, error) {
+	symbol := v.Get("symbol")
+	if symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	for _, o := range e.openOrders(marketFutures, symbol) {
+		e.finish(o, statusCanceled)
+	}
+	return &common.APIError{Code: 200, Message: "The operation of cancel all open order is done."}, nil
+}
+
+func (e *Exchange) futuresListOpenOrders(v url.Values) (interface{}, error) {
+	res := make([]*futures.Order, 0)
+	for _, o := range e.openOrders(marketFutures, v.Get("symbol")) {
+		res = append(res, futuresOrder(o))
+	}
+	return res, nil
+}
+
+func (e *Exchange) futuresPositionRisk(v url.Values) (interface{}, error) {
+	symbol := v.Get("symbol")
+	res := make([]*futures.PositionRisk, 0)
+	for _, p := range e.positions {
+		if symbol != "" && p.symbol != symbol {
+			continue
+		}
+		mark := e.books[p.symbol].mid()
+		res = append(res, &futures.PositionRisk{
+			Symbol:           p.symbol,
+			PositionSide:     futures.PositionSideType(p.side),
+			Leverage:         int(e.leverage()),
+			MarginType:       futures.MarginTypeCrossed,
+			PositionAmt:      p.amt,
+			EntryPrice:       p.entryPrice,
+			MarkPrice:        mark,
+			BreakEvenPrice:   p.entryPrice,
+			UnRealizedProfit: e.unrealizedPnL(p),
+			Notional:         p.amt * mark,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].PositionSide < res[j].PositionSide
+	})
+	return res, nil
+}
+
+func (e *Exchange) futuresBalance(v url.Values) (interface{}, error) {
+	res := make([]*futures.Balance, 0, len(e.wallet))
+	for asset, balance := range e.wallet {
+		var pnl float64
+		for _, p := range e.positions {
+			if e.symbols[p.symbol].QuoteAsset == asset {
+				pnl += e.unrealizedPnL(p)
+			}
+		}
+		avail := e.availableMargin(asset)
+		res = append(res, &futures.Balance{
+			Asset:              asset,
+			Balance:            balance,
+			CrossWalletBalance: balance,
+			CrossUnPnl:         pnl,
+			AvailableBalance:   avail,
+			MaxWithdrawAmount:  math.Max(math.Min(avail, balance), 0),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res, nil
+}
+
+func (e *Exchange) futuresAccount(v url.Values) (interface{}, error) {
+	balances, _ := e.futuresBalance(v)
+	res := &futures.Account{CanTrade: true, CanDeposit: true, CanWithdraw: true, UpdateTime: e.timestamp()}
+	for _, b := range balances.([]*futures.Balance) {
+		res.Assets = append(res.Assets, &futures.AccountAsset{
+			Asset:             b.Asset,
+			WalletBalance:     b.Balance,
+			UnrealizedProfit:  b.CrossUnPnl,
+			MarginBalance:     b.Balance + b.CrossUnPnl,
+			MaxWithdrawAmount: b.MaxWithdrawAmount,
+		})
+		res.TotalWalletBalance += b.Balance
+		res.TotalUnrealizedProfit += b.CrossUnPnl
+		res.AvailableBalance += b.AvailableBalance
+	}
+	res.TotalMarginBalance = res.TotalWalletBalance + res.TotalUnrealizedProfit
+	return res, nil
+}
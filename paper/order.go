@@ -0,0 +1,494 @@
+package paper
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+type market int
+
+const (
+	marketSpot market = iota
+	marketFutures
+)
+
+const (
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+
+	executionNew      = "NEW"
+	executionTrade    = "TRADE"
+	executionCanceled = "CANCELED"
+	executionExpired  = "EXPIRED"
+	executionAmended  = "AMENDMENT"
+
+	positionSideBoth  = "BOTH"
+	positionSideLong  = "LONG"
+	positionSideShort = "SHORT"
+)
+
+// order define a simulated order
+type order struct {
+	market           market
+	id               int64
+	symbol           string
+	clientOrderID    string
+	side             string
+	orderType        string
+	origType         string
+	timeInForce      string
+	positionSide     string
+	price            float64
+	stopPrice        float64
+	origQty          float64
+	quoteOrderQty    float64
+	executedQty      float64
+	cumQuote         float64
+	reduceOnly       bool
+	closePosition    bool
+	workingType      string
+	newOrderRespType string
+	status           string
+	triggered        bool
+	locked           float64
+	lockedAsset      string
+	fills            []fill
+	time             int64
+	updateTime       int64
+}
+
+func (o *order) remaining() float64 {
+	return o.origQty - o.executedQty
+}
+
+func (o *order) done() bool {
+	switch o.status {
+	case statusFilled, statusCanceled, statusExpired:
+		return true
+	}
+	return false
+}
+
+func (o *order) avgPrice() float64 {
+	if o.executedQty == 0 {
+		return 0
+	}
+	return o.cumQuote / o.executedQty
+}
+
+// conditional check if the order waits for a trigger price
+func (o *order) conditional() bool {
+	switch o.orderType {
+	case "STOP", "STOP_MARKET", "TAKE_PROFIT", "TAKE_PROFIT_MARKET",
+		"STOP_LOSS", "STOP_LOSS_LIMIT", "TAKE_PROFIT_LIMIT":
+		return !o.triggered
+	}
+	return false
+}
+
+// limitPrice return the price limit of the order, zero for market orders
+func (o *order) limitPrice() float64 {
+	switch o.orderType {
+	case "MARKET", "STOP_MARKET", "TAKE_PROFIT_MARKET", "STOP_LOSS":
+		return 0
+	case "TAKE_PROFIT":
+		if o.market == marketSpot {
+			return 0
+		}
+	}
+	return o.price
+}
+
+// shouldTrigger check if the conditional order triggers at the given price
+func (o *order) shouldTrigger(price float64) bool {
+	if price == 0 {
+		return false
+	}
+	stop := strings.HasPrefix(o.orderType, "STOP")
+	if (o.side == sideBuy) == stop {
+		return price >= o.stopPrice
+	}
+	return price <= o.stopPrice
+}
+
+// trigger turn the conditional order into a market or limit order
+func (o *order) trigger() {
+	o.triggered = true
+	if o.limitPrice() == 0 {
+		o.orderType = "MARKET"
+	} else {
+		o.orderType = "LIMIT"
+	}
+}
+
+// resting check if the order stays on the book after its taker pass
+func (o *order) resting() bool {
+	if o.limitPrice() == 0 {
+		return false
+	}
+	switch o.timeInForce {
+	case "IOC", "FOK":
+		return false
+	}
+	return true
+}
+
+func apiError(code int64, msg string) *common.APIError {
+	return &common.APIError{Code: code, Message: msg}
+}
+
+var (
+	errUnknownOrder      = apiError(-2011, "Unknown order sent.")
+	errOrderNotExist     = apiError(-2013, "Order does not exist.")
+	errInvalidSymbol     = apiError(-1121, "Invalid symbol.")
+	errInsufficient      = apiError(-2010, "Account has insufficient balance for requested action.")
+	errMarginInsufficent = apiError(-2019, "Margin is insufficient.")
+	errImmediateTrigger  = apiError(-2021, "Order would immediately trigger.")
+	errReduceOnly        = apiError(-2022, "ReduceOnly Order is rejected.")
+	errPositionSide      = apiError(-4061, "Order's position side does not match user's setting.")
+	errWouldTake         = apiError(-2010, "Order would immediately match and take.")
+)
+
+func errMandatory(name string) *common.APIError {
+	return apiError(-1102, fmt.Sprintf("Mandatory parameter '%s' was not sent, was empty/null, or malformed.", name))
+}
+
+func errFilter(name string) *common.APIError {
+	return apiError(-1013, fmt.Sprintf("Filter failure: %s", name))
+}
+
+func parseFloat(v url.Values, key string) (float64, error) {
+	s := v.Get(key)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, apiError(-1100, fmt.Sprintf("Illegal characters found in parameter '%s'; legal range is '^([0-9]{1,20})(\\.[0-9]{1,20})?$'.", key))
+	}
+	return f, nil
+}
+
+// parseOrder build an order from the request parameters of a new order
+func (e *Exchange) parseOrder(m market, v url.Values) (o *order, err error) {
+	o = &order{
+		market:           m,
+		symbol:           v.Get("symbol"),
+		clientOrderID:    v.Get("newClientOrderId"),
+		side:             v.Get("side"),
+		orderType:        v.Get("type"),
+		timeInForce:      v.Get("timeInForce"),
+		positionSide:     v.Get("positionSide"),
+		workingType:      v.Get("workingType"),
+		newOrderRespType: v.Get("newOrderRespType"),
+		reduceOnly:       v.Get("reduceOnly") == "true",
+		closePosition:    v.Get("closePosition") == "true",
+		status:           statusNew,
+	}
+	for key, dst := range map[string]*float64{
+		"quantity":      &o.origQty,
+		"quoteOrderQty": &o.quoteOrderQty,
+		"price":         &o.price,
+		"stopPrice":     &o.stopPrice,
+	} {
+		if *dst, err = parseFloat(v, key); err != nil {
+			return nil, err
+		}
+	}
+	if o.symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	if o.side != sideBuy && o.side != sideSell {
+		return nil, errMandatory("side")
+	}
+	if o.orderType == "" {
+		return nil, errMandatory("type")
+	}
+	o.origType = o.orderType
+	if o.origQty <= 0 && o.quoteOrderQty <= 0 && !o.closePosition {
+		return nil, errMandatory("quantity")
+	}
+	if o.limitPrice() > 0 || o.orderType == "LIMIT" || o.orderType == "LIMIT_MAKER" {
+		if o.price <= 0 {
+			return nil, errMandatory("price")
+		}
+		if o.timeInForce == "" && o.orderType != "LIMIT_MAKER" {
+			return nil, errMandatory("timeInForce")
+		}
+	}
+	if o.conditional() && o.stopPrice <= 0 {
+		return nil, errMandatory("stopPrice")
+	}
+	return o, nil
+}
+
+// validate check the order against the filters of its symbol
+func (e *Exchange) validate(o *order) error {
+	s, ok := e.symbols[o.symbol]
+	if !ok {
+		return errInvalidSymbol
+	}
+	if o.origQty > 0 {
+		if o.origQty < s.MinQuantity-epsilon || (s.MaxQuantity > 0 && o.origQty > s.MaxQuantity+epsilon) || !onStep(o.origQty, s.StepSize) {
+			return errFilter("LOT_SIZE")
+		}
+	}
+	for _, p := range []float64{o.price, o.stopPrice} {
+		if p > 0 && !onStep(p, s.TickSize) {
+			return errFilter("PRICE_FILTER")
+		}
+	}
+	price := o.price
+	if price == 0 {
+		price = o.stopPrice
+	}
+	if price == 0 {
+		price = e.books[o.symbol].mid()
+	}
+	notional := o.origQty * price
+	if o.quoteOrderQty > 0 {
+		notional = o.quoteOrderQty
+	}
+	if s.MinNotional > 0 && !o.closePosition && !o.reduceOnly && notional < s.MinNotional-epsilon {
+		if o.market == marketFutures {
+			return apiError(-4164, fmt.Sprintf("Order's notional must be no smaller than %s (unless you choose reduce only).", formatFloat(s.MinNotional)))
+		}
+		return errFilter("NOTIONAL")
+	}
+	return nil
+}
+
+// openOrders return the open orders of a symbol by time priority,
+// all symbols when symbol is empty
+func (e *Exchange) openOrders(m market, symbol string) []*order {
+	res := make([]*order, 0)
+	for _, o := range e.orders {
+		if o.market == m && !o.done() && (symbol == "" || o.symbol == symbol) {
+			res = append(res, o)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].id < res[j].id })
+	return res
+}
+
+// lookup find an order by orderId or origClientOrderId
+func (e *Exchange) lookup(m market, v url.Values) (*order, error) {
+	symbol := v.Get("symbol")
+	if symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	if s := v.Get("orderId"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, errMandatory("orderId")
+		}
+		if o, ok := e.orders[id]; ok && o.market == m && o.symbol == symbol {
+			return o, nil
+		}
+		return nil, errOrderNotExist
+	}
+	if s := v.Get("origClientOrderId"); s != "" {
+		var found *order
+		for _, o := range e.orders {
+			if o.market == m && o.symbol == symbol && o.clientOrderID == s && (found == nil || o.id > found.id) {
+				found = o
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+		return nil, errOrderNotExist
+	}
+	return nil, errMandatory("orderId")
+}
+
+// accept register a validated order and emit its NEW event
+func (e *Exchange) accept(o *order) {
+	e.nextID++
+	o.id = e.nextID
+	if o.clientOrderID == "" {
+		o.clientOrderID = fmt.Sprintf("paper-%d", o.id)
+	}
+	o.time = e.timestamp()
+	o.updateTime = o.time
+	e.orders[o.id] = o
+	e.emit(o, executionNew, nil)
+}
+
+// process run the taker pass of an order and rest or expire the remainder
+func (e *Exchange) process(o *order) {
+	if o.conditional() {
+		return
+	}
+	b := e.books[o.symbol]
+	qty := e.fillable(o)
+	if qty <= epsilon && o.quoteOrderQty == 0 {
+		e.finish(o, statusExpired)
+		return
+	}
+	if b == nil && !o.resting() {
+		// no book yet, nothing to take from
+		e.finish(o, statusExpired)
+		return
+	}
+	if o.timeInForce == "GTX" && len(b.peek(o.side, qty, 0, o.price, 0)) > 0 {
+		e.finish(o, statusExpired)
+		return
+	}
+	fills := b.peek(o.side, qty, o.quoteOrderQty, o.limitPrice(), e.symbols[o.symbol].StepSize)
+	if filled, _ := total(fills); o.timeInForce == "FOK" && filled < qty-epsilon {
+		e.finish(o, statusExpired)
+		return
+	}
+	if o.quoteOrderQty > 0 {
+		o.origQty, _ = total(fills)
+	}
+	fills = e.affordable(o, fills)
+	b.consume(o.side, fills)
+	for _, f := range fills {
+		e.execute(o, f)
+	}
+	if o.done() {
+		return
+	}
+	if !o.resting() {
+		e.finish(o, statusExpired)
+	}
+}
+
+// match trigger conditional orders and fill resting orders of a symbol
+// against a new book snapshot
+func (e *Exchange) match(symbol string) {
+	b := e.books[symbol]
+	for _, o := range e.openOrders(marketSpot, symbol) {
+		e.matchOrder(b, o)
+	}
+	for _, o := range e.openOrders(marketFutures, symbol) {
+		e.matchOrder(b, o)
+	}
+}
+
+func (e *Exchange) matchOrder(b *book, o *order) {
+	if o.done() {
+		return
+	}
+	if o.conditional() {
+		if o.shouldTrigger(b.mid()) {
+			o.trigger()
+			e.process(o)
+		}
+		return
+	}
+	qty := e.fillable(o)
+	if qty <= epsilon {
+		e.finish(o, statusExpired)
+		return
+	}
+	fills := b.peek(o.side, qty, 0, o.price, 0)
+	for i := range fills {
+		fills[i].price = o.price
+		fills[i].maker = true
+	}
+	fills = e.affordable(o, fills)
+	b.consume(o.side, fills)
+	for _, f := range fills {
+		e.execute(o, f)
+	}
+}
+
+// fillable return how much of the order may still be filled
+func (e *Exchange) fillable(o *order) float64 {
+	if o.market == marketFutures {
+		return e.futuresFillable(o)
+	}
+	return o.remaining()
+}
+
+// affordable truncate fills the account cannot pay for
+func (e *Exchange) affordable(o *order, fills []fill) []fill {
+	if o.market == marketSpot {
+		return e.spotAffordable(o, fills)
+	}
+	return fills
+}
+
+// execute apply a single fill to an order and the account
+func (e *Exchange) execute(o *order, f fill) {
+	o.executedQty += f.qty
+	o.cumQuote += f.qty * f.price
+	o.updateTime = e.timestamp()
+	o.status = statusPartiallyFilled
+	if o.remaining() <= epsilon {
+		o.executedQty = o.origQty
+		o.status = statusFilled
+	}
+	o.fills = append(o.fills, f)
+	e.nextTradeID++
+	var t *trade
+	if o.market == marketFutures {
+		t = e.futuresSettle(o, f)
+	} else {
+		t = e.spotSettle(o, f)
+	}
+	t.id = e.nextTradeID
+	e.emit(o, executionTrade, t)
+	if o.done() {
+		e.release(o)
+	}
+}
+
+// finish end an order with a final status and unlock its reservations
+func (e *Exchange) finish(o *order, status string) {
+	if o.done() {
+		return
+	}
+	o.status = status
+	o.updateTime = e.timestamp()
+	e.release(o)
+	if status == statusCanceled {
+		e.emit(o, executionCanceled, nil)
+	} else {
+		e.emit(o, executionExpired, nil)
+	}
+}
+
+// release unlock what is left of the reservation of a finished order
+func (e *Exchange) release(o *order) {
+	if o.market == marketSpot {
+		e.spotRelease(o)
+	}
+}
+
+// trade define the account side effect of a fill
+type trade struct {
+	id              int64
+	fill            fill
+	commission      float64
+	commissionAsset string
+	realizedPnL     float64
+}
+
+// emit queue a user data event for an order
+func (e *Exchange) emit(o *order, executionType string, t *trade) {
+	if o.market == marketFutures {
+		e.futuresEmit(o, executionType, t)
+		return
+	}
+	e.spotEmit(o, executionType, t)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e8)/1e8, 'f', -1, 64)
+}
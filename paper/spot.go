@@ -0,0 +1,369 @@
+package paper
+
+import (
+	"math"
+	"net/url"
+	"sort"
+
+	binance "github.com/uncle-gua/gobinance"
+)
+
+// spotBalance define the spot balance of an asset
+type spotBalance struct {
+	free   float64
+	locked float64
+}
+
+func (e *Exchange) spotBalance(asset string) *spotBalance {
+	b, ok := e.balances[asset]
+	if !ok {
+		b = &spotBalance{}
+		e.balances[asset] = b
+	}
+	return b
+}
+
+// spotLock reserve the funds of a new spot order
+func (e *Exchange) spotLock(o *order) error {
+	s := e.symbols[o.symbol]
+	asset, amount := s.BaseAsset, o.origQty
+	if o.side == sideBuy {
+		price := o.limitPrice()
+		if price == 0 {
+			price = o.stopPrice
+		}
+		asset, amount = s.QuoteAsset, o.origQty*price
+	}
+	if o.limitPrice() == 0 && !o.conditional() {
+		// market orders are paid from the free balance as they fill
+		amount = 0
+	}
+	b := e.spotBalance(asset)
+	if amount > b.free+epsilon {
+		return errInsufficient
+	}
+	b.free -= amount
+	b.locked += amount
+	o.lockedAsset, o.locked = asset, amount
+	return nil
+}
+
+// spotAffordable truncate the fills of a spot order to the available funds
+func (e *Exchange) spotAffordable(o *order, fills []fill) []fill {
+	s := e.symbols[o.symbol]
+	asset := s.BaseAsset
+	if o.side == sideBuy {
+		asset = s.QuoteAsset
+	}
+	avail := e.spotBalance(asset).free
+	if asset == o.lockedAsset {
+		avail += o.locked
+	}
+	res := make([]fill, 0, len(fills))
+	for _, f := range fills {
+		cost := f.qty
+		if o.side == sideBuy {
+			cost = f.qty * f.price
+		}
+		if cost > avail+epsilon {
+			if o.side == sideBuy {
+				f.qty = roundStep(avail/f.price, s.StepSize)
+			} else {
+				f.qty = roundStep(avail, s.StepSize)
+			}
+			if f.qty > epsilon {
+				res = append(res, f)
+			}
+			break
+		}
+		avail -= cost
+		res = append(res, f)
+	}
+	return res
+}
+
+// spotSettle move the funds of a spot fill, paying the commission with the
+// received asset
+func (e *Exchange) spotSettle(o *order, f fill) *trade {
+	s := e.symbols[o.symbol]
+	pay, receive := s.BaseAsset, s.QuoteAsset
+	cost, proceeds := f.qty, f.qty*f.price
+	if o.side == sideBuy {
+		pay, receive = s.QuoteAsset, s.BaseAsset
+		cost, proceeds = proceeds, cost
+	}
+	b := e.spotBalance(pay)
+	if pay == o.lockedAsset {
+		take := math.Min(cost, o.locked)
+		o.locked -= take
+		b.locked -= take
+		cost -= take
+	}
+	b.free -= cost
+	rate := s.TakerCommission
+	if f.maker {
+		rate = s.MakerCommission
+	}
+	t := &trade{
+		fill:            f,
+		commission:      proceeds * rate,
+		commissionAsset: receive,
+	}
+	e.spotBalance(receive).free += proceeds - t.commission
+	return t
+}
+
+// spotRelease unlock the reservation left by a finished spot order
+func (e *Exchange) spotRelease(o *order) {
+	if o.locked == 0 {
+		return
+	}
+	b := e.spotBalance(o.lockedAsset)
+	b.locked -= o.locked
+	b.free += o.locked
+	o.locked = 0
+}
+
+// spotEmit queue an executionReport and the balances it changed
+func (e *Exchange) spotEmit(o *order, executionType string, t *trade) {
+	now := e.timestamp()
+	u := binance.WsOrderUpdate{
+		Symbol:            o.symbol,
+		ClientOrderId:     o.clientOrderID,
+		Side:              o.side,
+		Type:              o.origType,
+		TimeInForce:       binance.TimeInForceType(o.timeInForce),
+		Volume:            formatFloat(o.origQty),
+		Price:             formatFloat(o.price),
+		StopPrice:         formatFloat(o.stopPrice),
+		IceBergVolume:     "0",
+		OrderListId:       -1,
+		ExecutionType:     executionType,
+		Status:            o.status,
+		RejectReason:      "NONE",
+		Id:                o.id,
+		LatestVolume:      "0",
+		FilledVolume:      formatFloat(o.executedQty),
+		LatestPrice:       "0",
+		FeeCost:           "0",
+		TransactionTime:   now,
+		TradeId:           -1,
+		IsInOrderBook:     !o.done() && !o.conditional(),
+		CreateTime:        o.time,
+		FilledQuoteVolume: formatFloat(o.cumQuote),
+		LatestQuoteVolume: "0",
+		QuoteVolume:       formatFloat(o.quoteOrderQty),
+	}
+	if t != nil {
+		u.LatestVolume = formatFloat(t.fill.qty)
+		u.LatestPrice = formatFloat(t.fill.price)
+		u.LatestQuoteVolume = formatFloat(t.fill.qty * t.fill.price)
+		u.FeeAsset = t.commissionAsset
+		u.FeeCost = formatFloat(t.commission)
+		u.TradeId = t.id
+		u.IsMaker = t.fill.maker
+	}
+	e.spotEvents = append(e.spotEvents, &binance.WsUserDataEvent{
+		Event:           binance.UserDataEventTypeExecutionReport,
+		Time:            now,
+		TransactionTime: now,
+		OrderUpdate:     u,
+	})
+
+	s := e.symbols[o.symbol]
+	account := &binance.WsUserDataEvent{
+		Event:             binance.UserDataEventTypeOutboundAccountPosition,
+		Time:              now,
+		AccountUpdateTime: now,
+	}
+	for _, asset := range []string{s.BaseAsset, s.QuoteAsset} {
+		b := e.spotBalance(asset)
+		account.AccountUpdate = append(account.AccountUpdate, binance.WsAccountUpdate{
+			Asset:  asset,
+			Free:   formatFloat(b.free),
+			Locked: formatFloat(b.locked),
+		})
+	}
+	e.spotEvents = append(e.spotEvents, account)
+}
+
+func (e *Exchange) spotNewOrder(v url.Values) (*order, error) {
+	o, err := e.parseOrder(marketSpot, v)
+	if err != nil {
+		return nil, err
+	}
+	if err = e.validate(o); err != nil {
+		return nil, err
+	}
+	if o.orderType == "LIMIT_MAKER" {
+		o.timeInForce = "GTX"
+	}
+	if o.conditional() && o.shouldTrigger(e.books[o.symbol].mid()) {
+		return nil, apiError(-2010, "Stop price would trigger immediately.")
+	}
+	return o, nil
+}
+
+func (e *Exchange) spotCreateOrder(v url.Values) (interface{}, error) {
+	o, err := e.spotNewOrder(v)
+	if err != nil {
+		return nil, err
+	}
+	if o.timeInForce == "GTX" && len(e.books[o.symbol].peek(o.side, o.origQty, 0, o.price, 0)) > 0 {
+		return nil, errWouldTake
+	}
+	if err = e.spotLock(o); err != nil {
+		return nil, err
+	}
+	e.accept(o)
+	e.process(o)
+
+	res := &binance.CreateOrderResponse{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		ClientOrderID:            o.clientOrderID,
+		TransactTime:             o.time,
+		Price:                    formatFloat(o.price),
+		OrigQuantity:             formatFloat(o.origQty),
+		ExecutedQuantity:         formatFloat(o.executedQty),
+		CummulativeQuoteQuantity: formatFloat(o.cumQuote),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.origType),
+		Side:                     binance.SideType(o.side),
+	}
+	s := e.symbols[o.symbol]
+	asset := s.BaseAsset
+	if o.side == sideSell {
+		asset = s.QuoteAsset
+	}
+	for i, f := range o.fills {
+		rate := s.TakerCommission
+		if f.maker {
+			rate = s.MakerCommission
+		}
+		proceeds := f.qty
+		if o.side == sideSell {
+			proceeds = f.qty * f.price
+		}
+		res.Fills = append(res.Fills, &binance.Fill{
+			TradeID:         int(e.nextTradeID) - len(o.fills) + i + 1,
+			Price:           formatFloat(f.price),
+			Quantity:        formatFloat(f.qty),
+			Commission:      formatFloat(proceeds * rate),
+			CommissionAsset: asset,
+		})
+	}
+	return res, nil
+}
+
+func (e *Exchange) spotTestOrder(v url.Values) (interface{}, error) {
+	if _, err := e.spotNewOrder(v); err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func spotOrder(o *order) *binance.Order {
+	return &binance.Order{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		OrderListId:              -1,
+		ClientOrderID:            o.clientOrderID,
+		Price:                    formatFloat(o.price),
+		OrigQuantity:             formatFloat(o.origQty),
+		ExecutedQuantity:         formatFloat(o.executedQty),
+		CummulativeQuoteQuantity: formatFloat(o.cumQuote),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.origType),
+		Side:                     binance.SideType(o.side),
+		StopPrice:                formatFloat(o.stopPrice),
+		IcebergQuantity:          "0",
+		Time:                     o.time,
+		UpdateTime:               o.updateTime,
+		IsWorking:                !o.conditional(),
+		OrigQuoteOrderQuantity:   formatFloat(o.quoteOrderQty),
+	}
+}
+
+func spotCancelResponse(o *order) *binance.CancelOrderResponse {
+	return &binance.CancelOrderResponse{
+		Symbol:                   o.symbol,
+		OrigClientOrderID:        o.clientOrderID,
+		OrderID:                  o.id,
+		OrderListID:              -1,
+		ClientOrderID:            o.clientOrderID,
+		TransactTime:             o.updateTime,
+		Price:                    formatFloat(o.price),
+		OrigQuantity:             formatFloat(o.origQty),
+		ExecutedQuantity:         formatFloat(o.executedQty),
+		CummulativeQuoteQuantity: formatFloat(o.cumQuote),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.origType),
+		Side:                     binance.SideType(o.side),
+	}
+}
+
+func (e *Exchange) spotGetOrder(v url.Values) (interface{}, error) {
+	o, err := e.lookup(marketSpot, v)
+	if err != nil {
+		return nil, err
+	}
+	return spotOrder(o), nil
+}
+
+func (e *Exchange) spotCancelOrder(v url.Values) (interface{}, error) {
+	o, err := e.lookup(marketSpot, v)
+	if err == errOrderNotExist || (err == nil && o.done()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.finish(o, statusCanceled)
+	return spotCancelResponse(o), nil
+}
+
+func (e *Exchange) spotListOpenOrders(v url.Values) (interface{}, error) {
+	res := make([]*binance.Order, 0)
+	for _, o := range e.openOrders(marketSpot, v.Get("symbol")) {
+		res = append(res, spotOrder(o))
+	}
+	return res, nil
+}
+
+func (e *Exchange) spotCancelOpenOrders(v url.Values) (interface{}, error) {
+	symbol := v.Get("symbol")
+	if symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	res := make([]*binance.CancelOrderResponse, 0)
+	for _, o := range e.openOrders(marketSpot, symbol) {
+		e.finish(o, statusCanceled)
+		res = append(res, spotCancelResponse(o))
+	}
+	return res, nil
+}
+
+func (e *Exchange) spotAccount(v url.Values) (interface{}, error) {
+	res := &binance.Account{
+		CanTrade:    true,
+		CanWithdraw: true,
+		CanDeposit:  true,
+		UpdateTime:  uint64(e.timestamp()),
+		AccountType: "SPOT",
+		Balances:    make([]binance.Balance, 0, len(e.balances)),
+		Permissions: []string{"SPOT"},
+	}
+	for asset, b := range e.balances {
+		res.Balances = append(res.Balances, binance.Balance{
+			Asset:  asset,
+			Free:   b.free,
+			Locked: b.locked,
+		})
+	}
+	sort.Slice(res.Balances, func(i, j int) bool { return res.Balances[i].Asset < res.Balances[j].Asset })
+	return res, nil
+}
@@ -34,18 +34,18 @@ func (s *PayTradeHistoryService) Limit(limit int32) *PayTradeHistoryService {
 // Do send request
 func (s *PayTradeHistoryService) Do(ctx context.Context, opts ...RequestOption) (*PayTradeHistory, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/pay/transactions",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/pay/transactions",
+		SecType:  secTypeSigned,
 	}
 	if s.startTimestamp != nil {
-		r.setParam("startTimestamp", *s.startTimestamp)
+		r.SetParam("startTimestamp", *s.startTimestamp)
 	}
 	if s.endTimestamp != nil {
-		r.setParam("endTimestamp", *s.endTimestamp)
+		r.SetParam("endTimestamp", *s.endTimestamp)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
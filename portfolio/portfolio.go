@@ -0,0 +1,452 @@
+// Package portfolio values the holdings of an account across its wallets:
+// spot, cross margin, USDT-M and COIN-M futures, Simple Earn, staking and
+// the spot wallets of the sub-accounts.
+//
+// The wallets are fetched concurrently and every asset is priced in a quote
+// asset with the spot prices, routed through intermediate assets when the
+// asset has no pair with the quote asset. A Snapshot breaks the value down
+// by wallet and by asset, and two snapshots can be compared with Diff.
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// Wallet define a wallet of the account
+type Wallet string
+
+// Wallet of the account
+const (
+	WalletSpot     Wallet = "spot"
+	WalletMargin   Wallet = "margin"   // cross margin net assets, negative when borrowed
+	WalletFutures  Wallet = "futures"  // USDT-M wallet balances with the cross unrealized PnL
+	WalletDelivery Wallet = "delivery" // COIN-M wallet balances with the cross unrealized PnL
+	WalletEarn     Wallet = "earn"     // Simple Earn flexible and locked positions
+	WalletStaking  Wallet = "staking"
+	// WalletSubAccounts is the spot wallets of the sub-accounts, for a
+	// master account only
+	WalletSubAccounts Wallet = "subaccounts"
+)
+
+// DefaultWallets are the wallets valued by default, the sub-accounts are
+// left out as they need a master account
+var DefaultWallets = []Wallet{WalletSpot, WalletMargin, WalletFutures, WalletDelivery, WalletEarn, WalletStaking}
+
+const (
+	defaultQuote = "USDT"
+	pageSize     = 100
+)
+
+// ErrNoWallet is returned when no wallet can be fetched
+var ErrNoWallet = errors.New("portfolio: no wallet fetched")
+
+// Holding define an amount of an asset in a wallet
+type Holding struct {
+	Wallet     Wallet
+	SubAccount string // email of the sub-account, for WalletSubAccounts
+	Asset      string
+	Amount     float64
+	Price      float64 // in the quote asset, 0 when not priced
+	Value      float64
+	Priced     bool
+}
+
+// AssetValue define the amount and value of an asset across the wallets
+type AssetValue struct {
+	Amount float64
+	Value  float64
+}
+
+// Snapshot define the holdings of the account at a time, valued in Quote.
+// The wallets that failed are reported in Errors and left out.
+type Snapshot struct {
+	Time     int64 // in milliseconds
+	Quote    string
+	Holdings []Holding
+	Total    float64
+	Wallets  map[Wallet]float64
+	Assets   map[string]AssetValue
+	Unpriced []string // assets without a route to Quote, left out of the values
+	Errors   map[Wallet]error
+}
+
+// Portfolio value the wallets of an account
+type Portfolio struct {
+	spot     *binance.Client
+	futures  *futures.Client
+	delivery *delivery.Client
+
+	// Quote is the asset of the values, default USDT
+	Quote string
+	// Intermediates are the assets the prices are routed through, default
+	// DefaultIntermediates
+	Intermediates []string
+	// Wallets are the valued wallets, default DefaultWallets. WalletFutures
+	// and WalletDelivery are skipped without their client.
+	Wallets []Wallet
+
+	mu      sync.Mutex
+	symbols []binance.Symbol
+}
+
+// New init portfolio, futures and delivery are optional
+func New(spot *binance.Client, futures *futures.Client, delivery *delivery.Client) *Portfolio {
+	return &Portfolio{spot: spot, futures: futures, delivery: delivery}
+}
+
+// Snapshot fetch the wallets and the prices concurrently and value the
+// holdings. An error is returned when the prices cannot be fetched or no
+// wallet can.
+func (p *Portfolio) Snapshot(ctx context.Context) (*Snapshot, error) {
+	wallets := p.Wallets
+	if len(wallets) == 0 {
+		wallets = DefaultWallets
+	}
+	quote := p.Quote
+	if quote == "" {
+		quote = defaultQuote
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		holdings = make(map[Wallet][]Holding)
+		errs     = make(map[Wallet]error)
+		prices   *Prices
+		priceErr error
+	)
+	for _, wallet := range wallets {
+		if (wallet == WalletFutures && p.futures == nil) || (wallet == WalletDelivery && p.delivery == nil) {
+			continue
+		}
+		wg.Add(1)
+		go func(wallet Wallet) {
+			defer wg.Done()
+			res, err := p.fetch(ctx, wallet)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[wallet] = err
+				return
+			}
+			holdings[wallet] = res
+		}(wallet)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		prices, priceErr = p.prices(ctx)
+	}()
+	wg.Wait()
+	if priceErr != nil {
+		return nil, fmt.Errorf("portfolio: prices: %w", priceErr)
+	}
+	if len(holdings) == 0 && len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrNoWallet, errors.Join(walletErrors(errs)...))
+	}
+
+	// the flexible Simple Earn positions also show in the spot wallet as
+	// LD<asset>, drop them when the positions are valued
+	if earn, ok := holdings[WalletEarn]; ok {
+		held := make(map[string]bool)
+		for _, h := range earn {
+			held["LD"+h.Asset] = true
+		}
+		spot := holdings[WalletSpot][:0]
+		for _, h := range holdings[WalletSpot] {
+			if !held[h.Asset] {
+				spot = append(spot, h)
+			}
+		}
+		holdings[WalletSpot] = spot
+	}
+
+	s := &Snapshot{
+		Time:    time.Now().UnixMilli(),
+		Quote:   quote,
+		Wallets: make(map[Wallet]float64),
+		Assets:  make(map[string]AssetValue),
+		Errors:  errs,
+	}
+	unpriced := make(map[string]bool)
+	for _, wallet := range wallets {
+		for _, h := range holdings[wallet] {
+			h.Price, h.Priced = prices.Price(h.Asset, quote)
+			if !h.Priced {
+				unpriced[h.Asset] = true
+			}
+			h.Value = h.Amount * h.Price
+			s.Holdings = append(s.Holdings, h)
+			s.Total += h.Value
+			s.Wallets[wallet] += h.Value
+			asset := s.Assets[h.Asset]
+			asset.Amount += h.Amount
+			asset.Value += h.Value
+			s.Assets[h.Asset] = asset
+		}
+	}
+	for asset := range unpriced {
+		s.Unpriced = append(s.Unpriced, asset)
+	}
+	sort.Strings(s.Unpriced)
+	return s, nil
+}
+
+func walletErrors(errs map[Wallet]error) []error {
+	res := make([]error, 0, len(errs))
+	for wallet, err := range errs {
+		res = append(res, fmt.Errorf("%s: %w", wallet, err))
+	}
+	return res
+}
+
+// Run take a snapshot every interval until ctx is done and call handler
+// with it and its diff with the previous one, nil for the first. The errors
+// of the snapshots are passed to errHandler, optional.
+func (p *Portfolio) Run(ctx context.Context, interval time.Duration, handler func(s *Snapshot, diff *Diff), errHandler func(err error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var prev *Snapshot
+	for {
+		s, err := p.Snapshot(ctx)
+		if err != nil {
+			if errHandler != nil && ctx.Err() == nil {
+				errHandler(err)
+			}
+		} else {
+			var diff *Diff
+			if prev != nil {
+				diff = s.Diff(prev)
+			}
+			handler(s, diff)
+			prev = s
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// prices fetch the spot prices, the symbols are fetched once
+func (p *Portfolio) prices(ctx context.Context) (*Prices, error) {
+	p.mu.Lock()
+	symbols := p.symbols
+	p.mu.Unlock()
+	if symbols == nil {
+		info, err := p.spot.NewExchangeInfoService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		symbols = info.Symbols
+		p.mu.Lock()
+		p.symbols = symbols
+		p.mu.Unlock()
+	}
+	tickers, err := p.spot.NewListPricesService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices := NewPrices(symbols, tickers)
+	prices.Intermediates = p.Intermediates
+	return prices, nil
+}
+
+// fetch return the non zero holdings of a wallet
+func (p *Portfolio) fetch(ctx context.Context, wallet Wallet) (res []Holding, err error) {
+	add := func(asset string, amount float64) {
+		if amount != 0 {
+			res = append(res, Holding{Wallet: wallet, Asset: asset, Amount: amount})
+		}
+	}
+	switch wallet {
+	case WalletSpot:
+		account, err := p.spot.NewGetAccountService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range account.Balances {
+			add(b.Asset, b.Free+b.Locked)
+		}
+	case WalletMargin:
+		account, err := p.spot.NewGetMarginAccountService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range account.UserAssets {
+			add(a.Asset, parseFloat(a.NetAsset))
+		}
+	case WalletFutures:
+		balances, err := p.futures.NewGetBalanceService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range balances {
+			add(b.Asset, b.Balance+b.CrossUnPnl)
+		}
+	case WalletDelivery:
+		balances, err := p.delivery.NewGetBalanceService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range balances {
+			add(b.Asset, parseFloat(b.Balance)+parseFloat(b.CrossUnPnl))
+		}
+	case WalletEarn:
+		for current := int64(1); ; current++ {
+			list, err := p.spot.NewGetSimpleEarnFlexiblePositionService().Current(current).Size(pageSize).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, position := range list.Rows {
+				add(position.Asset, parseFloat(position.TotalAmount))
+			}
+			if len(list.Rows) < pageSize {
+				break
+			}
+		}
+		for current := int64(1); ; current++ {
+			list, err := p.spot.NewGetSimpleEarnLockedPositionService().Current(current).Size(pageSize).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, position := range list.Rows {
+				add(position.Asset, parseFloat(position.Amount))
+			}
+			if len(list.Rows) < pageSize {
+				break
+			}
+		}
+	case WalletStaking:
+		for _, product := range []binance.StakingProduct{
+			binance.StakingProductLockedStaking, binance.StakingProductFlexibleDeFiStaking, binance.StakingProductLockedDeFiStaking,
+		} {
+			for current := int32(1); ; current++ {
+				positions, err := p.spot.NewStakingProductPositionService().Product(product).Current(current).Size(pageSize).Do(ctx)
+				if err != nil {
+					return nil, err
+				}
+				for _, position := range *positions {
+					add(position.Asset, parseFloat(position.Amount))
+				}
+				if len(*positions) < pageSize {
+					break
+				}
+			}
+		}
+	case WalletSubAccounts:
+		balance, err := p.spot.NewSubAccountConsolidatedBalanceService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(balance.Errors) > 0 {
+			return nil, errors.Join(subAccountErrors(balance.Errors)...)
+		}
+		for email, balances := range balance.SubAccounts {
+			for _, b := range balances {
+				if amount := b.Free + b.Locked; amount != 0 {
+					res = append(res, Holding{Wallet: wallet, SubAccount: email, Asset: b.Asset, Amount: amount})
+				}
+			}
+		}
+		sort.Slice(res, func(i, j int) bool {
+			if res[i].SubAccount != res[j].SubAccount {
+				return res[i].SubAccount < res[j].SubAccount
+			}
+			return res[i].Asset < res[j].Asset
+		})
+	default:
+		return nil, fmt.Errorf("unknown wallet %q", wallet)
+	}
+	return res, nil
+}
+
+func subAccountErrors(errs map[string]error) []error {
+	res := make([]error, 0, len(errs))
+	for email, err := range errs {
+		res = append(res, fmt.Errorf("%s: %w", email, err))
+	}
+	return res
+}
+
+// Diff define the change between two snapshots
+type Diff struct {
+	From    int64
+	To      int64
+	Total   float64
+	Wallets map[Wallet]float64
+	Assets  map[string]AssetValue // change of amount and value by asset
+	Skipped []Wallet              // wallets that failed in either snapshot, left out
+}
+
+// Diff return the change from prev to s, the assets and wallets missing
+// from one snapshot count as zero. The wallets that failed in either
+// snapshot are left out, a transient error would show as the whole wallet
+// lost then regained.
+func (s *Snapshot) Diff(prev *Snapshot) *Diff {
+	d := &Diff{
+		From:    prev.Time,
+		To:      s.Time,
+		Wallets: make(map[Wallet]float64),
+		Assets:  make(map[string]AssetValue),
+	}
+	skipped := make(map[Wallet]bool)
+	for _, errs := range []map[Wallet]error{s.Errors, prev.Errors} {
+		for wallet := range errs {
+			if !skipped[wallet] {
+				skipped[wallet] = true
+				d.Skipped = append(d.Skipped, wallet)
+			}
+		}
+	}
+	sort.Slice(d.Skipped, func(i, j int) bool { return d.Skipped[i] < d.Skipped[j] })
+	add := func(snapshot *Snapshot, sign float64) {
+		for _, h := range snapshot.Holdings {
+			if skipped[h.Wallet] {
+				continue
+			}
+			d.Total += sign * h.Value
+			d.Wallets[h.Wallet] += sign * h.Value
+			change := d.Assets[h.Asset]
+			change.Amount += sign * h.Amount
+			change.Value += sign * h.Value
+			d.Assets[h.Asset] = change
+		}
+	}
+	add(s, 1)
+	add(prev, -1)
+	for asset, v := range d.Assets {
+		if v.Amount == 0 && v.Value == 0 {
+			delete(d.Assets, asset)
+		}
+	}
+	return d
+}
+
+// Top return the holdings of the snapshot by value, from the largest
+func (s *Snapshot) Top(n int) []Holding {
+	res := append([]Holding(nil), s.Holdings...)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Value > res[j].Value
+	})
+	if n > 0 && n < len(res) {
+		res = res[:n]
+	}
+	return res
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,132 @@
+package portfolio_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/portfolio"
+)
+
+func TestPrices(t *testing.T) {
+	symbols := []binance.Symbol{
+		{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"},
+		{Symbol: "ETHBTC", BaseAsset: "ETH", QuoteAsset: "BTC"},
+		{Symbol: "XYZETH", BaseAsset: "XYZ", QuoteAsset: "ETH"},
+		{Symbol: "USDTTRY", BaseAsset: "USDT", QuoteAsset: "TRY"},
+	}
+	prices := portfolio.NewPrices(symbols, []*binance.SymbolPrice{
+		{Symbol: "BTCUSDT", Price: "50000"},
+		{Symbol: "ETHBTC", Price: "0.05"},
+		{Symbol: "XYZETH", Price: "0.1"},
+		{Symbol: "USDTTRY", Price: "30"},
+	})
+	cases := []struct {
+		asset, quote string
+		price        float64
+	}{
+		{"BTC", "USDT", 50000},
+		{"USDT", "BTC", 0.00002},
+		{"ETH", "USDT", 2500},
+		{"XYZ", "USDT", 250},
+		{"TRY", "BTC", 1.0 / 30 / 50000},
+		{"USDT", "USDT", 1},
+	}
+	for _, c := range cases {
+		price, ok := prices.Price(c.asset, c.quote)
+		if !ok || math.Abs(price-c.price) > 1e-9*c.price {
+			t.Fatalf("unexpected price of %s in %s: %v %v", c.asset, c.quote, price, ok)
+		}
+	}
+	if _, ok := prices.Price("ABC", "USDT"); ok {
+		t.Fatal("unexpected price of ABC")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	btcPrice := "50000"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/exchangeInfo":
+			fmt.Fprint(w, `{"symbols":[{"symbol":"BTCUSDT","baseAsset":"BTC","quoteAsset":"USDT"}]}`)
+		case "/api/v3/ticker/price":
+			fmt.Fprintf(w, `[{"symbol":"BTCUSDT","price":"%s"}]`, btcPrice)
+		case "/api/v3/account":
+			fmt.Fprint(w, `{"balances":[{"asset":"BTC","free":"1","locked":"0.5"},{"asset":"USDT","free":"100","locked":"0"},
+				{"asset":"LDBTC","free":"2","locked":"0"},{"asset":"ABC","free":"3","locked":"0"}]}`)
+		case "/sapi/v1/margin/account":
+			fmt.Fprint(w, `{"userAssets":[{"asset":"USDT","netAsset":"-50"}]}`)
+		case "/sapi/v1/simple-earn/flexible/position":
+			fmt.Fprint(w, `{"rows":[{"asset":"BTC","totalAmount":"2"}],"total":1}`)
+		case "/sapi/v1/simple-earn/locked/position":
+			fmt.Fprint(w, `{"rows":[],"total":0}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":-1,"msg":"not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := binance.NewClient("", "")
+	client.BaseURL = server.URL
+	p := portfolio.New(client, nil, nil)
+	p.Wallets = []portfolio.Wallet{portfolio.WalletSpot, portfolio.WalletMargin, portfolio.WalletEarn, portfolio.WalletStaking}
+	s, err := p.Snapshot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Errors[portfolio.WalletStaking] == nil {
+		t.Fatal("expected a staking error")
+	}
+	if s.Total != 175050 || s.Wallets[portfolio.WalletSpot] != 75100 || s.Wallets[portfolio.WalletMargin] != -50 || s.Wallets[portfolio.WalletEarn] != 100000 {
+		t.Fatalf("unexpected values %v %v", s.Total, s.Wallets)
+	}
+	if btc := s.Assets["BTC"]; btc.Amount != 3.5 || btc.Value != 175000 {
+		t.Fatalf("unexpected BTC %+v", btc)
+	}
+	if len(s.Unpriced) != 1 || s.Unpriced[0] != "ABC" {
+		t.Fatalf("unexpected unpriced %v", s.Unpriced)
+	}
+
+	btcPrice = "60000"
+	next, err := p.Snapshot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := next.Diff(s)
+	if diff.Total != 35000 || diff.Wallets[portfolio.WalletEarn] != 20000 || diff.Assets["BTC"].Amount != 0 || diff.Assets["BTC"].Value != 35000 {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+	if _, ok := diff.Assets["USDT"]; ok {
+		t.Fatalf("unexpected USDT diff %+v", diff.Assets)
+	}
+}
+
+func TestDiffSkipsFailedWallets(t *testing.T) {
+	prev := &portfolio.Snapshot{
+		Time: 1,
+		Holdings: []portfolio.Holding{
+			{Wallet: portfolio.WalletSpot, Asset: "USDT", Amount: 100, Value: 100},
+			{Wallet: portfolio.WalletFutures, Asset: "USDT", Amount: 1000, Value: 1000},
+		},
+	}
+	next := &portfolio.Snapshot{
+		Time: 2,
+		Holdings: []portfolio.Holding{
+			{Wallet: portfolio.WalletSpot, Asset: "USDT", Amount: 150, Value: 150},
+		},
+		Errors: map[portfolio.Wallet]error{portfolio.WalletFutures: errors.New("timeout")},
+	}
+	diff := next.Diff(prev)
+	if diff.Total != 50 || diff.Assets["USDT"].Amount != 50 || diff.Wallets[portfolio.WalletSpot] != 50 {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+	if _, ok := diff.Wallets[portfolio.WalletFutures]; ok || len(diff.Skipped) != 1 || diff.Skipped[0] != portfolio.WalletFutures {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+}
@@ -0,0 +1,96 @@
+package portfolio
+
+import (
+	"strconv"
+
+	binance "github.com/uncle-gua/gobinance"
+)
+
+// DefaultIntermediates are the assets a price is routed through when an
+// asset has no pair with the quote asset
+var DefaultIntermediates = []string{"USDT", "BTC", "BNB", "ETH", "FDUSD", "USDC"}
+
+// Prices convert assets with the spot prices, through intermediate assets
+// when there is no direct pair
+type Prices struct {
+	Intermediates []string // default DefaultIntermediates
+	rates         map[string]map[string]float64
+}
+
+// NewPrices init prices from the spot symbols and their prices
+func NewPrices(symbols []binance.Symbol, prices []*binance.SymbolPrice) *Prices {
+	assets := make(map[string]binance.Symbol, len(symbols))
+	for _, s := range symbols {
+		assets[s.Symbol] = s
+	}
+	p := &Prices{rates: make(map[string]map[string]float64)}
+	for _, price := range prices {
+		s, ok := assets[price.Symbol]
+		if !ok {
+			continue
+		}
+		rate, err := strconv.ParseFloat(price.Price, 64)
+		if err != nil || rate <= 0 {
+			continue
+		}
+		p.set(s.BaseAsset, s.QuoteAsset, rate)
+		p.set(s.QuoteAsset, s.BaseAsset, 1/rate)
+	}
+	return p
+}
+
+func (p *Prices) set(from, to string, rate float64) {
+	rates, ok := p.rates[from]
+	if !ok {
+		rates = make(map[string]float64)
+		p.rates[from] = rates
+	}
+	rates[to] = rate
+}
+
+// direct return the rate of a pair, or of its inverse
+func (p *Prices) direct(from, to string) (float64, bool) {
+	if from == to {
+		return 1, true
+	}
+	rate, ok := p.rates[from][to]
+	return rate, ok
+}
+
+// Price return the price of an asset in the quote asset: from the pair of
+// both assets, else through one then two intermediate assets, in the order
+// of Intermediates
+func (p *Prices) Price(asset, quote string) (float64, bool) {
+	if rate, ok := p.direct(asset, quote); ok {
+		return rate, true
+	}
+	intermediates := p.Intermediates
+	if len(intermediates) == 0 {
+		intermediates = DefaultIntermediates
+	}
+	for _, m := range intermediates {
+		first, ok := p.direct(asset, m)
+		if !ok {
+			continue
+		}
+		if second, ok := p.direct(m, quote); ok {
+			return first * second, true
+		}
+	}
+	for _, m1 := range intermediates {
+		first, ok := p.direct(asset, m1)
+		if !ok {
+			continue
+		}
+		for _, m2 := range intermediates {
+			second, ok := p.direct(m1, m2)
+			if !ok {
+				continue
+			}
+			if third, ok := p.direct(m2, quote); ok {
+				return first * second * third, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,248 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/common"
+	"github.com/uncle-gua/gobinance/delivery"
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// GetBalanceService get the unified account balance
+type GetBalanceService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset
+func (s *GetBalanceService) Asset(asset string) *GetBalanceService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/balance",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*Balance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	return res, nil
+}
+
+// Balance define unified account balance of an asset
+type Balance struct {
+	Asset               string  `json:"asset"`
+	TotalWalletBalance  float64 `json:"totalWalletBalance,string"`
+	CrossMarginAsset    float64 `json:"crossMarginAsset,string"`
+	CrossMarginBorrowed float64 `json:"crossMarginBorrowed,string"`
+	CrossMarginFree     float64 `json:"crossMarginFree,string"`
+	CrossMarginInterest float64 `json:"crossMarginInterest,string"`
+	CrossMarginLocked   float64 `json:"crossMarginLocked,string"`
+	UMWalletBalance     float64 `json:"umWalletBalance,string"`
+	UMUnrealizedPNL     float64 `json:"umUnrealizedPNL,string"`
+	CMWalletBalance     float64 `json:"cmWalletBalance,string"`
+	CMUnrealizedPNL     float64 `json:"cmUnrealizedPNL,string"`
+	NegativeBalance     float64 `json:"negativeBalance,string"`
+	UpdateTime          int64   `json:"updateTime"`
+}
+
+// GetAccountService get the unified account information
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/account",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Account)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Account define unified account info
+type Account struct {
+	UniMMR                   float64 `json:"uniMMR,string"`
+	AccountEquity            float64 `json:"accountEquity,string"`
+	ActualEquity             float64 `json:"actualEquity,string"`
+	AccountInitialMargin     float64 `json:"accountInitialMargin,string"`
+	AccountMaintMargin       float64 `json:"accountMaintMargin,string"`
+	AccountStatus            string  `json:"accountStatus"`
+	VirtualMaxWithdrawAmount float64 `json:"virtualMaxWithdrawAmount,string"`
+	TotalAvailableBalance    float64 `json:"totalAvailableBalance,string"`
+	TotalMarginOpenLoss      float64 `json:"totalMarginOpenLoss,string"`
+	UpdateTime               int64   `json:"updateTime"`
+}
+
+// GetUMPositionRiskService get UM position risk
+type GetUMPositionRiskService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetUMPositionRiskService) Symbol(symbol string) *GetUMPositionRiskService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetUMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*futures.PositionRisk, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/um/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*futures.PositionRisk{}, err
+	}
+	res = make([]*futures.PositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*futures.PositionRisk{}, err
+	}
+	return res, nil
+}
+
+// GetCMPositionRiskService get CM position risk
+type GetCMPositionRiskService struct {
+	c           *Client
+	pair        *string
+	marginAsset *string
+}
+
+// MarginAsset set margin asset
+func (s *GetCMPositionRiskService) MarginAsset(marginAsset string) *GetCMPositionRiskService {
+	s.marginAsset = &marginAsset
+	return s
+}
+
+// Pair set pair
+func (s *GetCMPositionRiskService) Pair(pair string) *GetCMPositionRiskService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *GetCMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*delivery.PositionRisk, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/cm/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	if s.marginAsset != nil {
+		r.SetParam("marginAsset", *s.marginAsset)
+	}
+	if s.pair != nil {
+		r.SetParam("pair", *s.pair)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*delivery.PositionRisk{}, err
+	}
+	res = make([]*delivery.PositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*delivery.PositionRisk{}, err
+	}
+	return res, nil
+}
+
+// AutoCollectionService collect all assets, or a single asset if set,
+// from the UM and CM wallets back to the margin wallet
+type AutoCollectionService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset
+func (s *AutoCollectionService) Asset(asset string) *AutoCollectionService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *AutoCollectionService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/auto-collection",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.Endpoint = "/papi/v1/asset-collection"
+		r.SetFormParam("asset", *s.asset)
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// BNBTransferService transfer BNB in and out of the UM wallet
+type BNBTransferService struct {
+	c            *Client
+	amount       string
+	transferSide BNBTransferSideType
+}
+
+// Amount set amount
+func (s *BNBTransferService) Amount(amount string) *BNBTransferService {
+	s.amount = amount
+	return s
+}
+
+// TransferSide set transferSide
+func (s *BNBTransferService) TransferSide(transferSide BNBTransferSideType) *BNBTransferService {
+	s.transferSide = transferSide
+	return s
+}
+
+// Do send request
+func (s *BNBTransferService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/bnb-transfer",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"amount":       s.amount,
+		"transferSide": s.transferSide,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,245 @@
+package portfoliomargin
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/uncle-gua/gobinance/internal/transport"
+)
+
+// BusinessUnitType define the business unit of an event, UM or CM
+type BusinessUnitType string
+
+// StrategyStatusType define conditional order status type
+type StrategyStatusType string
+
+// BNBTransferSideType define the direction of a BNB transfer
+type BNBTransferSideType string
+
+// UserDataEventType define user data event type
+type UserDataEventType string
+
+// Redefining the standard package
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Endpoints
+const (
+	baseApiMainUrl = "https://papi.binance.com"
+)
+
+// Global enums
+const (
+	BusinessUnitTypeUM BusinessUnitType = "UM"
+	BusinessUnitTypeCM BusinessUnitType = "CM"
+
+	StrategyStatusTypeNew       StrategyStatusType = "NEW"
+	StrategyStatusTypeCanceled  StrategyStatusType = "CANCELED"
+	StrategyStatusTypeTriggered StrategyStatusType = "TRIGGERED"
+	StrategyStatusTypeFinished  StrategyStatusType = "FINISHED"
+	StrategyStatusTypeExpired   StrategyStatusType = "EXPIRED"
+
+	BNBTransferSideTypeToUM   BNBTransferSideType = "TO_UM"
+	BNBTransferSideTypeFromUM BNBTransferSideType = "FROM_UM"
+
+	UserDataEventTypeListenKeyExpired            UserDataEventType = "listenKeyExpired"
+	UserDataEventTypeAccountUpdate               UserDataEventType = "ACCOUNT_UPDATE"
+	UserDataEventTypeOrderTradeUpdate            UserDataEventType = "ORDER_TRADE_UPDATE"
+	UserDataEventTypeConditionalOrderTradeUpdate UserDataEventType = "CONDITIONAL_ORDER_TRADE_UPDATE"
+	UserDataEventTypeAccountConfigUpdate         UserDataEventType = "ACCOUNT_CONFIG_UPDATE"
+	UserDataEventTypeExecutionReport             UserDataEventType = "executionReport"
+	UserDataEventTypeOutboundAccountPosition     UserDataEventType = "outboundAccountPosition"
+	UserDataEventTypeBalanceUpdate               UserDataEventType = "balanceUpdate"
+	UserDataEventTypeLiabilityChange             UserDataEventType = "liabilityChange"
+	UserDataEventTypeOpenOrderLoss               UserDataEventType = "openOrderLoss"
+	UserDataEventTypeRiskLevelChange             UserDataEventType = "riskLevelChange"
+)
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+func NewClient(apiKey, secretKey string) *Client {
+	return &Client{
+		APIKey:     apiKey,
+		SecretKey:  secretKey,
+		BaseURL:    baseApiMainUrl,
+		UserAgent:  "Binance/golang",
+		HTTPClient: http.DefaultClient,
+		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+	}
+}
+
+type doFunc = transport.DoFunc
+
+// Client define API client
+type Client struct {
+	APIKey     string
+	SecretKey  string
+	BaseURL    string
+	UserAgent  string
+	HTTPClient *http.Client
+	Debug      bool
+	Logger     *log.Logger
+	TimeOffset int64
+	do         doFunc
+}
+
+func (c *Client) transport() *transport.Client {
+	return &transport.Client{
+		APIKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		BaseURL:    c.BaseURL,
+		HTTPClient: c.HTTPClient,
+		Debug:      c.Debug,
+		Logger:     c.Logger,
+		TimeOffset: c.TimeOffset,
+		Do:         c.do,
+	}
+}
+
+func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	data, _, err = c.transport().CallAPI(ctx, r, opts...)
+	return data, err
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewCreateUMOrderService init creating UM order service
+func (c *Client) NewCreateUMOrderService() *CreateUMOrderService {
+	return &CreateUMOrderService{c: c}
+}
+
+// NewGetUMOrderService init get UM order service
+func (c *Client) NewGetUMOrderService() *GetUMOrderService {
+	return &GetUMOrderService{c: c}
+}
+
+// NewCancelUMOrderService init cancel UM order service
+func (c *Client) NewCancelUMOrderService() *CancelUMOrderService {
+	return &CancelUMOrderService{c: c}
+}
+
+// NewCancelAllUMOpenOrdersService init cancel all UM open orders service
+func (c *Client) NewCancelAllUMOpenOrdersService() *CancelAllUMOpenOrdersService {
+	return &CancelAllUMOpenOrdersService{c: c}
+}
+
+// NewListUMOpenOrdersService init list UM open orders service
+func (c *Client) NewListUMOpenOrdersService() *ListUMOpenOrdersService {
+	return &ListUMOpenOrdersService{c: c}
+}
+
+// NewCreateCMOrderService init creating CM order service
+func (c *Client) NewCreateCMOrderService() *CreateCMOrderService {
+	return &CreateCMOrderService{c: c}
+}
+
+// NewGetCMOrderService init get CM order service
+func (c *Client) NewGetCMOrderService() *GetCMOrderService {
+	return &GetCMOrderService{c: c}
+}
+
+// NewCancelCMOrderService init cancel CM order service
+func (c *Client) NewCancelCMOrderService() *CancelCMOrderService {
+	return &CancelCMOrderService{c: c}
+}
+
+// NewCancelAllCMOpenOrdersService init cancel all CM open orders service
+func (c *Client) NewCancelAllCMOpenOrdersService() *CancelAllCMOpenOrdersService {
+	return &CancelAllCMOpenOrdersService{c: c}
+}
+
+// NewListCMOpenOrdersService init list CM open orders service
+func (c *Client) NewListCMOpenOrdersService() *ListCMOpenOrdersService {
+	return &ListCMOpenOrdersService{c: c}
+}
+
+// NewCreateUMConditionalOrderService init creating UM conditional order service
+func (c *Client) NewCreateUMConditionalOrderService() *CreateUMConditionalOrderService {
+	return &CreateUMConditionalOrderService{c: c}
+}
+
+// NewCancelUMConditionalOrderService init cancel UM conditional order service
+func (c *Client) NewCancelUMConditionalOrderService() *CancelConditionalOrderService {
+	return &CancelConditionalOrderService{c: c, market: "um"}
+}
+
+// NewListUMConditionalOpenOrdersService init list UM conditional open orders service
+func (c *Client) NewListUMConditionalOpenOrdersService() *ListConditionalOpenOrdersService {
+	return &ListConditionalOpenOrdersService{c: c, market: "um"}
+}
+
+// NewCreateCMConditionalOrderService init creating CM conditional order service
+func (c *Client) NewCreateCMConditionalOrderService() *CreateCMConditionalOrderService {
+	return &CreateCMConditionalOrderService{c: c}
+}
+
+// NewCancelCMConditionalOrderService init cancel CM conditional order service
+func (c *Client) NewCancelCMConditionalOrderService() *CancelConditionalOrderService {
+	return &CancelConditionalOrderService{c: c, market: "cm"}
+}
+
+// NewListCMConditionalOpenOrdersService init list CM conditional open orders service
+func (c *Client) NewListCMConditionalOpenOrdersService() *ListConditionalOpenOrdersService {
+	return &ListConditionalOpenOrdersService{c: c, market: "cm"}
+}
+
+// NewMarginLoanService init margin loan service
+func (c *Client) NewMarginLoanService() *MarginLoanService {
+	return &MarginLoanService{c: c}
+}
+
+// NewMarginRepayService init margin repay service
+func (c *Client) NewMarginRepayService() *MarginRepayService {
+	return &MarginRepayService{c: c}
+}
+
+// NewGetBalanceService init getting balance service
+func (c *Client) NewGetBalanceService() *GetBalanceService {
+	return &GetBalanceService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewGetUMPositionRiskService init getting UM position risk service
+func (c *Client) NewGetUMPositionRiskService() *GetUMPositionRiskService {
+	return &GetUMPositionRiskService{c: c}
+}
+
+// NewGetCMPositionRiskService init getting CM position risk service
+func (c *Client) NewGetCMPositionRiskService() *GetCMPositionRiskService {
+	return &GetCMPositionRiskService{c: c}
+}
+
+// NewAutoCollectionService init auto collection service
+func (c *Client) NewAutoCollectionService() *AutoCollectionService {
+	return &AutoCollectionService{c: c}
+}
+
+// NewBNBTransferService init BNB transfer service
+func (c *Client) NewBNBTransferService() *BNBTransferService {
+	return &BNBTransferService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
@@ -0,0 +1,170 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/delivery"
+)
+
+// CreateCMConditionalOrderService create CM conditional order
+type CreateCMConditionalOrderService struct {
+	c                   *Client
+	symbol              string
+	side                delivery.SideType
+	positionSide        *delivery.PositionSideType
+	strategyType        delivery.OrderType
+	timeInForce         *delivery.TimeInForceType
+	quantity            *string
+	reduceOnly          *bool
+	price               *string
+	workingType         *delivery.WorkingType
+	priceProtect        *bool
+	newClientStrategyID *string
+	stopPrice           *string
+	activationPrice     *string
+	callbackRate        *string
+}
+
+// Symbol set symbol
+func (s *CreateCMConditionalOrderService) Symbol(symbol string) *CreateCMConditionalOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateCMConditionalOrderService) Side(side delivery.SideType) *CreateCMConditionalOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateCMConditionalOrderService) PositionSide(positionSide delivery.PositionSideType) *CreateCMConditionalOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// StrategyType set strategyType, one of STOP, STOP_MARKET, TAKE_PROFIT,
+// TAKE_PROFIT_MARKET or TRAILING_STOP_MARKET
+func (s *CreateCMConditionalOrderService) StrategyType(strategyType delivery.OrderType) *CreateCMConditionalOrderService {
+	s.strategyType = strategyType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateCMConditionalOrderService) TimeInForce(timeInForce delivery.TimeInForceType) *CreateCMConditionalOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateCMConditionalOrderService) Quantity(quantity string) *CreateCMConditionalOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateCMConditionalOrderService) ReduceOnly(reduceOnly bool) *CreateCMConditionalOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateCMConditionalOrderService) Price(price string) *CreateCMConditionalOrderService {
+	s.price = &price
+	return s
+}
+
+// WorkingType set workingType
+func (s *CreateCMConditionalOrderService) WorkingType(workingType delivery.WorkingType) *CreateCMConditionalOrderService {
+	s.workingType = &workingType
+	return s
+}
+
+// PriceProtect set priceProtect
+func (s *CreateCMConditionalOrderService) PriceProtect(priceProtect bool) *CreateCMConditionalOrderService {
+	s.priceProtect = &priceProtect
+	return s
+}
+
+// NewClientStrategyID set newClientStrategyID
+func (s *CreateCMConditionalOrderService) NewClientStrategyID(newClientStrategyID string) *CreateCMConditionalOrderService {
+	s.newClientStrategyID = &newClientStrategyID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CreateCMConditionalOrderService) StopPrice(stopPrice string) *CreateCMConditionalOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// ActivationPrice set activationPrice
+func (s *CreateCMConditionalOrderService) ActivationPrice(activationPrice string) *CreateCMConditionalOrderService {
+	s.activationPrice = &activationPrice
+	return s
+}
+
+// CallbackRate set callbackRate
+func (s *CreateCMConditionalOrderService) CallbackRate(callbackRate string) *CreateCMConditionalOrderService {
+	s.callbackRate = &callbackRate
+	return s
+}
+
+// Do send request
+func (s *CreateCMConditionalOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConditionalOrder, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/cm/conditional/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":       s.symbol,
+		"side":         s.side,
+		"strategyType": s.strategyType,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.workingType != nil {
+		m["workingType"] = *s.workingType
+	}
+	if s.priceProtect != nil {
+		m["priceProtect"] = *s.priceProtect
+	}
+	if s.newClientStrategyID != nil {
+		m["newClientStrategyId"] = *s.newClientStrategyID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.activationPrice != nil {
+		m["activationPrice"] = *s.activationPrice
+	}
+	if s.callbackRate != nil {
+		m["callbackRate"] = *s.callbackRate
+	}
+	r.SetFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConditionalOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
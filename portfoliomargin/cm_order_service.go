@@ -0,0 +1,289 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/delivery"
+)
+
+// CreateCMOrderService create CM order
+type CreateCMOrderService struct {
+	c                *Client
+	symbol           string
+	side             delivery.SideType
+	positionSide     *delivery.PositionSideType
+	orderType        delivery.OrderType
+	timeInForce      *delivery.TimeInForceType
+	quantity         string
+	reduceOnly       *bool
+	price            *string
+	newClientOrderID *string
+	newOrderRespType *delivery.NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateCMOrderService) Symbol(symbol string) *CreateCMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateCMOrderService) Side(side delivery.SideType) *CreateCMOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateCMOrderService) PositionSide(positionSide delivery.PositionSideType) *CreateCMOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type, LIMIT or MARKET
+func (s *CreateCMOrderService) Type(orderType delivery.OrderType) *CreateCMOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateCMOrderService) TimeInForce(timeInForce delivery.TimeInForceType) *CreateCMOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateCMOrderService) Quantity(quantity string) *CreateCMOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateCMOrderService) ReduceOnly(reduceOnly bool) *CreateCMOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateCMOrderService) Price(price string) *CreateCMOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateCMOrderService) NewClientOrderID(newClientOrderID string) *CreateCMOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateCMOrderService) NewOrderResponseType(newOrderResponseType delivery.NewOrderRespType) *CreateCMOrderService {
+	s.newOrderRespType = &newOrderResponseType
+	return s
+}
+
+// Do send request
+func (s *CreateCMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *delivery.CreateOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/cm/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.SetFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(delivery.CreateOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCMOrderService get CM order
+type GetCMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetCMOrderService) Symbol(symbol string) *GetCMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetCMOrderService) OrderID(orderID int64) *GetCMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetCMOrderService) OrigClientOrderID(origClientOrderID string) *GetCMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetCMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *delivery.Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/cm/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(delivery.Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelCMOrderService cancel CM order
+type CancelCMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelCMOrderService) Symbol(symbol string) *CancelCMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelCMOrderService) OrderID(orderID int64) *CancelCMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelCMOrderService) OrigClientOrderID(origClientOrderID string) *CancelCMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelCMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *delivery.CancelOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/cm/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(delivery.CancelOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllCMOpenOrdersService cancel all CM open orders of a symbol
+type CancelAllCMOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllCMOpenOrdersService) Symbol(symbol string) *CancelAllCMOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllCMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/cm/allOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListCMOpenOrdersService list CM opened orders
+type ListCMOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *ListCMOpenOrdersService) Symbol(symbol string) *ListCMOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *ListCMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*delivery.Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/cm/openOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*delivery.Order{}, err
+	}
+	res = make([]*delivery.Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*delivery.Order{}, err
+	}
+	return res, nil
+}
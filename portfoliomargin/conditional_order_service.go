@@ -0,0 +1,122 @@
+package portfoliomargin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ConditionalOrder define UM or CM conditional order info, the enums are the
+// ones of the futures and delivery packages
+type ConditionalOrder struct {
+	NewClientStrategyID     string             `json:"newClientStrategyId"`
+	StrategyID              int64              `json:"strategyId"`
+	StrategyStatus          StrategyStatusType `json:"strategyStatus"`
+	StrategyType            string             `json:"strategyType"`
+	OrigQuantity            float64            `json:"origQty,string"`
+	Price                   float64            `json:"price,string"`
+	ReduceOnly              bool               `json:"reduceOnly"`
+	Side                    string             `json:"side"`
+	PositionSide            string             `json:"positionSide"`
+	StopPrice               float64            `json:"stopPrice,string"`
+	Symbol                  string             `json:"symbol"`
+	Pair                    string             `json:"pair"` // CM only
+	TimeInForce             string             `json:"timeInForce"`
+	ActivatePrice           float64            `json:"activatePrice,string"`
+	PriceRate               float64            `json:"priceRate,string"`
+	BookTime                int64              `json:"bookTime"`
+	UpdateTime              int64              `json:"updateTime"`
+	WorkingType             string             `json:"workingType"`
+	PriceProtect            bool               `json:"priceProtect"`
+	SelfTradePreventionMode string             `json:"selfTradePreventionMode"` // UM only
+	GoodTillDate            int64              `json:"goodTillDate"`            // UM only
+	PriceMatch              string             `json:"priceMatch"`              // UM only
+}
+
+// CancelConditionalOrderService cancel UM or CM conditional order
+type CancelConditionalOrderService struct {
+	c                   *Client
+	market              string
+	symbol              string
+	strategyID          *int64
+	newClientStrategyID *string
+}
+
+// Symbol set symbol
+func (s *CancelConditionalOrderService) Symbol(symbol string) *CancelConditionalOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// StrategyID set strategyID
+func (s *CancelConditionalOrderService) StrategyID(strategyID int64) *CancelConditionalOrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// NewClientStrategyID set newClientStrategyID
+func (s *CancelConditionalOrderService) NewClientStrategyID(newClientStrategyID string) *CancelConditionalOrderService {
+	s.newClientStrategyID = &newClientStrategyID
+	return s
+}
+
+// Do send request
+func (s *CancelConditionalOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConditionalOrder, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/papi/v1/%s/conditional/order", s.market),
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.strategyID != nil {
+		r.SetFormParam("strategyId", *s.strategyID)
+	}
+	if s.newClientStrategyID != nil {
+		r.SetFormParam("newClientStrategyId", *s.newClientStrategyID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConditionalOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListConditionalOpenOrdersService list UM or CM opened conditional orders
+type ListConditionalOpenOrdersService struct {
+	c      *Client
+	market string
+	symbol string
+}
+
+// Symbol set symbol
+func (s *ListConditionalOpenOrdersService) Symbol(symbol string) *ListConditionalOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *ListConditionalOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*ConditionalOrder, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/papi/v1/%s/conditional/openOrders", s.market),
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConditionalOrder{}, err
+	}
+	res = make([]*ConditionalOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConditionalOrder{}, err
+	}
+	return res, nil
+}
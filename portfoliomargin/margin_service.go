@@ -0,0 +1,81 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarginLoanService borrow an asset in the cross margin account
+type MarginLoanService struct {
+	c      *Client
+	asset  string
+	amount string
+}
+
+// Asset set asset
+func (s *MarginLoanService) Asset(asset string) *MarginLoanService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *MarginLoanService) Amount(amount string) *MarginLoanService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *MarginLoanService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	return marginLoanOrRepay(ctx, s.c, "/papi/v1/marginLoan", s.asset, s.amount, opts...)
+}
+
+// MarginRepayService repay a cross margin loan
+type MarginRepayService struct {
+	c      *Client
+	asset  string
+	amount string
+}
+
+// Asset set asset
+func (s *MarginRepayService) Asset(asset string) *MarginRepayService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *MarginRepayService) Amount(amount string) *MarginRepayService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *MarginRepayService) Do(ctx context.Context, opts ...RequestOption) (res *TransactionResponse, err error) {
+	return marginLoanOrRepay(ctx, s.c, "/papi/v1/repayLoan", s.asset, s.amount, opts...)
+}
+
+// TransactionResponse define transaction response
+type TransactionResponse struct {
+	TranID int64 `json:"tranId"`
+}
+
+func marginLoanOrRepay(ctx context.Context, c *Client, endpoint string, asset string, amount string, opts ...RequestOption) (res *TransactionResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParams(params{
+		"asset":  asset,
+		"amount": amount,
+	})
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
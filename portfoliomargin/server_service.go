@@ -0,0 +1,21 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+)
+
+// PingService ping server
+type PingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/ping",
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
@@ -0,0 +1,16 @@
+package portfoliomargin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uncle-gua/gobinance/portfoliomargin"
+)
+
+func TestPing(t *testing.T) {
+	client := portfoliomargin.NewClient("", "")
+	err := client.NewPingService().Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
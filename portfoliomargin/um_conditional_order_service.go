@@ -0,0 +1,200 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// CreateUMConditionalOrderService create UM conditional order
+type CreateUMConditionalOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    futures.SideType
+	positionSide            *futures.PositionSideType
+	strategyType            futures.OrderType
+	timeInForce             *futures.TimeInForceType
+	quantity                *string
+	reduceOnly              *bool
+	price                   *string
+	workingType             *futures.WorkingType
+	priceProtect            *bool
+	newClientStrategyID     *string
+	stopPrice               *string
+	activationPrice         *string
+	callbackRate            *string
+	priceMatch              *futures.PriceMatchType
+	selfTradePreventionMode *futures.SelfTradePreventionModeType
+	goodTillDate            *int64
+}
+
+// Symbol set symbol
+func (s *CreateUMConditionalOrderService) Symbol(symbol string) *CreateUMConditionalOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateUMConditionalOrderService) Side(side futures.SideType) *CreateUMConditionalOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateUMConditionalOrderService) PositionSide(positionSide futures.PositionSideType) *CreateUMConditionalOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// StrategyType set strategyType, one of STOP, STOP_MARKET, TAKE_PROFIT,
+// TAKE_PROFIT_MARKET or TRAILING_STOP_MARKET
+func (s *CreateUMConditionalOrderService) StrategyType(strategyType futures.OrderType) *CreateUMConditionalOrderService {
+	s.strategyType = strategyType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateUMConditionalOrderService) TimeInForce(timeInForce futures.TimeInForceType) *CreateUMConditionalOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateUMConditionalOrderService) Quantity(quantity string) *CreateUMConditionalOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateUMConditionalOrderService) ReduceOnly(reduceOnly bool) *CreateUMConditionalOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateUMConditionalOrderService) Price(price string) *CreateUMConditionalOrderService {
+	s.price = &price
+	return s
+}
+
+// WorkingType set workingType
+func (s *CreateUMConditionalOrderService) WorkingType(workingType futures.WorkingType) *CreateUMConditionalOrderService {
+	s.workingType = &workingType
+	return s
+}
+
+// PriceProtect set priceProtect
+func (s *CreateUMConditionalOrderService) PriceProtect(priceProtect bool) *CreateUMConditionalOrderService {
+	s.priceProtect = &priceProtect
+	return s
+}
+
+// NewClientStrategyID set newClientStrategyID
+func (s *CreateUMConditionalOrderService) NewClientStrategyID(newClientStrategyID string) *CreateUMConditionalOrderService {
+	s.newClientStrategyID = &newClientStrategyID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CreateUMConditionalOrderService) StopPrice(stopPrice string) *CreateUMConditionalOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// ActivationPrice set activationPrice
+func (s *CreateUMConditionalOrderService) ActivationPrice(activationPrice string) *CreateUMConditionalOrderService {
+	s.activationPrice = &activationPrice
+	return s
+}
+
+// CallbackRate set callbackRate
+func (s *CreateUMConditionalOrderService) CallbackRate(callbackRate string) *CreateUMConditionalOrderService {
+	s.callbackRate = &callbackRate
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *CreateUMConditionalOrderService) PriceMatch(priceMatch futures.PriceMatchType) *CreateUMConditionalOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateUMConditionalOrderService) SelfTradePreventionMode(selfTradePreventionMode futures.SelfTradePreventionModeType) *CreateUMConditionalOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// GoodTillDate set goodTillDate
+func (s *CreateUMConditionalOrderService) GoodTillDate(goodTillDate int64) *CreateUMConditionalOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// Do send request
+func (s *CreateUMConditionalOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConditionalOrder, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/um/conditional/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":       s.symbol,
+		"side":         s.side,
+		"strategyType": s.strategyType,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.workingType != nil {
+		m["workingType"] = *s.workingType
+	}
+	if s.priceProtect != nil {
+		m["priceProtect"] = *s.priceProtect
+	}
+	if s.newClientStrategyID != nil {
+		m["newClientStrategyId"] = *s.newClientStrategyID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.activationPrice != nil {
+		m["activationPrice"] = *s.activationPrice
+	}
+	if s.callbackRate != nil {
+		m["callbackRate"] = *s.callbackRate
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
+	r.SetFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConditionalOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
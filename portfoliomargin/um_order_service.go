@@ -0,0 +1,319 @@
+package portfoliomargin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/uncle-gua/gobinance/futures"
+)
+
+// CreateUMOrderService create UM order
+type CreateUMOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    futures.SideType
+	positionSide            *futures.PositionSideType
+	orderType               futures.OrderType
+	timeInForce             *futures.TimeInForceType
+	quantity                string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderID        *string
+	newOrderRespType        *futures.NewOrderRespType
+	priceMatch              *futures.PriceMatchType
+	selfTradePreventionMode *futures.SelfTradePreventionModeType
+	goodTillDate            *int64
+}
+
+// Symbol set symbol
+func (s *CreateUMOrderService) Symbol(symbol string) *CreateUMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateUMOrderService) Side(side futures.SideType) *CreateUMOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateUMOrderService) PositionSide(positionSide futures.PositionSideType) *CreateUMOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type, LIMIT or MARKET
+func (s *CreateUMOrderService) Type(orderType futures.OrderType) *CreateUMOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateUMOrderService) TimeInForce(timeInForce futures.TimeInForceType) *CreateUMOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateUMOrderService) Quantity(quantity string) *CreateUMOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateUMOrderService) ReduceOnly(reduceOnly bool) *CreateUMOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateUMOrderService) Price(price string) *CreateUMOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateUMOrderService) NewClientOrderID(newClientOrderID string) *CreateUMOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *CreateUMOrderService) NewOrderResponseType(newOrderResponseType futures.NewOrderRespType) *CreateUMOrderService {
+	s.newOrderRespType = &newOrderResponseType
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *CreateUMOrderService) PriceMatch(priceMatch futures.PriceMatchType) *CreateUMOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateUMOrderService) SelfTradePreventionMode(selfTradePreventionMode futures.SelfTradePreventionModeType) *CreateUMOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// GoodTillDate set goodTillDate
+func (s *CreateUMOrderService) GoodTillDate(goodTillDate int64) *CreateUMOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// Do send request
+func (s *CreateUMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *futures.CreateOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/papi/v1/um/order",
+		SecType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
+	r.SetFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(futures.CreateOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetUMOrderService get UM order
+type GetUMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetUMOrderService) Symbol(symbol string) *GetUMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetUMOrderService) OrderID(orderID int64) *GetUMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetUMOrderService) OrigClientOrderID(origClientOrderID string) *GetUMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetUMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *futures.Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/um/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(futures.Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelUMOrderService cancel UM order
+type CancelUMOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelUMOrderService) Symbol(symbol string) *CancelUMOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelUMOrderService) OrderID(orderID int64) *CancelUMOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelUMOrderService) OrigClientOrderID(origClientOrderID string) *CancelUMOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelUMOrderService) Do(ctx context.Context, opts ...RequestOption) (res *futures.CancelOrderResponse, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/um/order",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(futures.CancelOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllUMOpenOrdersService cancel all UM open orders of a symbol
+type CancelAllUMOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllUMOpenOrdersService) Symbol(symbol string) *CancelAllUMOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllUMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/papi/v1/um/allOpenOrders",
+		SecType:  secTypeSigned,
+	}
+	r.SetFormParam("symbol", s.symbol)
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListUMOpenOrdersService list UM opened orders
+type ListUMOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *ListUMOpenOrdersService) Symbol(symbol string) *ListUMOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *ListUMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*futures.Order, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/papi/v1/um/openOrders",
+		SecType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.SetParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*futures.Order{}, err
+	}
+	res = make([]*futures.Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*futures.Order{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,99 @@
+package portfoliomargin
+
+import (
+	"fmt"
+
+	"github.com/uncle-gua/gobinance/futures"
+	"github.com/uncle-gua/wsc"
+)
+
+// Endpoints
+const (
+	baseWsMainUrl = "wss://fstream.binance.com/pm/ws"
+)
+
+// WsUserDataEvent define portfolio margin user data event. UM and CM events
+// reuse the futures models, margin events (executionReport,
+// outboundAccountPosition, ...) only carry Event, Time and the Raw message,
+// which can be decoded with the models of the root package.
+type WsUserDataEvent struct {
+	Event               UserDataEventType             `json:"e"`
+	BusinessUnit        BusinessUnitType              `json:"fs"`
+	Time                int64                         `json:"E"`
+	TransactionTime     int64                         `json:"T"`
+	AccountAlias        string                        `json:"i"`
+	AccountUpdate       futures.WsAccountUpdate       `json:"a"`
+	OrderTradeUpdate    futures.WsOrderTradeUpdate    `json:"o"`
+	StrategyUpdate      WsStrategyUpdate              `json:"so"`
+	AccountConfigUpdate futures.WsAccountConfigUpdate `json:"ac"`
+	Raw                 []byte                        `json:"-"`
+}
+
+// WsStrategyUpdate define conditional order update
+type WsStrategyUpdate struct {
+	Symbol              string             `json:"s"`
+	NewClientStrategyID string             `json:"c"`
+	StrategyID          int64              `json:"si"`
+	Side                string             `json:"S"`
+	StrategyType        string             `json:"st"`
+	TimeInForce         string             `json:"f"`
+	OrigQuantity        float64            `json:"q,string"`
+	Price               float64            `json:"p,string"`
+	StopPrice           float64            `json:"sp,string"`
+	StrategyStatus      StrategyStatusType `json:"os"`
+	BookTime            int64              `json:"T"`
+	UpdateTime          int64              `json:"ut"`
+	ReduceOnly          bool               `json:"R"`
+	WorkingType         string             `json:"wt"`
+	PositionSide        string             `json:"ps"`
+	ClosePosition       bool               `json:"cp"`
+	ActivationPrice     float64            `json:"AP,string"`
+	CallbackRate        float64            `json:"cr,string"`
+	OrderID             int64              `json:"i"`
+}
+
+// WsUserDataHandler handle WsUserDataEvent
+type WsUserDataHandler func(event *WsUserDataEvent)
+
+// WsUserDataServe serve user data handler with listen key
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (ws *wsc.Wsc, done chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", baseWsMainUrl, listenKey)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsUserDataEvent)
+		err := decodeUserDataEvent(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+func decodeUserDataEvent(message []byte, event *WsUserDataEvent) error {
+	probe := struct {
+		Event UserDataEventType `json:"e"`
+		Time  int64             `json:"E"`
+	}{}
+	err := json.Unmarshal(message, &probe)
+	if err != nil {
+		return err
+	}
+	switch probe.Event {
+	case UserDataEventTypeAccountUpdate,
+		UserDataEventTypeOrderTradeUpdate,
+		UserDataEventTypeConditionalOrderTradeUpdate,
+		UserDataEventTypeAccountConfigUpdate:
+		err = json.Unmarshal(message, event)
+		if err != nil {
+			return err
+		}
+	default:
+		// margin events reuse short keys such as "o" with other meanings
+		event.Event = probe.Event
+		event.Time = probe.Time
+	}
+	event.Raw = message
+	return nil
+}
@@ -14,9 +14,9 @@ type RateLimitService struct {
 func (s *RateLimitService) Do(ctx context.Context, opts ...RequestOption) (res []*RateLimitFull, err error) {
 	res = make([]*RateLimitFull, 0)
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/rateLimit/order",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/rateLimit/order",
+		SecType:  secTypeSigned,
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
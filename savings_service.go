@@ -6,6 +6,8 @@ import (
 )
 
 // ListSavingsFlexibleProductsService https://binance-docs.github.io/apidocs/spot/en/#get-flexible-product-list-user_data
+//
+// Deprecated: /sapi/v1/lending is retired, use ListSimpleEarnFlexibleProductsService, SimpleEarnFlexibleProduct.SavingsFlexibleProduct converts the new model
 type ListSavingsFlexibleProductsService struct {
 	c        *Client
 	status   string
@@ -41,9 +43,9 @@ func (s *ListSavingsFlexibleProductsService) Size(size int64) *ListSavingsFlexib
 // Do send request
 func (s *ListSavingsFlexibleProductsService) Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFlexibleProduct, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/daily/product/list",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/lending/daily/product/list",
+		SecType:  secTypeSigned,
 	}
 	m := params{}
 	if s.status != "" {
@@ -58,7 +60,7 @@ func (s *ListSavingsFlexibleProductsService) Do(ctx context.Context, opts ...Req
 	if s.size != 0 {
 		m["size"] = s.size
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -88,6 +90,8 @@ type SavingsFlexibleProduct struct {
 }
 
 // PurchaseSavingsFlexibleProductService https://binance-docs.github.io/apidocs/spot/en/#purchase-flexible-product-user_data
+//
+// Deprecated: /sapi/v1/lending is retired, use SubscribeSimpleEarnFlexibleProductService
 type PurchaseSavingsFlexibleProductService struct {
 	c         *Client
 	productId string
@@ -109,15 +113,15 @@ func (s *PurchaseSavingsFlexibleProductService) Amount(amount float64) *Purchase
 // Do send request
 func (s *PurchaseSavingsFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (uint64, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/lending/daily/purchase",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/lending/daily/purchase",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"productId": s.productId,
 		"amount":    s.amount,
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return 0, err
@@ -136,6 +140,8 @@ type PurchaseSavingsFlexibleProductResponse struct {
 }
 
 // RedeemSavingsFlexibleProductService https://binance-docs.github.io/apidocs/spot/en/#redeem-flexible-product-user_data
+//
+// Deprecated: /sapi/v1/lending is retired, use RedeemSimpleEarnFlexibleProductService
 type RedeemSavingsFlexibleProductService struct {
 	c          *Client
 	productId  string
@@ -164,9 +170,9 @@ func (s *RedeemSavingsFlexibleProductService) Type(redeemType string) *RedeemSav
 // Do send request
 func (s *RedeemSavingsFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) error {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/lending/daily/redeem",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/lending/daily/redeem",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"productId": s.productId,
@@ -175,13 +181,15 @@ func (s *RedeemSavingsFlexibleProductService) Do(ctx context.Context, opts ...Re
 	if s.redeemType != "" {
 		m["type"] = s.redeemType
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	_, err := s.c.callAPI(ctx, r, opts...)
 
 	return err
 }
 
 // ListSavingsFixedAndActivityProductsService https://binance-docs.github.io/apidocs/spot/en/#get-fixed-and-activity-project-list-user_data
+//
+// Deprecated: /sapi/v1/lending is retired, use ListSimpleEarnLockedProductsService
 type ListSavingsFixedAndActivityProductsService struct {
 	c           *Client
 	asset       string
@@ -238,9 +246,9 @@ func (s *ListSavingsFixedAndActivityProductsService) Size(size int64) *ListSavin
 // Do send request
 func (s *ListSavingsFixedAndActivityProductsService) Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFixedProduct, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/project/list",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/lending/project/list",
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"type": s.projectType,
@@ -263,7 +271,7 @@ func (s *ListSavingsFixedAndActivityProductsService) Do(ctx context.Context, opt
 	if s.size != 10 {
 		m["size"] = s.size
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -296,6 +304,8 @@ type SavingsFixedProduct struct {
 }
 
 // SavingFlexibleProductPositionsService fetches the saving flexible product positions
+//
+// Deprecated: /sapi/v1/lending is retired, use GetSimpleEarnFlexiblePositionService, SimpleEarnFlexiblePosition.SavingFlexibleProductPosition converts the new model
 type SavingFlexibleProductPositionsService struct {
 	c     *Client
 	asset string
@@ -310,15 +320,15 @@ func (s *SavingFlexibleProductPositionsService) Asset(asset string) *SavingFlexi
 // Do send request
 func (s *SavingFlexibleProductPositionsService) Do(ctx context.Context, opts ...RequestOption) ([]*SavingFlexibleProductPosition, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/daily/token/position",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/lending/daily/token/position",
+		SecType:  secTypeSigned,
 	}
 	m := params{}
 	if s.asset != "" {
 		m["asset"] = s.asset
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -349,6 +359,8 @@ type SavingFlexibleProductPosition struct {
 }
 
 // SavingFixedProjectPositionsService fetches the saving flexible product positions
+//
+// Deprecated: /sapi/v1/lending is retired, use GetSimpleEarnLockedPositionService
 type SavingFixedProjectPositionsService struct {
 	c         *Client
 	asset     string
@@ -377,9 +389,9 @@ func (s *SavingFixedProjectPositionsService) ProjectID(projectId string) *Saving
 // Do send request
 func (s *SavingFixedProjectPositionsService) Do(ctx context.Context, opts ...RequestOption) ([]*SavingFixedProjectPosition, error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/lending/project/position/list",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/lending/project/position/list",
+		SecType:  secTypeSigned,
 	}
 	m := params{}
 	if s.asset != "" {
@@ -391,7 +403,7 @@ func (s *SavingFixedProjectPositionsService) Do(ctx context.Context, opts ...Req
 	if s.projectId != "" {
 		m["projectId"] = s.projectId
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,747 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListSimpleEarnFlexibleSubscriptionRecordService list flexible subscription records
+type ListSimpleEarnFlexibleSubscriptionRecordService struct {
+	c          *Client
+	productId  *string
+	purchaseId *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// ProductId set productId
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) ProductId(productId string) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.productId = &productId
+	return s
+}
+
+// PurchaseId set purchaseId
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) PurchaseId(purchaseId int64) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.purchaseId = &purchaseId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) Asset(asset string) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) StartTime(startTime int64) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) EndTime(endTime int64) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) Current(current int64) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) Size(size int64) *ListSimpleEarnFlexibleSubscriptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleSubscriptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleSubscriptionRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/history/subscriptionRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.productId != nil {
+		r.SetParam("productId", *s.productId)
+	}
+	if s.purchaseId != nil {
+		r.SetParam("purchaseId", *s.purchaseId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleSubscriptionRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnLockedSubscriptionRecordService list locked subscription records
+type ListSimpleEarnLockedSubscriptionRecordService struct {
+	c          *Client
+	purchaseId *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// PurchaseId set purchaseId
+func (s *ListSimpleEarnLockedSubscriptionRecordService) PurchaseId(purchaseId int64) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.purchaseId = &purchaseId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedSubscriptionRecordService) Asset(asset string) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnLockedSubscriptionRecordService) StartTime(startTime int64) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnLockedSubscriptionRecordService) EndTime(endTime int64) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnLockedSubscriptionRecordService) Current(current int64) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnLockedSubscriptionRecordService) Size(size int64) *ListSimpleEarnLockedSubscriptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnLockedSubscriptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedSubscriptionRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/history/subscriptionRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.purchaseId != nil {
+		r.SetParam("purchaseId", *s.purchaseId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedSubscriptionRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnFlexibleRedemptionRecordService list flexible redemption records
+type ListSimpleEarnFlexibleRedemptionRecordService struct {
+	c         *Client
+	productId *string
+	redeemId  *int64
+	asset     *string
+	startTime *int64
+	endTime   *int64
+	current   *int64
+	size      *int64
+}
+
+// ProductId set productId
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) ProductId(productId string) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.productId = &productId
+	return s
+}
+
+// RedeemId set redeemId
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) RedeemId(redeemId int64) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.redeemId = &redeemId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) Asset(asset string) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) StartTime(startTime int64) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) EndTime(endTime int64) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) Current(current int64) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) Size(size int64) *ListSimpleEarnFlexibleRedemptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleRedemptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleRedemptionRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/history/redemptionRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.productId != nil {
+		r.SetParam("productId", *s.productId)
+	}
+	if s.redeemId != nil {
+		r.SetParam("redeemId", *s.redeemId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleRedemptionRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnLockedRedemptionRecordService list locked redemption records
+type ListSimpleEarnLockedRedemptionRecordService struct {
+	c          *Client
+	positionId *int64
+	redeemId   *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// PositionId set positionId
+func (s *ListSimpleEarnLockedRedemptionRecordService) PositionId(positionId int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.positionId = &positionId
+	return s
+}
+
+// RedeemId set redeemId
+func (s *ListSimpleEarnLockedRedemptionRecordService) RedeemId(redeemId int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.redeemId = &redeemId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedRedemptionRecordService) Asset(asset string) *ListSimpleEarnLockedRedemptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnLockedRedemptionRecordService) StartTime(startTime int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnLockedRedemptionRecordService) EndTime(endTime int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnLockedRedemptionRecordService) Current(current int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnLockedRedemptionRecordService) Size(size int64) *ListSimpleEarnLockedRedemptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnLockedRedemptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedRedemptionRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/history/redemptionRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.positionId != nil {
+		r.SetParam("positionId", *s.positionId)
+	}
+	if s.redeemId != nil {
+		r.SetParam("redeemId", *s.redeemId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedRedemptionRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnFlexibleRewardsRecordService list flexible rewards records
+type ListSimpleEarnFlexibleRewardsRecordService struct {
+	c          *Client
+	rewardType SimpleEarnRewardType
+	productId  *string
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// Type set type (BONUS, REALTIME, REWARDS), required
+func (s *ListSimpleEarnFlexibleRewardsRecordService) Type(rewardType SimpleEarnRewardType) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.rewardType = rewardType
+	return s
+}
+
+// ProductId set productId
+func (s *ListSimpleEarnFlexibleRewardsRecordService) ProductId(productId string) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.productId = &productId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleRewardsRecordService) Asset(asset string) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnFlexibleRewardsRecordService) StartTime(startTime int64) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnFlexibleRewardsRecordService) EndTime(endTime int64) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnFlexibleRewardsRecordService) Current(current int64) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnFlexibleRewardsRecordService) Size(size int64) *ListSimpleEarnFlexibleRewardsRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleRewardsRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleRewardsRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/history/rewardsRecord",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("type", s.rewardType)
+	if s.productId != nil {
+		r.SetParam("productId", *s.productId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleRewardsRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnLockedRewardsRecordService list locked rewards records
+type ListSimpleEarnLockedRewardsRecordService struct {
+	c          *Client
+	positionId *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// PositionId set positionId
+func (s *ListSimpleEarnLockedRewardsRecordService) PositionId(positionId int64) *ListSimpleEarnLockedRewardsRecordService {
+	s.positionId = &positionId
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedRewardsRecordService) Asset(asset string) *ListSimpleEarnLockedRewardsRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnLockedRewardsRecordService) StartTime(startTime int64) *ListSimpleEarnLockedRewardsRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnLockedRewardsRecordService) EndTime(endTime int64) *ListSimpleEarnLockedRewardsRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnLockedRewardsRecordService) Current(current int64) *ListSimpleEarnLockedRewardsRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnLockedRewardsRecordService) Size(size int64) *ListSimpleEarnLockedRewardsRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnLockedRewardsRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedRewardsRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/history/rewardsRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.positionId != nil {
+		r.SetParam("positionId", *s.positionId)
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedRewardsRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnFlexibleCollateralRecordService list records of flexible positions used as loan collateral
+type ListSimpleEarnFlexibleCollateralRecordService struct {
+	c         *Client
+	productId *string
+	startTime *int64
+	endTime   *int64
+	current   *int64
+	size      *int64
+}
+
+// ProductId set productId
+func (s *ListSimpleEarnFlexibleCollateralRecordService) ProductId(productId string) *ListSimpleEarnFlexibleCollateralRecordService {
+	s.productId = &productId
+	return s
+}
+
+// StartTime set startTime
+func (s *ListSimpleEarnFlexibleCollateralRecordService) StartTime(startTime int64) *ListSimpleEarnFlexibleCollateralRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListSimpleEarnFlexibleCollateralRecordService) EndTime(endTime int64) *ListSimpleEarnFlexibleCollateralRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current, start from 1
+func (s *ListSimpleEarnFlexibleCollateralRecordService) Current(current int64) *ListSimpleEarnFlexibleCollateralRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size, default 10, max 100
+func (s *ListSimpleEarnFlexibleCollateralRecordService) Size(size int64) *ListSimpleEarnFlexibleCollateralRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleCollateralRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleCollateralRecordList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/history/collateralRecord",
+		SecType:  secTypeSigned,
+	}
+	if s.productId != nil {
+		r.SetParam("productId", *s.productId)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleCollateralRecordList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleSubscriptionRecordList define a page of flexible subscription records
+type SimpleEarnFlexibleSubscriptionRecordList struct {
+	Rows  []*SimpleEarnFlexibleSubscriptionRecord `json:"rows"`
+	Total int64                                   `json:"total"`
+}
+
+// SimpleEarnFlexibleSubscriptionRecord define a flexible subscription record
+type SimpleEarnFlexibleSubscriptionRecord struct {
+	Amount         string `json:"amount"`
+	Asset          string `json:"asset"`
+	Time           int64  `json:"time"`
+	PurchaseId     int64  `json:"purchaseId"`
+	ProductId      string `json:"productId"`
+	Type           string `json:"type"`
+	SourceAccount  string `json:"sourceAccount"`
+	AmtFromSpot    string `json:"amtFromSpot"`
+	AmtFromFunding string `json:"amtFromFunding"`
+	Status         string `json:"status"`
+}
+
+// SimpleEarnLockedSubscriptionRecordList define a page of locked subscription records
+type SimpleEarnLockedSubscriptionRecordList struct {
+	Rows  []*SimpleEarnLockedSubscriptionRecord `json:"rows"`
+	Total int64                                 `json:"total"`
+}
+
+// SimpleEarnLockedSubscriptionRecord define a locked subscription record
+type SimpleEarnLockedSubscriptionRecord struct {
+	PositionId     string `json:"positionId"`
+	PurchaseId     string `json:"purchaseId"`
+	ProjectId      string `json:"projectId"`
+	Time           int64  `json:"time"`
+	Asset          string `json:"asset"`
+	Amount         string `json:"amount"`
+	LockPeriod     string `json:"lockPeriod"`
+	Type           string `json:"type"`
+	SourceAccount  string `json:"sourceAccount"`
+	AmtFromSpot    string `json:"amtFromSpot"`
+	AmtFromFunding string `json:"amtFromFunding"`
+	Status         string `json:"status"`
+}
+
+// SimpleEarnFlexibleRedemptionRecordList define a page of flexible redemption records
+type SimpleEarnFlexibleRedemptionRecordList struct {
+	Rows  []*SimpleEarnFlexibleRedemptionRecord `json:"rows"`
+	Total int64                                 `json:"total"`
+}
+
+// SimpleEarnFlexibleRedemptionRecord define a flexible redemption record
+type SimpleEarnFlexibleRedemptionRecord struct {
+	Amount      string `json:"amount"`
+	Asset       string `json:"asset"`
+	Time        int64  `json:"time"`
+	ProductId   string `json:"productId"`
+	RedeemId    int64  `json:"redeemId"`
+	DestAccount string `json:"destAccount"`
+	Status      string `json:"status"`
+}
+
+// SimpleEarnLockedRedemptionRecordList define a page of locked redemption records
+type SimpleEarnLockedRedemptionRecordList struct {
+	Rows  []*SimpleEarnLockedRedemptionRecord `json:"rows"`
+	Total int64                               `json:"total"`
+}
+
+// SimpleEarnLockedRedemptionRecord define a locked redemption record
+type SimpleEarnLockedRedemptionRecord struct {
+	PositionId  string `json:"positionId"`
+	RedeemId    string `json:"redeemId"`
+	Time        int64  `json:"time"`
+	Asset       string `json:"asset"`
+	LockPeriod  string `json:"lockPeriod"`
+	Amount      string `json:"amount"`
+	Type        string `json:"type"`
+	DeliverDate string `json:"deliverDate"`
+	Status      string `json:"status"`
+}
+
+// SimpleEarnFlexibleRewardsRecordList define a page of flexible rewards records
+type SimpleEarnFlexibleRewardsRecordList struct {
+	Rows  []*SimpleEarnFlexibleRewardsRecord `json:"rows"`
+	Total int64                              `json:"total"`
+}
+
+// SimpleEarnFlexibleRewardsRecord define a flexible rewards record
+type SimpleEarnFlexibleRewardsRecord struct {
+	Asset     string `json:"asset"`
+	Rewards   string `json:"rewards"`
+	ProjectId string `json:"projectId"`
+	Type      string `json:"type"`
+	Time      int64  `json:"time"`
+}
+
+// SimpleEarnLockedRewardsRecordList define a page of locked rewards records
+type SimpleEarnLockedRewardsRecordList struct {
+	Rows  []*SimpleEarnLockedRewardsRecord `json:"rows"`
+	Total int64                            `json:"total"`
+}
+
+// SimpleEarnLockedRewardsRecord define a locked rewards record
+type SimpleEarnLockedRewardsRecord struct {
+	PositionId string `json:"positionId"`
+	Time       int64  `json:"time"`
+	Asset      string `json:"asset"`
+	LockPeriod string `json:"lockPeriod"`
+	Amount     string `json:"amount"`
+}
+
+// SimpleEarnFlexibleCollateralRecordList define a page of flexible collateral records
+type SimpleEarnFlexibleCollateralRecordList struct {
+	Rows  []*SimpleEarnFlexibleCollateralRecord `json:"rows"`
+	Total int64                                 `json:"total"`
+}
+
+// SimpleEarnFlexibleCollateralRecord define a flexible collateral record
+type SimpleEarnFlexibleCollateralRecord struct {
+	Amount      string `json:"amount"`
+	ProductId   string `json:"productId"`
+	Asset       string `json:"asset"`
+	CreateTime  int64  `json:"createTime"`
+	Type        string `json:"type"`
+	ProductName string `json:"productName"`
+	OrderId     int64  `json:"orderId"`
+}
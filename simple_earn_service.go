@@ -0,0 +1,830 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ListSimpleEarnFlexibleProductsService list Simple Earn flexible products
+type ListSimpleEarnFlexibleProductsService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleProductsService) Asset(asset string) *ListSimpleEarnFlexibleProductsService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnFlexibleProductsService) Current(current int64) *ListSimpleEarnFlexibleProductsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *ListSimpleEarnFlexibleProductsService) Size(size int64) *ListSimpleEarnFlexibleProductsService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnFlexibleProductsService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleProductList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/list",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleProductList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleProductList define a page of flexible products
+type SimpleEarnFlexibleProductList struct {
+	Rows  []*SimpleEarnFlexibleProduct `json:"rows"`
+	Total int64                        `json:"total"`
+}
+
+// SimpleEarnFlexibleProduct define a Simple Earn flexible product
+type SimpleEarnFlexibleProduct struct {
+	Asset                      string             `json:"asset"`
+	LatestAnnualPercentageRate string             `json:"latestAnnualPercentageRate"`
+	TierAnnualPercentageRate   map[string]float64 `json:"tierAnnualPercentageRate"`
+	AirDropPercentageRate      string             `json:"airDropPercentageRate"`
+	CanPurchase                bool               `json:"canPurchase"`
+	CanRedeem                  bool               `json:"canRedeem"`
+	IsSoldOut                  bool               `json:"isSoldOut"`
+	Hot                        bool               `json:"hot"`
+	MinPurchaseAmount          string             `json:"minPurchaseAmount"`
+	ProductId                  string             `json:"productId"`
+	SubscriptionStartTime      int64              `json:"subscriptionStartTime"`
+	Status                     string             `json:"status"`
+}
+
+// SavingsFlexibleProduct convert the product to the deprecated savings
+// model, the fields without counterpart are left empty
+func (p *SimpleEarnFlexibleProduct) SavingsFlexibleProduct() *SavingsFlexibleProduct {
+	return &SavingsFlexibleProduct{
+		Asset:                 p.Asset,
+		AvgAnnualInterestRate: p.LatestAnnualPercentageRate,
+		CanPurchase:           p.CanPurchase,
+		CanRedeem:             p.CanRedeem,
+		Featured:              p.Hot,
+		MinPurchaseAmount:     p.MinPurchaseAmount,
+		ProductId:             p.ProductId,
+		Status:                p.Status,
+	}
+}
+
+// ListSimpleEarnLockedProductsService list Simple Earn locked products
+type ListSimpleEarnLockedProductsService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedProductsService) Asset(asset string) *ListSimpleEarnLockedProductsService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnLockedProductsService) Current(current int64) *ListSimpleEarnLockedProductsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *ListSimpleEarnLockedProductsService) Size(size int64) *ListSimpleEarnLockedProductsService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *ListSimpleEarnLockedProductsService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedProductList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/list",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedProductList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedProductList define a page of locked products
+type SimpleEarnLockedProductList struct {
+	Rows  []*SimpleEarnLockedProduct `json:"rows"`
+	Total int64                      `json:"total"`
+}
+
+// SimpleEarnLockedProduct define a Simple Earn locked product
+type SimpleEarnLockedProduct struct {
+	ProjectId string `json:"projectId"`
+	Detail    struct {
+		Asset                 string `json:"asset"`
+		RewardAsset           string `json:"rewardAsset"`
+		Duration              int64  `json:"duration"`
+		Renewable             bool   `json:"renewable"`
+		IsSoldOut             bool   `json:"isSoldOut"`
+		APR                   string `json:"apr"`
+		Status                string `json:"status"`
+		SubscriptionStartTime int64  `json:"subscriptionStartTime,string"`
+		ExtraRewardAsset      string `json:"extraRewardAsset"`
+		ExtraRewardAPR        string `json:"extraRewardAPR"`
+	} `json:"detail"`
+	Quota struct {
+		TotalPersonalQuota string `json:"totalPersonalQuota"`
+		Minimum            string `json:"minimum"`
+	} `json:"quota"`
+}
+
+// SubscribeSimpleEarnFlexibleProductService subscribe a flexible product
+type SubscribeSimpleEarnFlexibleProductService struct {
+	c             *Client
+	productId     string
+	amount        string
+	autoSubscribe *bool
+	sourceAccount *SimpleEarnAccountType
+}
+
+// ProductId set productId
+func (s *SubscribeSimpleEarnFlexibleProductService) ProductId(productId string) *SubscribeSimpleEarnFlexibleProductService {
+	s.productId = productId
+	return s
+}
+
+// Amount set amount
+func (s *SubscribeSimpleEarnFlexibleProductService) Amount(amount string) *SubscribeSimpleEarnFlexibleProductService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set autoSubscribe, default true
+func (s *SubscribeSimpleEarnFlexibleProductService) AutoSubscribe(autoSubscribe bool) *SubscribeSimpleEarnFlexibleProductService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set sourceAccount, default SPOT
+func (s *SubscribeSimpleEarnFlexibleProductService) SourceAccount(sourceAccount SimpleEarnAccountType) *SubscribeSimpleEarnFlexibleProductService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// Do send request
+func (s *SubscribeSimpleEarnFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/flexible/subscribe",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("productId", s.productId)
+	r.SetParam("amount", s.amount)
+	if s.autoSubscribe != nil {
+		r.SetParam("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.SetParam("sourceAccount", *s.sourceAccount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSubscribeResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubscribeSimpleEarnLockedProductService subscribe a locked product
+type SubscribeSimpleEarnLockedProductService struct {
+	c             *Client
+	projectId     string
+	amount        string
+	autoSubscribe *bool
+	sourceAccount *SimpleEarnAccountType
+}
+
+// ProjectId set projectId
+func (s *SubscribeSimpleEarnLockedProductService) ProjectId(projectId string) *SubscribeSimpleEarnLockedProductService {
+	s.projectId = projectId
+	return s
+}
+
+// Amount set amount
+func (s *SubscribeSimpleEarnLockedProductService) Amount(amount string) *SubscribeSimpleEarnLockedProductService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set autoSubscribe, default true
+func (s *SubscribeSimpleEarnLockedProductService) AutoSubscribe(autoSubscribe bool) *SubscribeSimpleEarnLockedProductService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set sourceAccount, default SPOT
+func (s *SubscribeSimpleEarnLockedProductService) SourceAccount(sourceAccount SimpleEarnAccountType) *SubscribeSimpleEarnLockedProductService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// Do send request
+func (s *SubscribeSimpleEarnLockedProductService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/locked/subscribe",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("projectId", s.projectId)
+	r.SetParam("amount", s.amount)
+	if s.autoSubscribe != nil {
+		r.SetParam("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.SetParam("sourceAccount", *s.sourceAccount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSubscribeResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnSubscribeResponse define subscribe response, PositionId is only
+// set for locked products
+type SimpleEarnSubscribeResponse struct {
+	PurchaseId int64 `json:"purchaseId"`
+	PositionId int64 `json:"positionId"`
+	Success    bool  `json:"success"`
+}
+
+// RedeemSimpleEarnFlexibleProductService redeem a flexible product
+type RedeemSimpleEarnFlexibleProductService struct {
+	c           *Client
+	productId   string
+	redeemAll   *bool
+	amount      *string
+	destAccount *SimpleEarnAccountType
+}
+
+// ProductId set productId
+func (s *RedeemSimpleEarnFlexibleProductService) ProductId(productId string) *RedeemSimpleEarnFlexibleProductService {
+	s.productId = productId
+	return s
+}
+
+// RedeemAll set redeemAll, amount is ignored when true
+func (s *RedeemSimpleEarnFlexibleProductService) RedeemAll(redeemAll bool) *RedeemSimpleEarnFlexibleProductService {
+	s.redeemAll = &redeemAll
+	return s
+}
+
+// Amount set amount
+func (s *RedeemSimpleEarnFlexibleProductService) Amount(amount string) *RedeemSimpleEarnFlexibleProductService {
+	s.amount = &amount
+	return s
+}
+
+// DestAccount set destAccount, default SPOT
+func (s *RedeemSimpleEarnFlexibleProductService) DestAccount(destAccount SimpleEarnAccountType) *RedeemSimpleEarnFlexibleProductService {
+	s.destAccount = &destAccount
+	return s
+}
+
+// Do send request
+func (s *RedeemSimpleEarnFlexibleProductService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/flexible/redeem",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("productId", s.productId)
+	if s.redeemAll != nil {
+		r.SetParam("redeemAll", *s.redeemAll)
+	}
+	if s.amount != nil {
+		r.SetParam("amount", *s.amount)
+	}
+	if s.destAccount != nil {
+		r.SetParam("destAccount", *s.destAccount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RedeemSimpleEarnLockedProductService redeem a locked position early
+type RedeemSimpleEarnLockedProductService struct {
+	c          *Client
+	positionId int64
+}
+
+// PositionId set positionId
+func (s *RedeemSimpleEarnLockedProductService) PositionId(positionId int64) *RedeemSimpleEarnLockedProductService {
+	s.positionId = positionId
+	return s
+}
+
+// Do send request
+func (s *RedeemSimpleEarnLockedProductService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/locked/redeem",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("positionId", s.positionId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnRedeemResponse define redeem response
+type SimpleEarnRedeemResponse struct {
+	RedeemId int64 `json:"redeemId"`
+	Success  bool  `json:"success"`
+}
+
+// GetSimpleEarnFlexiblePositionService get Simple Earn flexible positions
+type GetSimpleEarnFlexiblePositionService struct {
+	c         *Client
+	asset     *string
+	productId *string
+	current   *int64
+	size      *int64
+}
+
+// Asset set asset
+func (s *GetSimpleEarnFlexiblePositionService) Asset(asset string) *GetSimpleEarnFlexiblePositionService {
+	s.asset = &asset
+	return s
+}
+
+// ProductId set productId
+func (s *GetSimpleEarnFlexiblePositionService) ProductId(productId string) *GetSimpleEarnFlexiblePositionService {
+	s.productId = &productId
+	return s
+}
+
+// Current set current page, start from 1
+func (s *GetSimpleEarnFlexiblePositionService) Current(current int64) *GetSimpleEarnFlexiblePositionService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *GetSimpleEarnFlexiblePositionService) Size(size int64) *GetSimpleEarnFlexiblePositionService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *GetSimpleEarnFlexiblePositionService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexiblePositionList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/position",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.productId != nil {
+		r.SetParam("productId", *s.productId)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexiblePositionList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexiblePositionList define a page of flexible positions
+type SimpleEarnFlexiblePositionList struct {
+	Rows  []*SimpleEarnFlexiblePosition `json:"rows"`
+	Total int64                         `json:"total"`
+}
+
+// SimpleEarnFlexiblePosition define a Simple Earn flexible position
+type SimpleEarnFlexiblePosition struct {
+	TotalAmount                    string             `json:"totalAmount"`
+	TierAnnualPercentageRate       map[string]float64 `json:"tierAnnualPercentageRate"`
+	LatestAnnualPercentageRate     string             `json:"latestAnnualPercentageRate"`
+	YesterdayAirdropPercentageRate string             `json:"yesterdayAirdropPercentageRate"`
+	Asset                          string             `json:"asset"`
+	AirDropAsset                   string             `json:"airDropAsset"`
+	CanRedeem                      bool               `json:"canRedeem"`
+	CollateralAmount               string             `json:"collateralAmount"`
+	ProductId                      string             `json:"productId"`
+	YesterdayRealTimeRewards       string             `json:"yesterdayRealTimeRewards"`
+	CumulativeBonusRewards         string             `json:"cumulativeBonusRewards"`
+	CumulativeRealTimeRewards      string             `json:"cumulativeRealTimeRewards"`
+	CumulativeTotalRewards         string             `json:"cumulativeTotalRewards"`
+	AutoSubscribe                  bool               `json:"autoSubscribe"`
+}
+
+// SavingFlexibleProductPosition convert the position to the deprecated
+// savings model, the collateral is frozen and the fields without
+// counterpart are left empty
+func (p *SimpleEarnFlexiblePosition) SavingFlexibleProductPosition() *SavingFlexibleProductPosition {
+	return &SavingFlexibleProductPosition{
+		Asset:                 p.Asset,
+		ProductId:             p.ProductId,
+		AvgAnnualInterestRate: p.LatestAnnualPercentageRate,
+		AnnualInterestRate:    p.LatestAnnualPercentageRate,
+		TotalInterest:         p.CumulativeTotalRewards,
+		TotalAmount:           p.TotalAmount,
+		FreeAmount:            subAmount(p.TotalAmount, p.CollateralAmount),
+		FreezeAmount:          p.CollateralAmount,
+		CanRedeem:             p.CanRedeem,
+	}
+}
+
+// subAmount subtract two decimal amounts, keeping the 8 decimals of the
+// exchange without float noise
+func subAmount(a, b string) string {
+	v := strconv.FormatFloat(parseFloat(a)-parseFloat(b), 'f', 8, 64)
+	v = strings.TrimRight(strings.TrimRight(v, "0"), ".")
+	if v == "-0" {
+		return "0"
+	}
+	return v
+}
+
+// GetSimpleEarnLockedPositionService get Simple Earn locked positions
+type GetSimpleEarnLockedPositionService struct {
+	c          *Client
+	asset      *string
+	positionId *int64
+	projectId  *string
+	current    *int64
+	size       *int64
+}
+
+// Asset set asset
+func (s *GetSimpleEarnLockedPositionService) Asset(asset string) *GetSimpleEarnLockedPositionService {
+	s.asset = &asset
+	return s
+}
+
+// PositionId set positionId
+func (s *GetSimpleEarnLockedPositionService) PositionId(positionId int64) *GetSimpleEarnLockedPositionService {
+	s.positionId = &positionId
+	return s
+}
+
+// ProjectId set projectId
+func (s *GetSimpleEarnLockedPositionService) ProjectId(projectId string) *GetSimpleEarnLockedPositionService {
+	s.projectId = &projectId
+	return s
+}
+
+// Current set current page, start from 1
+func (s *GetSimpleEarnLockedPositionService) Current(current int64) *GetSimpleEarnLockedPositionService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default 10, max 100
+func (s *GetSimpleEarnLockedPositionService) Size(size int64) *GetSimpleEarnLockedPositionService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *GetSimpleEarnLockedPositionService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedPositionList, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/position",
+		SecType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.SetParam("asset", *s.asset)
+	}
+	if s.positionId != nil {
+		r.SetParam("positionId", *s.positionId)
+	}
+	if s.projectId != nil {
+		r.SetParam("projectId", *s.projectId)
+	}
+	if s.current != nil {
+		r.SetParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedPositionList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedPositionList define a page of locked positions
+type SimpleEarnLockedPositionList struct {
+	Rows  []*SimpleEarnLockedPosition `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+// SimpleEarnLockedPosition define a Simple Earn locked position
+type SimpleEarnLockedPosition struct {
+	PositionId            int64  `json:"positionId"`
+	ParentPositionId      int64  `json:"parentPositionId"`
+	ProjectId             string `json:"projectId"`
+	Asset                 string `json:"asset"`
+	Amount                string `json:"amount"`
+	PurchaseTime          string `json:"purchaseTime"`
+	Duration              string `json:"duration"`
+	AccrualDays           string `json:"accrualDays"`
+	RewardAsset           string `json:"rewardAsset"`
+	APY                   string `json:"APY"`
+	RewardAmount          string `json:"rewardAmt"`
+	ExtraRewardAsset      string `json:"extraRewardAsset"`
+	ExtraRewardAPR        string `json:"extraRewardAPR"`
+	EstExtraRewardAmount  string `json:"estExtraRewardAmt"`
+	NextPay               string `json:"nextPay"`
+	NextPayDate           string `json:"nextPayDate"`
+	PayPeriod             string `json:"payPeriod"`
+	RedeemAmountEarly     string `json:"redeemAmountEarly"`
+	RewardsEndDate        string `json:"rewardsEndDate"`
+	DeliverDate           string `json:"deliverDate"`
+	RedeemPeriod          string `json:"redeemPeriod"`
+	RedeemingAmount       string `json:"redeemingAmt"`
+	RedeemTo              string `json:"redeemTo"`
+	PartialAmtDeliverDate string `json:"partialAmtDeliverDate"`
+	CanRedeemEarly        bool   `json:"canRedeemEarly"`
+	CanFastRedemption     bool   `json:"canFastRedemption"`
+	AutoSubscribe         bool   `json:"autoSubscribe"`
+	Type                  string `json:"type"`
+	Status                string `json:"status"`
+	CanReStake            bool   `json:"canReStake"`
+}
+
+// GetSimpleEarnAccountService get the Simple Earn account summary
+type GetSimpleEarnAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetSimpleEarnAccountService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnAccount, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/account",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnAccount define the Simple Earn account summary
+type SimpleEarnAccount struct {
+	TotalAmountInBTC          string `json:"totalAmountInBTC"`
+	TotalAmountInUSDT         string `json:"totalAmountInUSDT"`
+	TotalFlexibleAmountInBTC  string `json:"totalFlexibleAmountInBTC"`
+	TotalFlexibleAmountInUSDT string `json:"totalFlexibleAmountInUSDT"`
+	TotalLockedInBTC          string `json:"totalLockedInBTC"`
+	TotalLockedInUSDT         string `json:"totalLockedInUSDT"`
+}
+
+// GetSimpleEarnFlexiblePersonalLeftQuotaService get the amount left to
+// subscribe to a flexible product
+type GetSimpleEarnFlexiblePersonalLeftQuotaService struct {
+	c         *Client
+	productId string
+}
+
+// ProductId set productId
+func (s *GetSimpleEarnFlexiblePersonalLeftQuotaService) ProductId(productId string) *GetSimpleEarnFlexiblePersonalLeftQuotaService {
+	s.productId = productId
+	return s
+}
+
+// Do send request
+func (s *GetSimpleEarnFlexiblePersonalLeftQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnPersonalLeftQuota, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/flexible/personalLeftQuota",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("productId", s.productId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnPersonalLeftQuota)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSimpleEarnLockedPersonalLeftQuotaService get the amount left to
+// subscribe to a locked product
+type GetSimpleEarnLockedPersonalLeftQuotaService struct {
+	c         *Client
+	projectId string
+}
+
+// ProjectId set projectId
+func (s *GetSimpleEarnLockedPersonalLeftQuotaService) ProjectId(projectId string) *GetSimpleEarnLockedPersonalLeftQuotaService {
+	s.projectId = projectId
+	return s
+}
+
+// Do send request
+func (s *GetSimpleEarnLockedPersonalLeftQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnPersonalLeftQuota, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/simple-earn/locked/personalLeftQuota",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("projectId", s.projectId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnPersonalLeftQuota)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnPersonalLeftQuota define personal left quota
+type SimpleEarnPersonalLeftQuota struct {
+	LeftPersonalQuota string `json:"leftPersonalQuota"`
+}
+
+// SetSimpleEarnFlexibleAutoSubscribeService toggle the auto subscribe of a
+// flexible product
+type SetSimpleEarnFlexibleAutoSubscribeService struct {
+	c             *Client
+	productId     string
+	autoSubscribe bool
+}
+
+// ProductId set productId
+func (s *SetSimpleEarnFlexibleAutoSubscribeService) ProductId(productId string) *SetSimpleEarnFlexibleAutoSubscribeService {
+	s.productId = productId
+	return s
+}
+
+// AutoSubscribe set autoSubscribe
+func (s *SetSimpleEarnFlexibleAutoSubscribeService) AutoSubscribe(autoSubscribe bool) *SetSimpleEarnFlexibleAutoSubscribeService {
+	s.autoSubscribe = autoSubscribe
+	return s
+}
+
+// Do send request
+func (s *SetSimpleEarnFlexibleAutoSubscribeService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSuccessResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/flexible/setAutoSubscribe",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("productId", s.productId)
+	r.SetParam("autoSubscribe", s.autoSubscribe)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSuccessResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetSimpleEarnLockedAutoSubscribeService toggle the auto subscribe of a
+// locked position
+type SetSimpleEarnLockedAutoSubscribeService struct {
+	c             *Client
+	positionId    int64
+	autoSubscribe bool
+}
+
+// PositionId set positionId
+func (s *SetSimpleEarnLockedAutoSubscribeService) PositionId(positionId int64) *SetSimpleEarnLockedAutoSubscribeService {
+	s.positionId = positionId
+	return s
+}
+
+// AutoSubscribe set autoSubscribe
+func (s *SetSimpleEarnLockedAutoSubscribeService) AutoSubscribe(autoSubscribe bool) *SetSimpleEarnLockedAutoSubscribeService {
+	s.autoSubscribe = autoSubscribe
+	return s
+}
+
+// Do send request
+func (s *SetSimpleEarnLockedAutoSubscribeService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSuccessResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/simple-earn/locked/setAutoSubscribe",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("positionId", s.positionId)
+	r.SetParam("autoSubscribe", s.autoSubscribe)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSuccessResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnSuccessResponse define a response only carrying a success flag
+type SimpleEarnSuccessResponse struct {
+	Success bool `json:"success"`
+}
@@ -0,0 +1,124 @@
+package binance
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	subAccountListMaxLimit              = 200
+	subAccountBalanceDefaultConcurrency = 5
+)
+
+// SubAccountConsolidatedBalanceService fetch the spot assets of the
+// sub-accounts concurrently and sum them by asset (For Master Account)
+type SubAccountConsolidatedBalanceService struct {
+	c           *Client
+	emails      []string
+	concurrency int
+}
+
+// Emails set the sub-accounts to fetch, every unfrozen sub-account is listed
+// when not set
+func (s *SubAccountConsolidatedBalanceService) Emails(emails ...string) *SubAccountConsolidatedBalanceService {
+	s.emails = emails
+	return s
+}
+
+// Concurrency set the number of sub-accounts fetched at once, default 5
+func (s *SubAccountConsolidatedBalanceService) Concurrency(concurrency int) *SubAccountConsolidatedBalanceService {
+	s.concurrency = concurrency
+	return s
+}
+
+// Do send the requests. An error is only returned when the sub-accounts
+// cannot be listed or ctx is done, the sub-accounts whose assets failed are
+// reported in Errors and left out of Total.
+func (s *SubAccountConsolidatedBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountConsolidatedBalance, err error) {
+	emails := s.emails
+	if len(emails) == 0 {
+		emails, err = s.listEmails(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	concurrency := s.concurrency
+	if concurrency <= 0 {
+		concurrency = subAccountBalanceDefaultConcurrency
+	}
+
+	res = &SubAccountConsolidatedBalance{
+		Total:       make(map[string]*AssetBalance),
+		SubAccounts: make(map[string][]AssetBalance, len(emails)),
+		Errors:      make(map[string]error),
+	}
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for _, email := range emails {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+		wg.Add(1)
+		go func(email string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			assets, err := s.c.NewSubaccountAssetsService().Email(email).Do(ctx, opts...)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				res.Errors[email] = err
+				return
+			}
+			res.add(email, assets.Balances)
+		}(email)
+	}
+	wg.Wait()
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *SubAccountConsolidatedBalanceService) listEmails(ctx context.Context, opts ...RequestOption) ([]string, error) {
+	var emails []string
+	for page := 1; ; page++ {
+		list, err := s.c.NewSubAccountListService().Page(page).Limit(subAccountListMaxLimit).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range list.SubAccounts {
+			emails = append(emails, sub.Email)
+		}
+		if len(list.SubAccounts) < subAccountListMaxLimit {
+			return emails, nil
+		}
+	}
+}
+
+// SubAccountConsolidatedBalance define the spot assets of the sub-accounts
+type SubAccountConsolidatedBalance struct {
+	Total       map[string]*AssetBalance  // sum of the sub-accounts by asset
+	SubAccounts map[string][]AssetBalance // balances by sub-account email
+	Errors      map[string]error          // errors by sub-account email
+}
+
+func (b *SubAccountConsolidatedBalance) add(email string, balances []AssetBalance) {
+	b.SubAccounts[email] = balances
+	for _, balance := range balances {
+		total, ok := b.Total[balance.Asset]
+		if !ok {
+			total = &AssetBalance{Asset: balance.Asset}
+			b.Total[balance.Asset] = total
+		}
+		total.Free += balance.Free
+		total.Locked += balance.Locked
+	}
+}
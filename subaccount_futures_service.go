@@ -0,0 +1,303 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// SubAccountFuturesInternalTransferService transfer between the futures
+// accounts of two sub-accounts, or the master account (For Master Account)
+type SubAccountFuturesInternalTransferService struct {
+	c           *Client
+	fromEmail   string
+	toEmail     string
+	futuresType SubAccountFuturesType
+	asset       string
+	amount      string
+}
+
+// FromEmail set fromEmail
+func (s *SubAccountFuturesInternalTransferService) FromEmail(fromEmail string) *SubAccountFuturesInternalTransferService {
+	s.fromEmail = fromEmail
+	return s
+}
+
+// ToEmail set toEmail
+func (s *SubAccountFuturesInternalTransferService) ToEmail(toEmail string) *SubAccountFuturesInternalTransferService {
+	s.toEmail = toEmail
+	return s
+}
+
+// FuturesType set futuresType
+func (s *SubAccountFuturesInternalTransferService) FuturesType(futuresType SubAccountFuturesType) *SubAccountFuturesInternalTransferService {
+	s.futuresType = futuresType
+	return s
+}
+
+// Asset set asset
+func (s *SubAccountFuturesInternalTransferService) Asset(asset string) *SubAccountFuturesInternalTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *SubAccountFuturesInternalTransferService) Amount(amount string) *SubAccountFuturesInternalTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *SubAccountFuturesInternalTransferService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountTransferResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/sub-account/futures/internalTransfer",
+		SecType:  secTypeSigned,
+	}
+	r.SetParams(params{
+		"fromEmail":   s.fromEmail,
+		"toEmail":     s.toEmail,
+		"futuresType": s.futuresType,
+		"asset":       s.asset,
+		"amount":      s.amount,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountTransferResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesTransferService transfer between the spot and futures
+// accounts of a sub-account (For Master Account)
+type SubAccountFuturesTransferService struct {
+	c            *Client
+	email        string
+	asset        string
+	amount       string
+	transferType SubAccountFuturesTransferType
+}
+
+// Email set email
+func (s *SubAccountFuturesTransferService) Email(email string) *SubAccountFuturesTransferService {
+	s.email = email
+	return s
+}
+
+// Asset set asset
+func (s *SubAccountFuturesTransferService) Asset(asset string) *SubAccountFuturesTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *SubAccountFuturesTransferService) Amount(amount string) *SubAccountFuturesTransferService {
+	s.amount = amount
+	return s
+}
+
+// Type set transfer type
+func (s *SubAccountFuturesTransferService) Type(transferType SubAccountFuturesTransferType) *SubAccountFuturesTransferService {
+	s.transferType = transferType
+	return s
+}
+
+// Do send request
+func (s *SubAccountFuturesTransferService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountTransferResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/sub-account/futures/transfer",
+		SecType:  secTypeSigned,
+	}
+	r.SetParams(params{
+		"email":  s.email,
+		"asset":  s.asset,
+		"amount": s.amount,
+		"type":   s.transferType,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountTransferResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountTransferResponse define sub-account futures transfer response
+type SubAccountTransferResponse struct {
+	Success bool   `json:"success"`
+	TxnID   string `json:"txnId"`
+}
+
+// SubAccountFuturesAccountSummaryService get the futures account summary of
+// every sub-account (For Master Account)
+type SubAccountFuturesAccountSummaryService struct {
+	c           *Client
+	futuresType SubAccountFuturesType
+	page        *int
+	limit       *int
+}
+
+// FuturesType set futuresType
+func (s *SubAccountFuturesAccountSummaryService) FuturesType(futuresType SubAccountFuturesType) *SubAccountFuturesAccountSummaryService {
+	s.futuresType = futuresType
+	return s
+}
+
+// Page set page, default 1
+func (s *SubAccountFuturesAccountSummaryService) Page(page int) *SubAccountFuturesAccountSummaryService {
+	s.page = &page
+	return s
+}
+
+// Limit set limit, default 10, max 20
+func (s *SubAccountFuturesAccountSummaryService) Limit(limit int) *SubAccountFuturesAccountSummaryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *SubAccountFuturesAccountSummaryService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountFuturesAccountSummary, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v2/sub-account/futures/accountSummary",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("futuresType", s.futuresType)
+	if s.page != nil {
+		r.SetParam("page", *s.page)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountFuturesAccountSummary)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesAccountSummary define futures account summary, only the
+// field matching the requested futures type is set
+type SubAccountFuturesAccountSummary struct {
+	FutureAccountSummaryResp   *SubAccountUSDTFuturesSummary `json:"futureAccountSummaryResp"`
+	DeliveryAccountSummaryResp *SubAccountCoinFuturesSummary `json:"deliveryAccountSummaryResp"`
+}
+
+// SubAccountUSDTFuturesSummary define USDT-M futures summary of the sub-accounts
+type SubAccountUSDTFuturesSummary struct {
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+	SubAccountList              []struct {
+		Email                       string `json:"email"`
+		TotalInitialMargin          string `json:"totalInitialMargin"`
+		TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+		TotalMarginBalance          string `json:"totalMarginBalance"`
+		TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+		TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+		TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+		TotalWalletBalance          string `json:"totalWalletBalance"`
+		Asset                       string `json:"asset"`
+	} `json:"subAccountList"`
+}
+
+// SubAccountCoinFuturesSummary define COIN-M futures summary of the
+// sub-accounts, valued in BTC and USD
+type SubAccountCoinFuturesSummary struct {
+	TotalMarginBalanceOfBTC    string `json:"totalMarginBalanceOfBTC"`
+	TotalUnrealizedProfitOfBTC string `json:"totalUnrealizedProfitOfBTC"`
+	TotalWalletBalanceOfBTC    string `json:"totalWalletBalanceOfBTC"`
+	Asset                      string `json:"asset"`
+	SubAccountList             []struct {
+		Email                 string `json:"email"`
+		TotalMarginBalance    string `json:"totalMarginBalance"`
+		TotalUnrealizedProfit string `json:"totalUnrealizedProfit"`
+		TotalWalletBalance    string `json:"totalWalletBalance"`
+		Asset                 string `json:"asset"`
+	} `json:"subAccountList"`
+}
+
+// SubAccountFuturesPositionRiskService get the futures positions of a
+// sub-account (For Master Account)
+type SubAccountFuturesPositionRiskService struct {
+	c           *Client
+	email       string
+	futuresType SubAccountFuturesType
+}
+
+// Email set email
+func (s *SubAccountFuturesPositionRiskService) Email(email string) *SubAccountFuturesPositionRiskService {
+	s.email = email
+	return s
+}
+
+// FuturesType set futuresType
+func (s *SubAccountFuturesPositionRiskService) FuturesType(futuresType SubAccountFuturesType) *SubAccountFuturesPositionRiskService {
+	s.futuresType = futuresType
+	return s
+}
+
+// Do send request
+func (s *SubAccountFuturesPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*SubAccountFuturesPositionRisk, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v2/sub-account/futures/positionRisk",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	r.SetParam("futuresType", s.futuresType)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SubAccountFuturesPositionRisk{}, err
+	}
+	resp := struct {
+		FuturePositionRiskVos   []*SubAccountFuturesPositionRisk `json:"futurePositionRiskVos"`
+		DeliveryPositionRiskVos []*SubAccountFuturesPositionRisk `json:"deliveryPositionRiskVos"`
+	}{}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return []*SubAccountFuturesPositionRisk{}, err
+	}
+	if s.futuresType == SubAccountFuturesTypeCoin {
+		return resp.DeliveryPositionRiskVos, nil
+	}
+	return resp.FuturePositionRiskVos, nil
+}
+
+// SubAccountFuturesPositionRisk define a futures position of a sub-account,
+// MaxNotional and LiquidationPrice are USDT-M only, the isolated margin
+// fields and PositionSide COIN-M only
+type SubAccountFuturesPositionRisk struct {
+	Symbol           string `json:"symbol"`
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	Leverage         string `json:"leverage"`
+	PositionAmount   string `json:"positionAmount"`
+	UnrealizedProfit string `json:"unrealizedProfit"`
+	MaxNotional      string `json:"maxNotional"`
+	LiquidationPrice string `json:"liquidationPrice"`
+	Isolated         bool   `json:"isolated"`
+	IsolatedWallet   string `json:"isolatedWallet"`
+	IsolatedMargin   string `json:"isolatedMargin"`
+	IsAutoAddMargin  bool   `json:"isAutoAddMargin"`
+	PositionSide     string `json:"positionSide"`
+}
@@ -0,0 +1,278 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateVirtualSubAccountService create a virtual sub-account (For Master Account)
+type CreateVirtualSubAccountService struct {
+	c                *Client
+	subAccountString string
+}
+
+// SubAccountString set subAccountString, the prefix of the generated email
+func (s *CreateVirtualSubAccountService) SubAccountString(subAccountString string) *CreateVirtualSubAccountService {
+	s.subAccountString = subAccountString
+	return s
+}
+
+// Do send request
+func (s *CreateVirtualSubAccountService) Do(ctx context.Context, opts ...RequestOption) (res *CreateVirtualSubAccountResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/sub-account/virtualSubAccount",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("subAccountString", s.subAccountString)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateVirtualSubAccountResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateVirtualSubAccountResponse define create virtual sub-account response
+type CreateVirtualSubAccountResponse struct {
+	Email string `json:"email"`
+}
+
+// EnableSubAccountFuturesService enable futures for a sub-account (For Master Account)
+type EnableSubAccountFuturesService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *EnableSubAccountFuturesService) Email(email string) *EnableSubAccountFuturesService {
+	s.email = email
+	return s
+}
+
+// Do send request
+func (s *EnableSubAccountFuturesService) Do(ctx context.Context, opts ...RequestOption) (res *EnableSubAccountFuturesResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/sub-account/futures/enable",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(EnableSubAccountFuturesResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// EnableSubAccountFuturesResponse define enable futures response
+type EnableSubAccountFuturesResponse struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+// EnableSubAccountMarginService enable margin for a sub-account (For Master Account)
+type EnableSubAccountMarginService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *EnableSubAccountMarginService) Email(email string) *EnableSubAccountMarginService {
+	s.email = email
+	return s
+}
+
+// Do send request
+func (s *EnableSubAccountMarginService) Do(ctx context.Context, opts ...RequestOption) (res *EnableSubAccountMarginResponse, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/sub-account/margin/enable",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(EnableSubAccountMarginResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// EnableSubAccountMarginResponse define enable margin response
+type EnableSubAccountMarginResponse struct {
+	Email           string `json:"email"`
+	IsMarginEnabled bool   `json:"isMarginEnabled"`
+}
+
+// GetSubAccountApiIpRestrictionService get the IP restriction of a
+// sub-account API key (For Master Account)
+type GetSubAccountApiIpRestrictionService struct {
+	c                *Client
+	email            string
+	subAccountApiKey string
+}
+
+// Email set email
+func (s *GetSubAccountApiIpRestrictionService) Email(email string) *GetSubAccountApiIpRestrictionService {
+	s.email = email
+	return s
+}
+
+// SubAccountApiKey set subAccountApiKey
+func (s *GetSubAccountApiIpRestrictionService) SubAccountApiKey(subAccountApiKey string) *GetSubAccountApiIpRestrictionService {
+	s.subAccountApiKey = subAccountApiKey
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountApiIpRestrictionService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiIpRestriction, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/sub-account/subAccountApi/ipRestriction",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	r.SetParam("subAccountApiKey", s.subAccountApiKey)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountApiIpRestriction)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddSubAccountApiIpRestrictionService turn on or off the IP restriction
+// of a sub-account API key and add IPs to its list (For Master Account)
+type AddSubAccountApiIpRestrictionService struct {
+	c                *Client
+	email            string
+	subAccountApiKey string
+	status           SubAccountApiIpRestrictionStatus
+	ipAddress        *string
+}
+
+// Email set email
+func (s *AddSubAccountApiIpRestrictionService) Email(email string) *AddSubAccountApiIpRestrictionService {
+	s.email = email
+	return s
+}
+
+// SubAccountApiKey set subAccountApiKey
+func (s *AddSubAccountApiIpRestrictionService) SubAccountApiKey(subAccountApiKey string) *AddSubAccountApiIpRestrictionService {
+	s.subAccountApiKey = subAccountApiKey
+	return s
+}
+
+// Status set status
+func (s *AddSubAccountApiIpRestrictionService) Status(status SubAccountApiIpRestrictionStatus) *AddSubAccountApiIpRestrictionService {
+	s.status = status
+	return s
+}
+
+// IpAddress set ipAddress, comma separated list of IPs
+func (s *AddSubAccountApiIpRestrictionService) IpAddress(ipAddress string) *AddSubAccountApiIpRestrictionService {
+	s.ipAddress = &ipAddress
+	return s
+}
+
+// Do send request
+func (s *AddSubAccountApiIpRestrictionService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiIpRestriction, err error) {
+	r := &request{
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v2/sub-account/subAccountApi/ipRestriction",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	r.SetParam("subAccountApiKey", s.subAccountApiKey)
+	r.SetParam("status", s.status)
+	if s.ipAddress != nil {
+		r.SetParam("ipAddress", *s.ipAddress)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountApiIpRestriction)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteSubAccountApiIpRestrictionService remove IPs from the IP list of a
+// sub-account API key (For Master Account)
+type DeleteSubAccountApiIpRestrictionService struct {
+	c                *Client
+	email            string
+	subAccountApiKey string
+	ipAddress        string
+}
+
+// Email set email
+func (s *DeleteSubAccountApiIpRestrictionService) Email(email string) *DeleteSubAccountApiIpRestrictionService {
+	s.email = email
+	return s
+}
+
+// SubAccountApiKey set subAccountApiKey
+func (s *DeleteSubAccountApiIpRestrictionService) SubAccountApiKey(subAccountApiKey string) *DeleteSubAccountApiIpRestrictionService {
+	s.subAccountApiKey = subAccountApiKey
+	return s
+}
+
+// IpAddress set ipAddress, comma separated list of IPs
+func (s *DeleteSubAccountApiIpRestrictionService) IpAddress(ipAddress string) *DeleteSubAccountApiIpRestrictionService {
+	s.ipAddress = ipAddress
+	return s
+}
+
+// Do send request
+func (s *DeleteSubAccountApiIpRestrictionService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiIpRestriction, err error) {
+	r := &request{
+		Method:   http.MethodDelete,
+		Endpoint: "/sapi/v1/sub-account/subAccountApi/ipRestriction/ipList",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	r.SetParam("subAccountApiKey", s.subAccountApiKey)
+	r.SetParam("ipAddress", s.ipAddress)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountApiIpRestriction)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountApiIpRestriction define the IP restriction of a sub-account API
+// key, the query endpoint reports IpRestrict and the update ones Status
+type SubAccountApiIpRestriction struct {
+	IpRestrict string   `json:"ipRestrict"`
+	Status     string   `json:"status"`
+	IpList     []string `json:"ipList"`
+	UpdateTime int64    `json:"updateTime"`
+	ApiKey     string   `json:"apiKey"`
+}
@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// SubAccountMarginAccountSummaryService get the margin account summary of
+// every sub-account (For Master Account)
+type SubAccountMarginAccountSummaryService struct {
+	c *Client
+}
+
+// Do send request
+func (s *SubAccountMarginAccountSummaryService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountMarginAccountSummary, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/sub-account/margin/accountSummary",
+		SecType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountMarginAccountSummary)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountMarginAccountSummary define margin account summary of the sub-accounts
+type SubAccountMarginAccountSummary struct {
+	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+	SubAccountList      []struct {
+		Email               string `json:"email"`
+		TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+		TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+		TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+	} `json:"subAccountList"`
+}
+
+// SubAccountMarginAccountService get the margin account detail of a
+// sub-account (For Master Account)
+type SubAccountMarginAccountService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *SubAccountMarginAccountService) Email(email string) *SubAccountMarginAccountService {
+	s.email = email
+	return s
+}
+
+// Do send request
+func (s *SubAccountMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountMarginAccount, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/sub-account/margin/account",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountMarginAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountMarginAccount define margin account detail of a sub-account
+type SubAccountMarginAccount struct {
+	Email               string `json:"email"`
+	MarginLevel         string `json:"marginLevel"`
+	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+	MarginTradeCoeffVo  struct {
+		ForceLiquidationBar string `json:"forceLiquidationBar"`
+		MarginCallBar       string `json:"marginCallBar"`
+		NormalBar           string `json:"normalBar"`
+	} `json:"marginTradeCoeffVo"`
+	MarginUserAssetVoList []UserAsset `json:"marginUserAssetVoList"`
+}
@@ -32,16 +32,16 @@ func (s *TransferToSubAccountService) Amount(amount string) *TransferToSubAccoun
 
 func (s *TransferToSubAccountService) transferToSubaccount(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   "POST",
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   "POST",
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"toEmail": s.toEmail,
 		"asset":   s.asset,
 		"amount":  s.amount,
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -95,16 +95,16 @@ func (s *SubaccountDepositAddressService) Network(network string) *SubaccountDep
 
 func (s *SubaccountDepositAddressService) subaccountDepositAddress(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   "GET",
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   "GET",
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"email":   s.email,
 		"coin":    s.coin,
 		"network": s.network,
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -146,14 +146,14 @@ func (s *SubaccountAssetsService) Email(email string) *SubaccountAssetsService {
 
 func (s *SubaccountAssetsService) subaccountAssets(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   "GET",
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   "GET",
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 	m := params{
 		"email": s.email,
 	}
-	r.setParams(m)
+	r.SetParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -211,21 +211,21 @@ func (s *SubaccountSpotSummaryService) Size(size int32) *SubaccountSpotSummarySe
 
 func (s *SubaccountSpotSummaryService) subaccountSpotSummary(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
-		method:   "GET",
-		endpoint: endpoint,
-		secType:  secTypeSigned,
+		Method:   "GET",
+		Endpoint: endpoint,
+		SecType:  secTypeSigned,
 	}
 
 	if s.size != nil {
-		r.setParam("size", *s.size)
+		r.SetParam("size", *s.size)
 	}
 
 	if s.page != nil {
-		r.setParam("page", *s.page)
+		r.SetParam("page", *s.page)
 	}
 
 	if s.email != nil {
-		r.setParam("email", *s.email)
+		r.SetParam("email", *s.email)
 	}
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -291,27 +291,27 @@ func (s *SubAccountListService) Limit(v int) *SubAccountListService {
 
 func (s *SubAccountListService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountList, err error) {
 	r := &request{
-		method:   "GET",
-		endpoint: "/sapi/v1/sub-account/list",
-		secType:  secTypeSigned,
+		Method:   "GET",
+		Endpoint: "/sapi/v1/sub-account/list",
+		SecType:  secTypeSigned,
 	}
 	if s.email != nil {
-		r.setParam("email", *s.email)
+		r.SetParam("email", *s.email)
 	}
 	if s.isFreeze {
-		r.setParam("isFreeze", "true")
+		r.SetParam("isFreeze", "true")
 	} else {
-		r.setParam("isFreeze", "false")
+		r.SetParam("isFreeze", "false")
 	}
 	if s.page > 0 {
-		r.setParam("page", s.page)
+		r.SetParam("page", s.page)
 	}
 	if s.limit > 200 {
-		r.setParam("limit", 200)
+		r.SetParam("limit", 200)
 	} else if s.limit <= 0 {
-		r.setParam("limit", 10)
+		r.SetParam("limit", 10)
 	} else {
-		r.setParam("limit", s.limit)
+		r.SetParam("limit", s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -336,3 +336,95 @@ type SubAccount struct {
 	IsManagedSubAccount         bool   `json:"isManagedSubAccount"`
 	IsAssetManagementSubAccount bool   `json:"isAssetManagementSubAccount"`
 }
+
+// SubAccountDepositHistoryService list the deposit history of a sub-account (For Master Account)
+type SubAccountDepositHistoryService struct {
+	c         *Client
+	email     string
+	coin      *string
+	status    *int
+	startTime *int64
+	endTime   *int64
+	limit     *int
+	offset    *int
+}
+
+// Email set email
+func (s *SubAccountDepositHistoryService) Email(email string) *SubAccountDepositHistoryService {
+	s.email = email
+	return s
+}
+
+// Coin set coin
+func (s *SubAccountDepositHistoryService) Coin(coin string) *SubAccountDepositHistoryService {
+	s.coin = &coin
+	return s
+}
+
+// Status set status, 0: pending, 6: credited but cannot withdraw, 1: success
+func (s *SubAccountDepositHistoryService) Status(status int) *SubAccountDepositHistoryService {
+	s.status = &status
+	return s
+}
+
+// StartTime set startTime
+func (s *SubAccountDepositHistoryService) StartTime(startTime int64) *SubAccountDepositHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SubAccountDepositHistoryService) EndTime(endTime int64) *SubAccountDepositHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *SubAccountDepositHistoryService) Limit(limit int) *SubAccountDepositHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Offset set offset
+func (s *SubAccountDepositHistoryService) Offset(offset int) *SubAccountDepositHistoryService {
+	s.offset = &offset
+	return s
+}
+
+// Do send request
+func (s *SubAccountDepositHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*Deposit, err error) {
+	r := &request{
+		Method:   "GET",
+		Endpoint: "/sapi/v1/capital/deposit/subHisrec",
+		SecType:  secTypeSigned,
+	}
+	r.SetParam("email", s.email)
+	if s.coin != nil {
+		r.SetParam("coin", *s.coin)
+	}
+	if s.status != nil {
+		r.SetParam("status", *s.status)
+	}
+	if s.startTime != nil {
+		r.SetParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetParam("limit", *s.limit)
+	}
+	if s.offset != nil {
+		r.SetParam("offset", *s.offset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Deposit{}, err
+	}
+	res = make([]*Deposit, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Deposit{}, err
+	}
+	return res, nil
+}
@@ -22,11 +22,11 @@ func (s *ListBookTickersService) Symbol(symbol string) *ListBookTickersService {
 // Do send request
 func (s *ListBookTickersService) Do(ctx context.Context, opts ...RequestOption) (res []*BookTicker, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/ticker/bookTicker",
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/ticker/bookTicker",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	data = common.ToJSONList(data)
@@ -66,14 +66,14 @@ func (s *ListPricesService) Symbol(symbol string) *ListPricesService {
 // Do send request
 func (s *ListPricesService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolPrice, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/ticker/price",
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/ticker/price",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	} else if s.symbols != nil {
 		s, _ := json.Marshal(s.symbols)
-		r.setParam("symbols", string(s))
+		r.SetParam("symbols", string(s))
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -115,11 +115,11 @@ func (s *ListPricesService) Symbols(symbols []string) *ListPricesService {
 // Do send request
 func (s *ListPriceChangeStatsService) Do(ctx context.Context, opts ...RequestOption) (res []*PriceChangeStats, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/ticker/24hr",
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/ticker/24hr",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -172,10 +172,10 @@ func (s *AveragePriceService) Symbol(symbol string) *AveragePriceService {
 // Do send request
 func (s *AveragePriceService) Do(ctx context.Context, opts ...RequestOption) (res *AvgPrice, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/avgPrice",
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/avgPrice",
 	}
-	r.setParam("symbol", s.symbol)
+	r.SetParam("symbol", s.symbol)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return res, err
@@ -249,18 +249,18 @@ func (s *ListSymbolTickerService) WindowSize(windowSize string) *ListSymbolTicke
 
 func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolTicker, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/api/v3/ticker",
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/ticker",
 	}
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	} else if s.symbols != nil {
 		s, _ := json.Marshal(s.symbols)
-		r.setParam("symbols", string(s))
+		r.SetParam("symbols", string(s))
 	}
 
 	if s.windowSize != nil {
-		r.setParam("windowSize", *s.windowSize)
+		r.SetParam("windowSize", *s.windowSize)
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -275,3 +275,58 @@ func (s *ListSymbolTickerService) Do(ctx context.Context, opts ...RequestOption)
 	}
 	return res, nil
 }
+
+// ListTradingDayTickerService list the price change statistics of the
+// current trading day of symbols
+type ListTradingDayTickerService struct {
+	c        *Client
+	symbol   *string
+	symbols  []string
+	timeZone *string
+}
+
+// Symbol set symbol
+func (s *ListTradingDayTickerService) Symbol(symbol string) *ListTradingDayTickerService {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols, at most 100
+func (s *ListTradingDayTickerService) Symbols(symbols []string) *ListTradingDayTickerService {
+	s.symbols = symbols
+	return s
+}
+
+// TimeZone set the time zone the trading day starts in, e.g. "8" or "-1:00", default UTC
+func (s *ListTradingDayTickerService) TimeZone(timeZone string) *ListTradingDayTickerService {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Do send request
+func (s *ListTradingDayTickerService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolTicker, err error) {
+	r := &request{
+		Method:   http.MethodGet,
+		Endpoint: "/api/v3/ticker/tradingDay",
+	}
+	if s.symbol != nil {
+		r.SetParam("symbol", *s.symbol)
+	} else if s.symbols != nil {
+		s, _ := json.Marshal(s.symbols)
+		r.SetParam("symbols", string(s))
+	}
+	if s.timeZone != nil {
+		r.SetParam("timeZone", *s.timeZone)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SymbolTicker{}, err
+	}
+	data = common.ToJSONList(data)
+	res = make([]*SymbolTicker, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolTicker{}, err
+	}
+	return res, nil
+}
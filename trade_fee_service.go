@@ -20,13 +20,13 @@ func (s *TradeFeeService) Symbol(symbol string) *TradeFeeService {
 // Do send request
 func (s *TradeFeeService) Do(ctx context.Context) (res []*TradeFeeDetails, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/asset/tradeFee",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/asset/tradeFee",
+		SecType:  secTypeSigned,
 	}
 
 	if s.symbol != nil {
-		r.setParam("symbol", *s.symbol)
+		r.SetParam("symbol", *s.symbol)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
@@ -0,0 +1,658 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	transferWatcherDefaultPollInterval = time.Minute
+	transferWatcherDefaultLookback     = 24 * time.Hour
+	transferWatcherDefaultStallTimeout = time.Hour
+	transferWatcherOverlap             = time.Hour
+	transferHistoryMaxWindow           = 90 * 24 * time.Hour
+	transferHistoryMaxLimit            = 1000
+	withdrawApplyTimeLayout            = "2006-01-02 15:04:05"
+)
+
+// TransferKind define the kind of a transfer
+type TransferKind string
+
+// TransferState define the state of a deposit or a withdrawal
+type TransferState string
+
+// Transfer kinds and states
+const (
+	TransferKindDeposit  TransferKind = "DEPOSIT"
+	TransferKindWithdraw TransferKind = "WITHDRAW"
+
+	TransferStatePending            TransferState = "PENDING"
+	TransferStateCredited           TransferState = "CREDITED" // credited but cannot withdraw
+	TransferStateSuccess            TransferState = "SUCCESS"
+	TransferStateRejected           TransferState = "REJECTED"
+	TransferStateWrongDeposit       TransferState = "WRONG_DEPOSIT"
+	TransferStateWaitingUserConfirm TransferState = "WAITING_USER_CONFIRM"
+	TransferStateEmailSent          TransferState = "EMAIL_SENT"
+	TransferStateCancelled          TransferState = "CANCELLED"
+	TransferStateAwaitingApproval   TransferState = "AWAITING_APPROVAL"
+	TransferStateProcessing         TransferState = "PROCESSING"
+	TransferStateFailure            TransferState = "FAILURE"
+	TransferStateCompleted          TransferState = "COMPLETED"
+	TransferStateUnknown            TransferState = "UNKNOWN"
+)
+
+// DepositState return the state of a deposit status of ListDepositsService
+func DepositState(status int) TransferState {
+	switch status {
+	case 0:
+		return TransferStatePending
+	case 1:
+		return TransferStateSuccess
+	case 2:
+		return TransferStateRejected
+	case 6:
+		return TransferStateCredited
+	case 7:
+		return TransferStateWrongDeposit
+	case 8:
+		return TransferStateWaitingUserConfirm
+	}
+	return TransferStateUnknown
+}
+
+// WithdrawState return the state of a withdraw status of ListWithdrawsService
+func WithdrawState(status int) TransferState {
+	switch status {
+	case 0:
+		return TransferStateEmailSent
+	case 1:
+		return TransferStateCancelled
+	case 2:
+		return TransferStateAwaitingApproval
+	case 3:
+		return TransferStateRejected
+	case 4:
+		return TransferStateProcessing
+	case 5:
+		return TransferStateFailure
+	case 6:
+		return TransferStateCompleted
+	}
+	return TransferStateUnknown
+}
+
+// Final return whether a transfer does not change from the state
+func (s TransferState) Final() bool {
+	switch s {
+	case TransferStateSuccess, TransferStateRejected, TransferStateWrongDeposit,
+		TransferStateCancelled, TransferStateFailure, TransferStateCompleted:
+		return true
+	}
+	return false
+}
+
+// Transfer define a deposit or a withdrawal followed by TransferWatcher
+type Transfer struct {
+	Kind    TransferKind
+	ID      string
+	Coin    string
+	Network string
+	Amount  string
+	Address string
+	TxID    string
+	Time    int64 // insert time of the deposit or apply time of the withdrawal, in milliseconds
+
+	// the records of the history, set on the events only
+	Deposit  *Deposit  // for TransferKindDeposit
+	Withdraw *Withdraw // for TransferKindWithdraw
+}
+
+// TransferEvent define the change of state of a transfer, From is empty
+// when the transfer is new
+type TransferEvent struct {
+	Transfer Transfer
+	From     TransferState
+	To       TransferState
+	Time     time.Time
+}
+
+// TransferStallAlert define a withdrawal stuck in a state for longer than
+// the stall timeout
+type TransferStallAlert struct {
+	Transfer Transfer
+	State    TransferState
+	Since    time.Time
+	Duration time.Duration
+}
+
+// Text return the alert as a Slack message
+func (a *TransferStallAlert) Text() string {
+	t := a.Transfer
+	text := fmt.Sprintf(":warning: %s of %s %s", kindLabel(t.Kind), t.Amount, t.Coin)
+	if t.Network != "" {
+		text += " on " + t.Network
+	}
+	if t.Address != "" {
+		text += fmt.Sprintf(" to `%s`", t.Address)
+	}
+	text += fmt.Sprintf(" stuck in *%s* for %s (id `%s`", a.State, a.Duration.Round(time.Minute), t.ID)
+	if t.TxID != "" {
+		text += fmt.Sprintf(", tx `%s`", t.TxID)
+	}
+	return text + ")"
+}
+
+func kindLabel(kind TransferKind) string {
+	if kind == TransferKindDeposit {
+		return "Deposit"
+	}
+	return "Withdrawal"
+}
+
+// SlackWebhookStallHandler return a stall handler posting the alerts to a
+// Slack incoming webhook
+func SlackWebhookStallHandler(webhookURL string) func(alert *TransferStallAlert) error {
+	return func(alert *TransferStallAlert) error {
+		body, err := json.Marshal(map[string]string{"text": alert.Text()})
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("slack webhook: status %d", res.StatusCode)
+		}
+		return nil
+	}
+}
+
+// TransferCursor define where the watcher resumes: the start time of the
+// next polls and the last state of the transfers seen since
+type TransferCursor struct {
+	DepositStart  int64                           `json:"depositStart"`
+	WithdrawStart int64                           `json:"withdrawStart"`
+	Transfers     map[string]*TransferCursorEntry `json:"transfers"` // by kind and id
+}
+
+// TransferCursorEntry define the last state of a transfer, with what the
+// watcher needs to list it and alert on it after a restart
+type TransferCursorEntry struct {
+	State   TransferState `json:"state"`
+	Time    int64         `json:"time"`  // insert or apply time, in milliseconds
+	Since   int64         `json:"since"` // time the state was first seen, in milliseconds
+	Alerted bool          `json:"alerted,omitempty"`
+	Coin    string        `json:"coin"`
+	Network string        `json:"network,omitempty"`
+	Amount  string        `json:"amount"`
+	Address string        `json:"address,omitempty"`
+	TxID    string        `json:"txId,omitempty"`
+}
+
+// set copy the details of a transfer
+func (e *TransferCursorEntry) set(t Transfer) {
+	e.Time = t.Time
+	e.Coin, e.Network, e.Amount, e.Address, e.TxID = t.Coin, t.Network, t.Amount, t.Address, t.TxID
+}
+
+// transfer return the transfer of the entry, without its record
+func (e *TransferCursorEntry) transfer(key string) Transfer {
+	kind, id, _ := strings.Cut(key, ":")
+	return Transfer{
+		Kind:    TransferKind(kind),
+		ID:      id,
+		Coin:    e.Coin,
+		Network: e.Network,
+		Amount:  e.Amount,
+		Address: e.Address,
+		TxID:    e.TxID,
+		Time:    e.Time,
+	}
+}
+
+// TransferCursorStore persist the cursor of a TransferWatcher
+type TransferCursorStore interface {
+	Load() (*TransferCursor, error) // nil without a saved cursor
+	Save(cursor *TransferCursor) error
+}
+
+// FileTransferCursorStore persist the cursor as a JSON file, replaced
+// atomically on save
+type FileTransferCursorStore string
+
+// Load implement TransferCursorStore
+func (f FileTransferCursorStore) Load() (*TransferCursor, error) {
+	data, err := os.ReadFile(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cursor := new(TransferCursor)
+	if err = json.Unmarshal(data, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Save implement TransferCursorStore
+func (f FileTransferCursorStore) Save(cursor *TransferCursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(string(f)), filepath.Base(string(f))+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), string(f))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// TransferWatcher poll the deposit and withdraw histories and send the
+// changes of state of the transfers to the subscribers. The polls resume
+// from a cursor, persisted in CursorStore when set. The first poll without
+// a cursor looks back on Lookback and records the states without events.
+//
+// Feed HandleUserData with the events of the user data stream: a
+// balanceUpdate triggers a poll at once, deposits and withdrawals show in
+// the balance before the next poll.
+type TransferWatcher struct {
+	PollInterval time.Duration // poll interval of Run, a minute when zero
+	Lookback     time.Duration // range of the first poll without a cursor, a day when zero
+	// StallTimeout is the time a withdrawal may stay in a state that is not
+	// final before StallHandler is called, an hour when zero. The handler is
+	// called once per state, again on the next polls while it fails.
+	StallTimeout time.Duration
+	StallHandler func(alert *TransferStallAlert) error
+	CursorStore  TransferCursorStore
+	ErrHandler   ErrHandler
+
+	c           *Client
+	mu          sync.Mutex
+	cursor      *TransferCursor
+	subscribers map[int]func(event *TransferEvent)
+	nextID      int
+	trigger     chan struct{}
+	now         func() time.Time
+}
+
+// Subscribe add a handler of the events, call the returned function to
+// remove it
+func (w *TransferWatcher) Subscribe(handler func(event *TransferEvent)) (unsubscribe func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	id := w.nextID
+	w.nextID++
+	w.subscribers[id] = handler
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subscribers, id)
+	}
+}
+
+// HandleUserData trigger a poll on a balanceUpdate event
+func (w *TransferWatcher) HandleUserData(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeBalanceUpdate {
+		return
+	}
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Transfers return the transfers which are not in a final state, from the
+// cursor loaded by the first poll
+func (w *TransferWatcher) Transfers() []Transfer {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	res := make([]Transfer, 0)
+	if w.cursor == nil {
+		return res
+	}
+	for key, entry := range w.cursor.Transfers {
+		if !entry.State.Final() {
+			res = append(res, entry.transfer(key))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Time < res[j].Time })
+	return res
+}
+
+// Run poll on PollInterval and on the balanceUpdate events until ctx is
+// done, the errors are sent to ErrHandler. The first poll is sent at once.
+func (w *TransferWatcher) Run(ctx context.Context) error {
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = transferWatcherDefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Poll(ctx); err != nil && ctx.Err() == nil && w.ErrHandler != nil {
+			w.ErrHandler(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-w.trigger:
+		}
+	}
+}
+
+// Poll fetch the transfers since the cursor, send the events, check the
+// stalled withdrawals and save the cursor
+func (w *TransferWatcher) Poll(ctx context.Context) error {
+	w.mu.Lock()
+	if w.cursor == nil {
+		if err := w.loadCursor(); err != nil {
+			w.mu.Unlock()
+			return err
+		}
+	}
+	cursor := w.cursor
+	w.mu.Unlock()
+
+	now := w.now()
+	seeding := cursor.Transfers == nil
+	depositStart, withdrawStart := cursor.DepositStart, cursor.WithdrawStart
+	if seeding {
+		lookback := w.Lookback
+		if lookback <= 0 {
+			lookback = transferWatcherDefaultLookback
+		}
+		depositStart = now.Add(-lookback).UnixMilli()
+		withdrawStart = depositStart
+	}
+	var transfers []Transfer
+	var states []TransferState
+	deposits, err := w.listDeposits(ctx, depositStart, now.UnixMilli())
+	if err != nil {
+		return err
+	}
+	for _, d := range deposits {
+		transfers = append(transfers, depositTransfer(d))
+		states = append(states, DepositState(d.Status))
+	}
+	withdraws, err := w.listWithdraws(ctx, withdrawStart, now.UnixMilli())
+	if err != nil {
+		return err
+	}
+	for _, wd := range withdraws {
+		transfers = append(transfers, withdrawTransfer(wd))
+		states = append(states, WithdrawState(wd.Status))
+	}
+
+	w.mu.Lock()
+	if cursor.Transfers == nil {
+		cursor.Transfers = make(map[string]*TransferCursorEntry)
+	}
+	var events []*TransferEvent
+	for i, t := range transfers {
+		key := transferKey(t)
+		entry, ok := cursor.Transfers[key]
+		if ok && entry.State == states[i] {
+			// the tx id shows once the withdrawal is sent
+			entry.set(t)
+			continue
+		}
+		event := &TransferEvent{Transfer: t, To: states[i], Time: now}
+		if ok {
+			event.From = entry.State
+		}
+		entry = &TransferCursorEntry{State: states[i], Since: now.UnixMilli()}
+		entry.set(t)
+		cursor.Transfers[key] = entry
+		if !seeding {
+			events = append(events, event)
+		}
+	}
+	alerts := w.stalled(now)
+	w.advance(now)
+	subscribers := make([]func(event *TransferEvent), 0, len(w.subscribers))
+	for _, id := range w.subscriberIDs() {
+		subscribers = append(subscribers, w.subscribers[id])
+	}
+	w.mu.Unlock()
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Transfer.Time < events[j].Transfer.Time })
+	for _, event := range events {
+		for _, handler := range subscribers {
+			handler(event)
+		}
+	}
+	var errs []error
+	var alerted []*TransferStallAlert
+	for _, alert := range alerts {
+		if err := w.StallHandler(alert); err != nil {
+			errs = append(errs, fmt.Errorf("stall alert %s: %w", alert.Transfer.ID, err))
+			continue
+		}
+		alerted = append(alerted, alert)
+	}
+
+	w.mu.Lock()
+	// an alert which failed is sent again on the next poll
+	for _, alert := range alerted {
+		if entry, ok := cursor.Transfers[transferKey(alert.Transfer)]; ok && entry.State == alert.State {
+			entry.Alerted = true
+		}
+	}
+	var saved *TransferCursor
+	if w.CursorStore != nil {
+		saved = cursor.clone()
+	}
+	w.mu.Unlock()
+	if saved != nil {
+		if err := w.CursorStore.Save(saved); err != nil {
+			errs = append(errs, fmt.Errorf("save transfer cursor: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// loadCursor load the cursor from the store, w.mu is held
+func (w *TransferWatcher) loadCursor() error {
+	w.cursor = &TransferCursor{}
+	if w.CursorStore == nil {
+		return nil
+	}
+	cursor, err := w.CursorStore.Load()
+	if err != nil {
+		w.cursor = nil
+		return fmt.Errorf("load transfer cursor: %w", err)
+	}
+	if cursor != nil {
+		w.cursor = cursor
+	}
+	return nil
+}
+
+// stalled return the alerts of the withdrawals stuck in a state which are
+// not sent yet, w.mu is held
+func (w *TransferWatcher) stalled(now time.Time) []*TransferStallAlert {
+	if w.StallHandler == nil {
+		return nil
+	}
+	timeout := w.StallTimeout
+	if timeout <= 0 {
+		timeout = transferWatcherDefaultStallTimeout
+	}
+	var alerts []*TransferStallAlert
+	for key, entry := range w.cursor.Transfers {
+		if transferKeyKind(key) != TransferKindWithdraw || entry.State.Final() || entry.Alerted {
+			continue
+		}
+		since := time.UnixMilli(entry.Since)
+		if d := now.Sub(since); d >= timeout {
+			alerts = append(alerts, &TransferStallAlert{Transfer: entry.transfer(key), State: entry.State, Since: since, Duration: d})
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Transfer.Time < alerts[j].Transfer.Time })
+	return alerts
+}
+
+// advance move the start of the next polls to the oldest transfer which is
+// not final, or to an hour ago, and forget the final transfers before it,
+// w.mu is held
+func (w *TransferWatcher) advance(now time.Time) {
+	start := map[TransferKind]int64{
+		TransferKindDeposit:  now.Add(-transferWatcherOverlap).UnixMilli(),
+		TransferKindWithdraw: now.Add(-transferWatcherOverlap).UnixMilli(),
+	}
+	for key, entry := range w.cursor.Transfers {
+		kind := transferKeyKind(key)
+		if !entry.State.Final() && entry.Time < start[kind] {
+			start[kind] = entry.Time
+		}
+	}
+	w.cursor.DepositStart, w.cursor.WithdrawStart = start[TransferKindDeposit], start[TransferKindWithdraw]
+	for key, entry := range w.cursor.Transfers {
+		if entry.State.Final() && entry.Time < start[transferKeyKind(key)] {
+			delete(w.cursor.Transfers, key)
+		}
+	}
+}
+
+func transferKey(t Transfer) string {
+	return string(t.Kind) + ":" + t.ID
+}
+
+func transferKeyKind(key string) TransferKind {
+	kind, _, _ := strings.Cut(key, ":")
+	return TransferKind(kind)
+}
+
+func (w *TransferWatcher) subscriberIDs() []int {
+	ids := make([]int, 0, len(w.subscribers))
+	for id := range w.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (c *TransferCursor) clone() *TransferCursor {
+	res := &TransferCursor{
+		DepositStart:  c.DepositStart,
+		WithdrawStart: c.WithdrawStart,
+		Transfers:     make(map[string]*TransferCursorEntry, len(c.Transfers)),
+	}
+	for key, entry := range c.Transfers {
+		e := *entry
+		res.Transfers[key] = &e
+	}
+	return res
+}
+
+// listDeposits page the deposits in windows of 90 days
+func (w *TransferWatcher) listDeposits(ctx context.Context, startTime, endTime int64) ([]*Deposit, error) {
+	res := make([]*Deposit, 0)
+	for from := startTime; from <= endTime; from += transferHistoryMaxWindow.Milliseconds() {
+		to := from + transferHistoryMaxWindow.Milliseconds() - 1
+		if to > endTime {
+			to = endTime
+		}
+		for offset := 0; ; offset += transferHistoryMaxLimit {
+			deposits, err := w.c.NewListDepositsService().StartTime(from).EndTime(to).
+				Offset(offset).Limit(transferHistoryMaxLimit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, deposits...)
+			if len(deposits) < transferHistoryMaxLimit {
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// listWithdraws page the withdrawals in windows of 90 days
+func (w *TransferWatcher) listWithdraws(ctx context.Context, startTime, endTime int64) ([]*Withdraw, error) {
+	res := make([]*Withdraw, 0)
+	for from := startTime; from <= endTime; from += transferHistoryMaxWindow.Milliseconds() {
+		to := from + transferHistoryMaxWindow.Milliseconds() - 1
+		if to > endTime {
+			to = endTime
+		}
+		for offset := 0; ; offset += transferHistoryMaxLimit {
+			withdraws, err := w.c.NewListWithdrawsService().StartTime(from).EndTime(to).
+				Offset(offset).Limit(transferHistoryMaxLimit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, withdraws...)
+			if len(withdraws) < transferHistoryMaxLimit {
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func depositTransfer(d *Deposit) Transfer {
+	id := d.ID
+	if id == "" {
+		id = d.Coin + ":" + d.TxID
+	}
+	return Transfer{
+		Kind:    TransferKindDeposit,
+		ID:      id,
+		Coin:    d.Coin,
+		Network: d.Network,
+		Amount:  d.Amount,
+		Address: d.Address,
+		TxID:    d.TxID,
+		Time:    d.InsertTime,
+		Deposit: d,
+	}
+}
+
+func withdrawTransfer(w *Withdraw) Transfer {
+	applyTime, _ := time.ParseInLocation(withdrawApplyTimeLayout, w.ApplyTime, time.UTC)
+	return Transfer{
+		Kind:     TransferKindWithdraw,
+		ID:       w.ID,
+		Coin:     w.Coin,
+		Network:  w.Network,
+		Amount:   w.Amount,
+		Address:  w.Address,
+		TxID:     w.TxID,
+		Time:     applyTime.UnixMilli(),
+		Withdraw: w,
+	}
+}
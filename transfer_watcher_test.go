@@ -0,0 +1,334 @@
+package binance_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+)
+
+type transferServer struct {
+	*httptest.Server
+	mu            sync.Mutex
+	deposits      []binance.Deposit
+	withdraws     []binance.Withdraw
+	depositStarts []int64
+	fail          bool
+	polled        chan struct{}
+}
+
+func newTransferServer(t *testing.T) *transferServer {
+	s := &transferServer{polled: make(chan struct{}, 10)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		startTime, _ := strconv.ParseInt(r.URL.Query().Get("startTime"), 10, 64)
+		endTime, _ := strconv.ParseInt(r.URL.Query().Get("endTime"), 10, 64)
+		switch r.URL.Path {
+		case "/sapi/v1/capital/deposit/hisrec":
+			s.depositStarts = append(s.depositStarts, startTime)
+			res := make([]binance.Deposit, 0)
+			for _, d := range s.deposits {
+				if d.InsertTime >= startTime && d.InsertTime <= endTime {
+					res = append(res, d)
+				}
+			}
+			json.NewEncoder(w).Encode(res)
+			select {
+			case s.polled <- struct{}{}:
+			default:
+			}
+		case "/sapi/v1/capital/withdraw/history":
+			res := make([]binance.Withdraw, 0)
+			for _, wd := range s.withdraws {
+				applyTime, _ := time.ParseInLocation("2006-01-02 15:04:05", wd.ApplyTime, time.UTC)
+				if applyTime.UnixMilli() >= startTime && applyTime.UnixMilli() <= endTime {
+					res = append(res, wd)
+				}
+			}
+			json.NewEncoder(w).Encode(res)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func (s *transferServer) set(f func(s *transferServer)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s)
+}
+
+func newTransferWatcher(s *transferServer, store binance.TransferCursorStore) (*binance.TransferWatcher, *[]*binance.TransferEvent) {
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = s.URL
+	w := c.NewTransferWatcher()
+	w.CursorStore = store
+	var events []*binance.TransferEvent
+	w.Subscribe(func(event *binance.TransferEvent) {
+		events = append(events, event)
+	})
+	return w, &events
+}
+
+func TestTransferWatcherEvents(t *testing.T) {
+	s := newTransferServer(t)
+	defer s.Close()
+	now := time.Now()
+	s.deposits = []binance.Deposit{
+		{ID: "d1", Coin: "BTC", Amount: "1", Status: 1, InsertTime: now.Add(-2 * time.Hour).UnixMilli()},
+		{ID: "d2", Coin: "ETH", Amount: "2", Status: 0, InsertTime: now.Add(-3 * time.Hour).UnixMilli()},
+	}
+	store := binance.FileTransferCursorStore(filepath.Join(t.TempDir(), "cursor.json"))
+	w, events := newTransferWatcher(s, store)
+
+	// the first poll records the states without events
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(*events) != 0 {
+		t.Fatalf("unexpected events %+v", *events)
+	}
+	cursor, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the next polls start from the oldest pending deposit
+	if len(cursor.Transfers) != 2 || cursor.DepositStart != s.deposits[1].InsertTime {
+		t.Fatalf("unexpected cursor %+v", cursor)
+	}
+
+	s.set(func(s *transferServer) {
+		s.deposits[1].Status = 1
+		s.withdraws = []binance.Withdraw{{ID: "w1", Coin: "USDT", Amount: "100", Status: 4,
+			ApplyTime: now.UTC().Format("2006-01-02 15:04:05")}}
+	})
+	if err = w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s.depositStarts[1] != s.deposits[1].InsertTime {
+		t.Fatalf("unexpected start %d", s.depositStarts[1])
+	}
+	if len(*events) != 2 {
+		t.Fatalf("unexpected events %+v", *events)
+	}
+	d2, w1 := (*events)[0], (*events)[1]
+	if d2.Transfer.ID != "d2" || d2.From != binance.TransferStatePending || d2.To != binance.TransferStateSuccess || d2.Transfer.Deposit == nil {
+		t.Fatalf("unexpected event %+v", d2)
+	}
+	if w1.Transfer.ID != "w1" || w1.From != "" || w1.To != binance.TransferStateProcessing || w1.Transfer.Withdraw == nil {
+		t.Fatalf("unexpected event %+v", w1)
+	}
+
+	// without pending deposit the start is an hour ago, the final deposits
+	// before it are forgotten
+	cursor, _ = store.Load()
+	if _, ok := cursor.Transfers["DEPOSIT:d1"]; ok || len(cursor.Transfers) != 1 {
+		t.Fatalf("unexpected transfers %+v", cursor.Transfers)
+	}
+	if d := time.Since(time.UnixMilli(cursor.DepositStart)); d < time.Hour || d > time.Hour+time.Minute {
+		t.Fatalf("unexpected deposit start %v ago", d)
+	}
+
+	// no event without change
+	if err = w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(*events) != 2 {
+		t.Fatalf("unexpected events %+v", (*events)[2:])
+	}
+}
+
+func TestTransferWatcherRestart(t *testing.T) {
+	s := newTransferServer(t)
+	defer s.Close()
+	s.withdraws = []binance.Withdraw{{ID: "w1", Coin: "USDT", Network: "TRX", Amount: "100", Address: "TXYZ", Status: 4,
+		ApplyTime: time.Now().UTC().Format("2006-01-02 15:04:05")}}
+	store := binance.FileTransferCursorStore(filepath.Join(t.TempDir(), "cursor.json"))
+	w, _ := newTransferWatcher(s, store)
+	w.StallTimeout = time.Millisecond
+	var alerts []*binance.TransferStallAlert
+	w.StallHandler = func(alert *binance.TransferStallAlert) error {
+		alerts = append(alerts, alert)
+		return nil
+	}
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("unexpected alerts %d", len(alerts))
+	}
+
+	// the transfers are listed and the alert is not sent again without refetch
+	s.set(func(s *transferServer) { s.fail = true })
+	restarted, events := newTransferWatcher(s, store)
+	restarted.StallTimeout = time.Millisecond
+	restarted.StallHandler = w.StallHandler
+	if err := restarted.Poll(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+	transfers := restarted.Transfers()
+	if len(transfers) != 1 || transfers[0].ID != "w1" || transfers[0].Kind != binance.TransferKindWithdraw ||
+		transfers[0].Coin != "USDT" || transfers[0].Amount != "100" || transfers[0].Network != "TRX" || transfers[0].Address != "TXYZ" {
+		t.Fatalf("unexpected transfers %+v", transfers)
+	}
+
+	s.set(func(s *transferServer) {
+		s.fail = false
+		s.withdraws[0].Status = 6
+	})
+	if err := restarted.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("unexpected alerts %d", len(alerts))
+	}
+	if len(*events) != 1 || (*events)[0].From != binance.TransferStateProcessing || (*events)[0].To != binance.TransferStateCompleted {
+		t.Fatalf("unexpected events %+v", *events)
+	}
+	if transfers = restarted.Transfers(); len(transfers) != 0 {
+		t.Fatalf("unexpected transfers %+v", transfers)
+	}
+}
+
+func TestTransferWatcherStall(t *testing.T) {
+	s := newTransferServer(t)
+	defer s.Close()
+	s.withdraws = []binance.Withdraw{{ID: "w1", Coin: "BTC", Amount: "1", Status: 2,
+		ApplyTime: time.Now().UTC().Format("2006-01-02 15:04:05")}}
+	w, _ := newTransferWatcher(s, nil)
+	w.StallTimeout = time.Millisecond
+	var alerts []*binance.TransferStallAlert
+	fail := true
+	w.StallHandler = func(alert *binance.TransferStallAlert) error {
+		alerts = append(alerts, alert)
+		if fail {
+			return errors.New("webhook down")
+		}
+		return nil
+	}
+	poll := func() error {
+		time.Sleep(5 * time.Millisecond)
+		return w.Poll(context.Background())
+	}
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// a failed alert is sent again on the next poll
+	if err := poll(); err == nil {
+		t.Fatal("expected the handler error")
+	}
+	fail = false
+	if err := poll(); err != nil {
+		t.Fatal(err)
+	}
+	if err := poll(); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 2 || alerts[1].State != binance.TransferStateAwaitingApproval {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+
+	// once per state
+	s.set(func(s *transferServer) { s.withdraws[0].Status = 4 })
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := poll(); err != nil {
+		t.Fatal(err)
+	}
+	if err := poll(); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 3 || alerts[2].State != binance.TransferStateProcessing || alerts[2].Duration < time.Millisecond {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+	if text := alerts[2].Text(); text != ":warning: Withdrawal of 1 BTC stuck in *PROCESSING* for 0s (id `w1`)" {
+		t.Fatalf("unexpected text %s", text)
+	}
+}
+
+func TestTransferWatcherBalanceUpdate(t *testing.T) {
+	s := newTransferServer(t)
+	defer s.Close()
+	w, _ := newTransferWatcher(s, nil)
+	w.PollInterval = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+	wait := func() {
+		select {
+		case <-s.polled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("no poll")
+		}
+	}
+	wait()
+	w.HandleUserData(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeBalanceUpdate})
+	wait()
+	w.HandleUserData(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeOutboundAccountPosition})
+	select {
+	case <-s.polled:
+		t.Fatal("unexpected poll")
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+}
+
+func TestFileTransferCursorStore(t *testing.T) {
+	dir := t.TempDir()
+	store := binance.FileTransferCursorStore(filepath.Join(dir, "cursor.json"))
+	cursor, err := store.Load()
+	if err != nil || cursor != nil {
+		t.Fatalf("unexpected cursor %v %v", cursor, err)
+	}
+	saved := &binance.TransferCursor{
+		DepositStart:  1,
+		WithdrawStart: 2,
+		Transfers: map[string]*binance.TransferCursorEntry{
+			"WITHDRAW:w1": {State: binance.TransferStateProcessing, Time: 3, Since: 4, Alerted: true, Coin: "BTC", Amount: "1", TxID: "0xa"},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if err = store.Save(saved); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cursor, err = store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(cursor.DepositStart, cursor.WithdrawStart, *cursor.Transfers["WITHDRAW:w1"]) !=
+		fmt.Sprint(saved.DepositStart, saved.WithdrawStart, *saved.Transfers["WITHDRAW:w1"]) {
+		t.Fatalf("unexpected cursor %+v", cursor)
+	}
+	// the temporary files are renamed
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Fatalf("unexpected files %v", entries)
+	}
+}
@@ -58,19 +58,19 @@ func (s *CreateUserUniversalTransferService) ToSymbol(v string) *CreateUserUnive
 // Do sends the request.
 func (s *CreateUserUniversalTransferService) Do(ctx context.Context) (*CreateUserUniversalTransferResponse, error) {
 	r := &request{
-		method:   "POST",
-		endpoint: "/sapi/v1/asset/transfer",
-		secType:  secTypeSigned,
+		Method:   "POST",
+		Endpoint: "/sapi/v1/asset/transfer",
+		SecType:  secTypeSigned,
 	}
 
-	r.setParam("type", s.types)
-	r.setParam("asset", s.asset)
-	r.setParam("amount", s.amount)
+	r.SetParam("type", s.types)
+	r.SetParam("asset", s.asset)
+	r.SetParam("amount", s.amount)
 	if v := s.fromSymbol; v != nil {
-		r.setParam("fromSymbol", *v)
+		r.SetParam("fromSymbol", *v)
 	}
 	if v := s.toSymbol; v != nil {
-		r.setParam("toSymbol", *v)
+		r.SetParam("toSymbol", *v)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
@@ -154,24 +154,24 @@ type CreateUserUniversalTransferResponse struct {
 // 		endpoint: "/sapi/v1/asset/transfer",
 // 		secType:  secTypeSigned,
 // 	}
-// 	r.setParam("types", s.types)
+// 	r.SetParam("types", s.types)
 // 	if s.startTime != nil {
-// 		r.setParam("startTime", *s.startTime)
+// 		r.SetParam("startTime", *s.startTime)
 // 	}
 // 	if s.endTime != nil {
-// 		r.setParam("endTime", *s.endTime)
+// 		r.SetParam("endTime", *s.endTime)
 // 	}
 // 	if s.current != nil {
-// 		r.setParam("current", *s.current)
+// 		r.SetParam("current", *s.current)
 // 	}
 // 	if s.size != nil {
-// 		r.setParam("size", *s.size)
+// 		r.SetParam("size", *s.size)
 // 	}
 // 	if s.fromSymbol != nil {
-// 		r.setParam("fromSymbol", *s.fromSymbol)
+// 		r.SetParam("fromSymbol", *s.fromSymbol)
 // 	}
 // 	if s.toSymbol != nil {
-// 		r.setParam("toSymbol", *s.toSymbol)
+// 		r.SetParam("toSymbol", *s.toSymbol)
 // 	}
 // 	data, err := s.c.callAPI(ctx, r)
 // 	if err != nil {
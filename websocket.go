@@ -1,8 +1,7 @@
 package binance
 
 import (
-	"github.com/uncle-gua/gobinance/log"
-	"github.com/uncle-gua/wsc"
+	"github.com/uncle-gua/gobinance/internal/transport"
 )
 
 // WsHandler handle raw websocket message
@@ -23,44 +22,6 @@ func newWsConfig(endpoint string) *WsConfig {
 }
 
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (done chan struct{}, err error) {
-	done = make(chan struct{})
-
-	go func() {
-		ws := wsc.New(cfg.Endpoint)
-		ws.OnConnected(func() {
-			if log.Default.OnConnected {
-				log.Default.Log("websocket connected")
-			}
-		})
-		ws.OnConnectError(errHandler)
-		ws.OnDisconnected(errHandler)
-		ws.OnClose(func(code int, text string) {
-			if log.Default.OnClose {
-				log.Default.Log("websocket closed, code: %d, message: %s", code, text)
-			}
-		})
-		ws.OnSentError(errHandler)
-		ws.OnPingReceived(func(appData string) {
-			if log.Default.OnPingReceived {
-				log.Default.Log("ping received, data: %s", appData)
-			}
-		})
-		ws.OnPongReceived(func(appData string) {
-			if log.Default.OnPongReceived {
-				log.Default.Log("pong received, data: %s", appData)
-			}
-		})
-		ws.OnTextMessageReceived(handler)
-		ws.OnKeepalive(func() {
-			if log.Default.OnKeepalive {
-				log.Default.Log("keep alive")
-			}
-		})
-		ws.Connect()
-		for range done {
-			ws.Close()
-			return
-		}
-	}()
-	return
+	ws := transport.NewWs(cfg.Endpoint, handler, errHandler)
+	return transport.Serve(ws, true), nil
 }
@@ -0,0 +1,470 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uncle-gua/gobinance/common"
+)
+
+const withdrawGuardWindow = 24 * time.Hour
+
+// ErrWithdrawRejected is wrapped by the errors of WithdrawGuard when a
+// withdrawal breaks a rule
+var ErrWithdrawRejected = errors.New("withdraw rejected")
+
+// WithdrawAuditEvent define an event of the withdraw audit log
+type WithdrawAuditEvent string
+
+// Withdraw audit events
+const (
+	WithdrawAuditEventRequested WithdrawAuditEvent = "REQUESTED"
+	WithdrawAuditEventRejected  WithdrawAuditEvent = "REJECTED"
+	WithdrawAuditEventApproved  WithdrawAuditEvent = "APPROVED"
+	WithdrawAuditEventSubmitted WithdrawAuditEvent = "SUBMITTED"
+	WithdrawAuditEventFailed    WithdrawAuditEvent = "FAILED"
+)
+
+// WithdrawRequest define a withdrawal sent through WithdrawGuard
+type WithdrawRequest struct {
+	Coin               string `json:"coin"`
+	Network            string `json:"network,omitempty"` // default network of the coin when empty
+	Address            string `json:"address"`
+	AddressTag         string `json:"addressTag,omitempty"`
+	Amount             string `json:"amount"`
+	WithdrawOrderID    string `json:"withdrawOrderId,omitempty"`
+	TransactionFeeFlag bool   `json:"transactionFeeFlag,omitempty"`
+	Name               string `json:"name,omitempty"`
+	RequestedBy        string `json:"requestedBy,omitempty"` // person or service requesting the withdrawal
+}
+
+// AllowedAddress define an address withdrawals are allowed to. When Tag is
+// set the address tag (memo) must match too.
+type AllowedAddress struct {
+	Coin    string
+	Network string
+	Address string
+	Tag     string
+	Label   string
+}
+
+// WithdrawLimit define the limits of a coin, a zero limit is disabled
+type WithdrawLimit struct {
+	PerTransaction float64
+	Daily          float64 // over the last 24 hours
+}
+
+// WithdrawApprover ask a second person to approve a withdrawal. It returns
+// the identity of the approver, which must differ from RequestedBy, or an
+// error when the withdrawal is denied. RequestedBy is required with an
+// approver.
+type WithdrawApprover func(ctx context.Context, req *WithdrawRequest) (approvedBy string, err error)
+
+// WithdrawAuditEntry define a line of the withdraw audit log
+type WithdrawAuditEntry struct {
+	Time       int64              `json:"time"`
+	Event      WithdrawAuditEvent `json:"event"`
+	Request    WithdrawRequest    `json:"request"`
+	ApprovedBy string             `json:"approvedBy,omitempty"`
+	ID         string             `json:"id,omitempty"`
+	Reason     string             `json:"reason,omitempty"`
+}
+
+// WithdrawGuard check withdrawals before sending them with
+// CreateWithdrawService:
+//   - the address must be allowed for the coin and network, an empty
+//     network is resolved to the default network of the coin first
+//   - the coin must have a limit, the amount must not exceed the per
+//     transaction cap nor the daily limit with the withdrawals of the last
+//     24 hours, loaded from the withdraw history on first use. The daily
+//     usage counts the network fees, unless TransactionFeeFlag takes the
+//     fee out of the amount
+//   - the network must be enabled, the amount must be within its minimum,
+//     maximum and multiple, the address and tag must match its patterns and
+//     the network fee must not exceed MaxFee or MaxFeeRatio
+//   - the Approver, when set, must approve it
+//
+// Every step is written to AuditLog as JSON lines. Withdrawals are refused
+// without an audit log or when it cannot be written.
+type WithdrawGuard struct {
+	MaxFee      map[string]float64 // maximum network fee by coin, optional
+	MaxFeeRatio float64            // maximum network fee over the amount, disabled when zero
+	Approver    WithdrawApprover   // two-person approval, optional
+	AuditLog    io.Writer          // append-only audit log, required, see OpenWithdrawAuditLog
+
+	c       *Client
+	mu      sync.Mutex
+	allowed []AllowedAddress
+	limits  map[string]WithdrawLimit
+	loaded  bool
+	history []withdrawUsage
+	now     func() time.Time
+}
+
+type withdrawUsage struct {
+	id     string
+	coin   string
+	amount float64
+	time   time.Time
+}
+
+// OpenWithdrawAuditLog open an audit log file for appending, creating it
+// readable by its owner only
+func OpenWithdrawAuditLog(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+}
+
+// Allow add allowed addresses
+func (g *WithdrawGuard) Allow(addresses ...AllowedAddress) *WithdrawGuard {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.allowed = append(g.allowed, addresses...)
+	return g
+}
+
+// Limit set the limits of a coin, the coins without limits are rejected
+func (g *WithdrawGuard) Limit(coin string, limit WithdrawLimit) *WithdrawGuard {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.limits[coin] = limit
+	return g
+}
+
+// Used return the amount of a coin withdrawn in the last 24 hours, network
+// fees included
+func (g *WithdrawGuard) Used(ctx context.Context, coin string) (float64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err := g.load(ctx); err != nil {
+		return 0, err
+	}
+	return g.used(coin), nil
+}
+
+// Check run the checks of a withdrawal without the approval and without
+// sending it
+func (g *WithdrawGuard) Check(ctx context.Context, req *WithdrawRequest) error {
+	network, fee, err := g.checkNetwork(ctx, req)
+	if err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err = g.load(ctx); err != nil {
+		return err
+	}
+	_, err = g.check(req, network, fee)
+	return err
+}
+
+// Withdraw check, approve and send a withdrawal. The errors of the rules
+// wrap ErrWithdrawRejected.
+func (g *WithdrawGuard) Withdraw(ctx context.Context, req *WithdrawRequest) (*CreateWithdrawResponse, error) {
+	if err := g.audit(WithdrawAuditEntry{Event: WithdrawAuditEventRequested, Request: *req}); err != nil {
+		return nil, err
+	}
+	reject := func(err error) (*CreateWithdrawResponse, error) {
+		if auditErr := g.audit(WithdrawAuditEntry{Event: WithdrawAuditEventRejected, Request: *req, Reason: err.Error()}); auditErr != nil {
+			return nil, errors.Join(err, auditErr)
+		}
+		return nil, err
+	}
+
+	// the withdrawal is sent to the resolved network, which the address is
+	// allowed on
+	network, fee, err := g.checkNetwork(ctx, req)
+	if err != nil {
+		return reject(err)
+	}
+
+	// the amount is reserved until the withdrawal fails, so that concurrent
+	// withdrawals cannot exceed the daily limit together
+	g.mu.Lock()
+	if err = g.load(ctx); err != nil {
+		g.mu.Unlock()
+		return reject(err)
+	}
+	usage, err := g.check(req, network, fee)
+	if err != nil {
+		g.mu.Unlock()
+		return reject(err)
+	}
+	g.history = append(g.history, usage)
+	g.mu.Unlock()
+	release := func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		for i := range g.history {
+			if g.history[i] == usage {
+				g.history = append(g.history[:i], g.history[i+1:]...)
+				return
+			}
+		}
+	}
+
+	var approvedBy string
+	if g.Approver != nil {
+		if req.RequestedBy == "" {
+			release()
+			return reject(fmt.Errorf("%w: no requester to tell from the approver", ErrWithdrawRejected))
+		}
+		approvedBy, err = g.Approver(ctx, req)
+		if err == nil && approvedBy == "" {
+			err = fmt.Errorf("%w: no approver", ErrWithdrawRejected)
+		}
+		if err == nil && strings.EqualFold(approvedBy, req.RequestedBy) {
+			err = fmt.Errorf("%w: approved by the requester %s", ErrWithdrawRejected, approvedBy)
+		}
+		if err != nil {
+			release()
+			return reject(err)
+		}
+		if err = g.audit(WithdrawAuditEntry{Event: WithdrawAuditEventApproved, Request: *req, ApprovedBy: approvedBy}); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	s := g.c.NewCreateWithdrawService().Coin(req.Coin).Network(network).Address(req.Address).Amount(req.Amount)
+	if req.AddressTag != "" {
+		s.AddressTag(req.AddressTag)
+	}
+	if req.WithdrawOrderID != "" {
+		s.WithdrawOrderID(req.WithdrawOrderID)
+	}
+	if req.TransactionFeeFlag {
+		s.TransactionFeeFlag(true)
+	}
+	if req.Name != "" {
+		s.Name(req.Name)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		// the withdrawal may have been accepted when the response is lost,
+		// the amount stays reserved until the history is reloaded
+		if common.IsAPIError(err) {
+			release()
+		}
+		if auditErr := g.audit(WithdrawAuditEntry{Event: WithdrawAuditEventFailed, Request: *req, ApprovedBy: approvedBy, Reason: err.Error()}); auditErr != nil {
+			return nil, errors.Join(err, auditErr)
+		}
+		return nil, err
+	}
+	g.mu.Lock()
+	for i := range g.history {
+		if g.history[i] == usage {
+			g.history[i].id = res.ID
+			break
+		}
+	}
+	g.mu.Unlock()
+	if err = g.audit(WithdrawAuditEntry{Event: WithdrawAuditEventSubmitted, Request: *req, ApprovedBy: approvedBy, ID: res.ID}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// Reload reload the withdrawals of the last 24 hours from the withdraw
+// history, the pending withdrawals stay reserved
+func (g *WithdrawGuard) Reload(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.loaded = false
+	return g.load(ctx)
+}
+
+// load load the withdrawals of the last 24 hours once, the cancelled,
+// rejected and failed ones are left out. The local reservations are kept:
+// the withdrawals not submitted yet and the submitted ones the history does
+// not list yet.
+func (g *WithdrawGuard) load(ctx context.Context) error {
+	if g.loaded {
+		return nil
+	}
+	now := g.now()
+	withdraws, err := g.c.NewListWithdrawsService().StartTime(now.Add(-withdrawGuardWindow).UnixMilli()).EndTime(now.UnixMilli()).Do(ctx)
+	if err != nil {
+		return err
+	}
+	history := make([]withdrawUsage, 0, len(withdraws))
+	listed := make(map[string]bool, len(withdraws))
+	for _, w := range withdraws {
+		listed[w.ID] = true
+		// 1 cancelled, 3 rejected, 5 failure
+		if w.Status == 1 || w.Status == 3 || w.Status == 5 {
+			continue
+		}
+		amount, _ := strconv.ParseFloat(w.Amount, 64)
+		fee, _ := strconv.ParseFloat(w.TransactionFee, 64)
+		applyTime, err := time.ParseInLocation("2006-01-02 15:04:05", w.ApplyTime, time.UTC)
+		if err != nil {
+			applyTime = now
+		}
+		history = append(history, withdrawUsage{id: w.ID, coin: w.Coin, amount: amount + fee, time: applyTime})
+	}
+	for _, u := range g.history {
+		if u.id == "" || !listed[u.id] {
+			history = append(history, u)
+		}
+	}
+	g.history = history
+	g.loaded = true
+	return nil
+}
+
+// used return the amount of a coin withdrawn or reserved in the last 24 hours
+func (g *WithdrawGuard) used(coin string) float64 {
+	since := g.now().Add(-withdrawGuardWindow)
+	var used float64
+	history := g.history[:0]
+	for _, u := range g.history {
+		if u.time.Before(since) {
+			continue
+		}
+		history = append(history, u)
+		if u.coin == coin {
+			used += u.amount
+		}
+	}
+	g.history = history
+	return used
+}
+
+// check check the address on the resolved network and the limits of a
+// withdrawal and return its usage, the amount with the network fee unless
+// the fee is taken out of the amount
+func (g *WithdrawGuard) check(req *WithdrawRequest, network string, fee float64) (withdrawUsage, error) {
+	amount, err := strconv.ParseFloat(req.Amount, 64)
+	if err != nil || amount <= 0 || math.IsInf(amount, 0) {
+		return withdrawUsage{}, fmt.Errorf("%w: invalid amount %q", ErrWithdrawRejected, req.Amount)
+	}
+	if !g.allowedAddress(req, network) {
+		return withdrawUsage{}, fmt.Errorf("%w: address %s of %s on %s is not allowed", ErrWithdrawRejected, req.Address, req.Coin, network)
+	}
+	limit, ok := g.limits[req.Coin]
+	if !ok {
+		return withdrawUsage{}, fmt.Errorf("%w: no limit for %s", ErrWithdrawRejected, req.Coin)
+	}
+	if limit.PerTransaction > 0 && amount > limit.PerTransaction {
+		return withdrawUsage{}, fmt.Errorf("%w: amount %v above the transaction cap %v", ErrWithdrawRejected, amount, limit.PerTransaction)
+	}
+	debit := amount
+	if !req.TransactionFeeFlag {
+		debit += fee
+	}
+	if used := g.used(req.Coin); limit.Daily > 0 && used+debit > limit.Daily {
+		return withdrawUsage{}, fmt.Errorf("%w: amount %v with fee and %v withdrawn in 24h above the daily limit %v", ErrWithdrawRejected, debit, used, limit.Daily)
+	}
+	return withdrawUsage{coin: req.Coin, amount: debit, time: g.now()}, nil
+}
+
+func (g *WithdrawGuard) allowedAddress(req *WithdrawRequest, network string) bool {
+	for _, a := range g.allowed {
+		if a.Coin != req.Coin || a.Network != network {
+			continue
+		}
+		match := a.Address == req.Address
+		// EVM addresses are case insensitive, the case is only a checksum
+		if strings.HasPrefix(a.Address, "0x") {
+			match = strings.EqualFold(a.Address, req.Address)
+		}
+		if match && (a.Tag == "" || a.Tag == req.AddressTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNetwork check a withdrawal against the coin and network information
+// and return the network it is sent to and its fee
+func (g *WithdrawGuard) checkNetwork(ctx context.Context, req *WithdrawRequest) (string, float64, error) {
+	coins, err := g.c.NewGetAllCoinsInfoService().Do(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	var coin *CoinInfo
+	for _, c := range coins {
+		if c.Coin == req.Coin {
+			coin = c
+			break
+		}
+	}
+	if coin == nil {
+		return "", 0, fmt.Errorf("%w: unknown coin %s", ErrWithdrawRejected, req.Coin)
+	}
+	if !coin.WithdrawAllEnable {
+		return "", 0, fmt.Errorf("%w: withdrawals of %s are disabled", ErrWithdrawRejected, req.Coin)
+	}
+	var network *Network
+	for i := range coin.NetworkList {
+		n := &coin.NetworkList[i]
+		if n.Network == req.Network || (req.Network == "" && n.IsDefault) {
+			network = n
+			break
+		}
+	}
+	if network == nil {
+		return "", 0, fmt.Errorf("%w: unknown network %q of %s", ErrWithdrawRejected, req.Network, req.Coin)
+	}
+	if !network.WithdrawEnable {
+		return "", 0, fmt.Errorf("%w: withdrawals of %s on %s are disabled %s", ErrWithdrawRejected, req.Coin, network.Network, network.WithdrawDesc)
+	}
+
+	amount, _ := strconv.ParseFloat(req.Amount, 64)
+	if min, _ := strconv.ParseFloat(network.WithdrawMin, 64); amount < min {
+		return "", 0, fmt.Errorf("%w: amount %v under the minimum %v", ErrWithdrawRejected, amount, min)
+	}
+	if max, _ := strconv.ParseFloat(network.WithdrawMax, 64); max > 0 && amount > max {
+		return "", 0, fmt.Errorf("%w: amount %v above the maximum %v", ErrWithdrawRejected, amount, max)
+	}
+	if multiple, _ := strconv.ParseFloat(network.WithdrawIntegerMultiple, 64); multiple > 0 {
+		if n := amount / multiple; math.Abs(n-math.Round(n)) > 1e-9*math.Max(1, n) {
+			return "", 0, fmt.Errorf("%w: amount %v not a multiple of %v", ErrWithdrawRejected, amount, multiple)
+		}
+	}
+	if network.AddressRegex != "" {
+		if re, err := regexp.Compile(network.AddressRegex); err == nil && !re.MatchString(req.Address) {
+			return "", 0, fmt.Errorf("%w: address %s does not match the %s format", ErrWithdrawRejected, req.Address, network.Network)
+		}
+	}
+	if network.MemoRegex != "" && req.AddressTag != "" {
+		if re, err := regexp.Compile(network.MemoRegex); err == nil && !re.MatchString(req.AddressTag) {
+			return "", 0, fmt.Errorf("%w: address tag %s does not match the %s format", ErrWithdrawRejected, req.AddressTag, network.Network)
+		}
+	}
+	fee, _ := strconv.ParseFloat(network.WithdrawFee, 64)
+	if max, ok := g.MaxFee[req.Coin]; ok && fee > max {
+		return "", 0, fmt.Errorf("%w: network fee %v above %v", ErrWithdrawRejected, fee, max)
+	}
+	if g.MaxFeeRatio > 0 && fee > amount*g.MaxFeeRatio {
+		return "", 0, fmt.Errorf("%w: network fee %v above %v of the amount", ErrWithdrawRejected, fee, g.MaxFeeRatio)
+	}
+	return network.Network, fee, nil
+}
+
+// audit write an entry to the audit log
+func (g *WithdrawGuard) audit(entry WithdrawAuditEntry) error {
+	if g.AuditLog == nil {
+		return errors.New("withdraw audit log: not set")
+	}
+	entry.Time = g.now().UnixMilli()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, err = g.AuditLog.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("withdraw audit log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+package binance_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	binance "github.com/uncle-gua/gobinance"
+	"github.com/uncle-gua/gobinance/common"
+)
+
+const withdrawCoins = `[
+	{"coin":"ETH","withdrawAllEnable":true,"networkList":[
+		{"network":"ETH","isDefault":true,"withdrawEnable":true,"withdrawFee":"0.001","withdrawMin":"0.01","withdrawMax":"100"},
+		{"network":"BSC","withdrawEnable":true,"withdrawFee":"0.0001","withdrawMin":"0.01","withdrawMax":"100"}]},
+	{"coin":"XRP","withdrawAllEnable":true,"networkList":[
+		{"network":"XRP","isDefault":true,"withdrawEnable":true,"withdrawFee":"0.2","withdrawMin":"1","memoRegex":"^[0-9]+$"}]},
+	{"coin":"USDT","withdrawAllEnable":true,"networkList":[
+		{"network":"ETH","isDefault":true,"withdrawEnable":true,"withdrawFee":"5","withdrawMin":"10"},
+		{"network":"TRX","withdrawEnable":true,"withdrawFee":"1","withdrawMin":"10"}]}]`
+
+const ethAddress = "0x52908400098527886E0F7030069857D2E4169EE7"
+
+type withdrawServer struct {
+	*httptest.Server
+	history string
+	apply   func(w http.ResponseWriter, r *http.Request)
+	applied int32
+}
+
+func newWithdrawServer(t *testing.T, history string) *withdrawServer {
+	s := &withdrawServer{history: history}
+	s.apply = func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"w%d"}`, atomic.LoadInt32(&s.applied))
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sapi/v1/capital/config/getall":
+			fmt.Fprint(w, withdrawCoins)
+		case "/sapi/v1/capital/withdraw/history":
+			fmt.Fprint(w, s.history)
+		case "/sapi/v1/capital/withdraw/apply":
+			atomic.AddInt32(&s.applied, 1)
+			s.apply(w, r)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func newWithdrawGuard(s *withdrawServer) (*binance.WithdrawGuard, *bytes.Buffer) {
+	c := binance.NewClient("key", "secret")
+	c.BaseURL = s.URL
+	log := new(bytes.Buffer)
+	g := c.NewWithdrawGuard()
+	g.AuditLog = log
+	g.Allow(binance.AllowedAddress{Coin: "ETH", Network: "ETH", Address: ethAddress})
+	g.Limit("ETH", binance.WithdrawLimit{PerTransaction: 2, Daily: 5})
+	return g, log
+}
+
+func applyTime(d time.Duration) string {
+	return time.Now().UTC().Add(-d).Format("2006-01-02 15:04:05")
+}
+
+func ethWithdraw(amount string) *binance.WithdrawRequest {
+	return &binance.WithdrawRequest{Coin: "ETH", Network: "ETH", Address: ethAddress, Amount: amount}
+}
+
+func TestWithdrawGuardAllowlist(t *testing.T) {
+	s := newWithdrawServer(t, `[]`)
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+	g.Allow(
+		binance.AllowedAddress{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Tag: "123"},
+		binance.AllowedAddress{Coin: "USDT", Network: "TRX", Address: "TLa2f6VPqDgRE67v1736s7bJ8Ray5wYjU7"},
+	)
+	g.Limit("XRP", binance.WithdrawLimit{})
+	g.Limit("USDT", binance.WithdrawLimit{})
+
+	tests := []struct {
+		name    string
+		req     binance.WithdrawRequest
+		allowed bool
+	}{
+		{"evm address in another case", binance.WithdrawRequest{Coin: "ETH", Network: "ETH", Address: "0x52908400098527886e0f7030069857d2e4169ee7", Amount: "1"}, true},
+		{"default network resolved", binance.WithdrawRequest{Coin: "ETH", Address: ethAddress, Amount: "1"}, true},
+		{"other network", binance.WithdrawRequest{Coin: "ETH", Network: "BSC", Address: ethAddress, Amount: "1"}, false},
+		{"other coin", binance.WithdrawRequest{Coin: "USDT", Network: "ETH", Address: ethAddress, Amount: "20"}, false},
+		{"tag", binance.WithdrawRequest{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", AddressTag: "123", Amount: "10"}, true},
+		{"other tag", binance.WithdrawRequest{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", AddressTag: "456", Amount: "10"}, false},
+		{"no tag", binance.WithdrawRequest{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Amount: "10"}, false},
+		{"case sensitive address", binance.WithdrawRequest{Coin: "USDT", Network: "TRX", Address: "tla2f6vpqdgre67v1736s7bj8ray5wyju7", Amount: "20"}, false},
+		{"allowed network", binance.WithdrawRequest{Coin: "USDT", Network: "TRX", Address: "TLa2f6VPqDgRE67v1736s7bJ8Ray5wYjU7", Amount: "20"}, true},
+		// the default network of USDT is ETH, the address is only allowed on TRX
+		{"default network not allowed", binance.WithdrawRequest{Coin: "USDT", Address: "TLa2f6VPqDgRE67v1736s7bJ8Ray5wYjU7", Amount: "20"}, false},
+	}
+	for _, test := range tests {
+		err := g.Check(context.Background(), &test.req)
+		if test.allowed && err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		if !test.allowed && !errors.Is(err, binance.ErrWithdrawRejected) {
+			t.Errorf("%s: expected a rejection, got %v", test.name, err)
+		}
+	}
+}
+
+func TestWithdrawGuardLimits(t *testing.T) {
+	s := newWithdrawServer(t, fmt.Sprintf(`[
+		{"id":"h1","coin":"ETH","amount":"3","status":6,"applyTime":%q},
+		{"id":"h2","coin":"ETH","amount":"10","status":1,"applyTime":%q},
+		{"id":"h3","coin":"BTC","amount":"1","status":6,"applyTime":%q}]`,
+		applyTime(time.Hour), applyTime(time.Hour), applyTime(time.Hour)))
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+
+	if used, err := g.Used(context.Background(), "ETH"); err != nil || used != 3 {
+		t.Fatalf("unexpected used %v %v", used, err)
+	}
+	for _, test := range []struct {
+		amount  string
+		allowed bool
+	}{
+		{"2.5", false}, // above the transaction cap
+		{"1.99", true},
+		{"2", false}, // above the daily limit with the 0.001 fee
+		{"0", false},
+		{"abc", false},
+	} {
+		err := g.Check(context.Background(), ethWithdraw(test.amount))
+		if test.allowed != (err == nil) {
+			t.Errorf("amount %s: unexpected error %v", test.amount, err)
+		}
+	}
+	// the fee taken out of the amount is not counted on top of it
+	req := ethWithdraw("2")
+	req.TransactionFeeFlag = true
+	if err := g.Check(context.Background(), req); err != nil {
+		t.Errorf("fee out of the amount: unexpected error %v", err)
+	}
+	g.Allow(binance.AllowedAddress{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh"})
+	err := g.Check(context.Background(), &binance.WithdrawRequest{Coin: "XRP", Network: "XRP", Address: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Amount: "10"})
+	if !errors.Is(err, binance.ErrWithdrawRejected) || !strings.Contains(err.Error(), "no limit") {
+		t.Errorf("expected a rejection without limit, got %v", err)
+	}
+}
+
+func TestWithdrawGuardConcurrent(t *testing.T) {
+	s := newWithdrawServer(t, fmt.Sprintf(`[{"id":"h1","coin":"ETH","amount":"3","status":6,"applyTime":%q}]`, applyTime(time.Hour)))
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+
+	var wg sync.WaitGroup
+	var submitted int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := g.Withdraw(context.Background(), ethWithdraw("0.5")); err == nil {
+				atomic.AddInt32(&submitted, 1)
+			} else if !errors.Is(err, binance.ErrWithdrawRejected) {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// 0.501 each with the fee, a fourth one would reach 5.004
+	if submitted != 3 || s.applied != 3 {
+		t.Fatalf("unexpected withdrawals %d %d", submitted, s.applied)
+	}
+}
+
+func TestWithdrawGuardReloadKeepsReservations(t *testing.T) {
+	s := newWithdrawServer(t, fmt.Sprintf(`[{"id":"h1","coin":"ETH","amount":"3","status":6,"applyTime":%q}]`, applyTime(time.Hour)))
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+	approving := make(chan struct{})
+	approve := make(chan struct{})
+	g.Approver = func(ctx context.Context, req *binance.WithdrawRequest) (string, error) {
+		close(approving)
+		<-approve
+		return "bob", nil
+	}
+	done := make(chan error)
+	go func() {
+		req := ethWithdraw("1.5")
+		req.RequestedBy = "alice"
+		_, err := g.Withdraw(context.Background(), req)
+		done <- err
+	}()
+	<-approving
+	if err := g.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); !near(used, 4.501) {
+		t.Fatalf("unexpected used %v", used)
+	}
+	if err := g.Check(context.Background(), ethWithdraw("1")); !errors.Is(err, binance.ErrWithdrawRejected) {
+		t.Fatalf("expected a rejection, got %v", err)
+	}
+	close(approve)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// the submitted withdrawal is kept until the history lists it
+	if err := g.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); !near(used, 4.501) {
+		t.Fatalf("unexpected used %v", used)
+	}
+	s.history = fmt.Sprintf(`[{"id":"h1","coin":"ETH","amount":"3","status":6,"applyTime":%q},
+		{"id":"w1","coin":"ETH","amount":"1.5","transactionFee":"0.001","status":4,"applyTime":%q}]`, applyTime(time.Hour), applyTime(0))
+	if err := g.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); !near(used, 4.501) {
+		t.Fatalf("unexpected used %v", used)
+	}
+}
+
+func TestWithdrawGuardFailures(t *testing.T) {
+	s := newWithdrawServer(t, `[]`)
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+
+	// an API error releases the reservation
+	s.apply = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"code":-4026,"msg":"Not enough balance"}`)
+	}
+	if _, err := g.Withdraw(context.Background(), ethWithdraw("2")); !common.IsAPIError(err) {
+		t.Fatalf("expected an api error, got %v", err)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); used != 0 {
+		t.Fatalf("unexpected used %v", used)
+	}
+
+	// a lost response keeps it, the withdrawal may have been accepted
+	s.apply = func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}
+	if _, err := g.Withdraw(context.Background(), ethWithdraw("2")); err == nil || common.IsAPIError(err) {
+		t.Fatalf("expected a transport error, got %v", err)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); !near(used, 2.001) {
+		t.Fatalf("unexpected used %v", used)
+	}
+}
+
+func TestWithdrawGuardApproval(t *testing.T) {
+	s := newWithdrawServer(t, `[]`)
+	defer s.Close()
+	g, log := newWithdrawGuard(s)
+	g.Approver = func(ctx context.Context, req *binance.WithdrawRequest) (string, error) {
+		return "Alice", nil
+	}
+
+	req := ethWithdraw("1")
+	req.RequestedBy = "alice"
+	if _, err := g.Withdraw(context.Background(), req); !errors.Is(err, binance.ErrWithdrawRejected) {
+		t.Fatalf("expected a rejection, got %v", err)
+	}
+	req.RequestedBy = ""
+	if _, err := g.Withdraw(context.Background(), req); !errors.Is(err, binance.ErrWithdrawRejected) {
+		t.Fatalf("expected a rejection, got %v", err)
+	}
+	if s.applied != 0 {
+		t.Fatalf("unexpected withdrawals %d", s.applied)
+	}
+	if used, _ := g.Used(context.Background(), "ETH"); used != 0 {
+		t.Fatalf("unexpected used %v", used)
+	}
+
+	req.RequestedBy = "bob"
+	res, err := g.Withdraw(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	for _, line := range bytes.Split(bytes.TrimSpace(log.Bytes()), []byte("\n")) {
+		var entry binance.WithdrawAuditEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, string(entry.Event))
+	}
+	expected := "[REQUESTED REJECTED REQUESTED REJECTED REQUESTED APPROVED SUBMITTED]"
+	if fmt.Sprint(events) != expected || res.ID != "w1" {
+		t.Fatalf("unexpected audit log %v", events)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestWithdrawGuardAuditLog(t *testing.T) {
+	s := newWithdrawServer(t, `[]`)
+	defer s.Close()
+	g, _ := newWithdrawGuard(s)
+
+	g.AuditLog = failingWriter{}
+	if _, err := g.Withdraw(context.Background(), ethWithdraw("1")); err == nil {
+		t.Fatal("expected an audit log error")
+	}
+	g.AuditLog = nil
+	if _, err := g.Withdraw(context.Background(), ethWithdraw("1")); err == nil {
+		t.Fatal("expected an error without audit log")
+	}
+	if s.applied != 0 {
+		t.Fatalf("unexpected withdrawals %d", s.applied)
+	}
+}
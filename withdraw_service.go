@@ -71,27 +71,27 @@ func (s *CreateWithdrawService) Name(v string) *CreateWithdrawService {
 // Do sends the request.
 func (s *CreateWithdrawService) Do(ctx context.Context) (*CreateWithdrawResponse, error) {
 	r := &request{
-		method:   http.MethodPost,
-		endpoint: "/sapi/v1/capital/withdraw/apply",
-		secType:  secTypeSigned,
+		Method:   http.MethodPost,
+		Endpoint: "/sapi/v1/capital/withdraw/apply",
+		SecType:  secTypeSigned,
 	}
-	r.setParam("coin", s.coin)
-	r.setParam("address", s.address)
-	r.setParam("amount", s.amount)
+	r.SetParam("coin", s.coin)
+	r.SetParam("address", s.address)
+	r.SetParam("amount", s.amount)
 	if v := s.withdrawOrderID; v != nil {
-		r.setParam("withdrawOrderId", *v)
+		r.SetParam("withdrawOrderId", *v)
 	}
 	if v := s.network; v != nil {
-		r.setParam("network", *v)
+		r.SetParam("network", *v)
 	}
 	if v := s.addressTag; v != nil {
-		r.setParam("addressTag", *v)
+		r.SetParam("addressTag", *v)
 	}
 	if v := s.transactionFeeFlag; v != nil {
-		r.setParam("transactionFeeFlag", *v)
+		r.SetParam("transactionFeeFlag", *v)
 	}
 	if v := s.name; v != nil {
-		r.setParam("name", *v)
+		r.SetParam("name", *v)
 	}
 
 	data, err := s.c.callAPI(ctx, r)
@@ -173,30 +173,30 @@ func (s *ListWithdrawsService) Limit(limit int) *ListWithdrawsService {
 // Do sends the request.
 func (s *ListWithdrawsService) Do(ctx context.Context) (res []*Withdraw, err error) {
 	r := &request{
-		method:   http.MethodGet,
-		endpoint: "/sapi/v1/capital/withdraw/history",
-		secType:  secTypeSigned,
+		Method:   http.MethodGet,
+		Endpoint: "/sapi/v1/capital/withdraw/history",
+		SecType:  secTypeSigned,
 	}
 	if s.coin != nil {
-		r.setParam("coin", *s.coin)
+		r.SetParam("coin", *s.coin)
 	}
 	if s.withdrawOrderId != nil {
-		r.setParam("withdrawOrderId", *s.withdrawOrderId)
+		r.SetParam("withdrawOrderId", *s.withdrawOrderId)
 	}
 	if s.status != nil {
-		r.setParam("status", *s.status)
+		r.SetParam("status", *s.status)
 	}
 	if s.startTime != nil {
-		r.setParam("startTime", *s.startTime)
+		r.SetParam("startTime", *s.startTime)
 	}
 	if s.endTime != nil {
-		r.setParam("endTime", *s.endTime)
+		r.SetParam("endTime", *s.endTime)
 	}
 	if s.offset != nil {
-		r.setParam("offset", *s.offset)
+		r.SetParam("offset", *s.offset)
 	}
 	if s.limit != nil {
-		r.setParam("limit", *s.limit)
+		r.SetParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {